@@ -0,0 +1,25 @@
+package accounting
+
+import (
+	sdk "github.com/algorand/go-algorand-sdk/v2/types"
+)
+
+// TxnAuthAddrChange returns the auth address of the transaction sender after
+// the transaction, and whether the transaction closed the sender account.
+// The zero address means that the sender signs for itself. ok is false when
+// the transaction does not change the auth address.
+func TxnAuthAddrChange(stxn *sdk.SignedTxnWithAD) (authAddr sdk.Address, closed bool, ok bool) {
+	// Closing an account deletes it, along with its auth address. It is
+	// applied after the rekey.
+	if stxn.Txn.Type == sdk.PaymentTx && !stxn.Txn.CloseRemainderTo.IsZero() {
+		return sdk.Address{}, true, true
+	}
+	if stxn.Txn.RekeyTo.IsZero() {
+		return sdk.Address{}, false, false
+	}
+	if stxn.Txn.RekeyTo == stxn.Txn.Sender {
+		// rekeying to the sender resets the auth address
+		return sdk.Address{}, false, true
+	}
+	return stxn.Txn.RekeyTo, false, true
+}
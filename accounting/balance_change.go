@@ -0,0 +1,75 @@
+package accounting
+
+import (
+	"github.com/algorand/indexer/v3/idb"
+
+	sdk "github.com/algorand/go-algorand-sdk/v2/types"
+)
+
+// BalanceChange describes how a transaction changed the balances of an account.
+type BalanceChange struct {
+	// MicroAlgosIn is the amount received, including realized rewards.
+	MicroAlgosIn uint64
+	// MicroAlgosOut is the amount sent, including the fee.
+	MicroAlgosOut uint64
+
+	// AssetID is the asset sent or received by the account, 0 if none.
+	AssetID  uint64
+	AssetIn  uint64
+	AssetOut uint64
+}
+
+// TxnBalanceChange returns the change made by a transaction to the balances
+// of addr. Only the top level transaction is considered, query with
+// SkipInnerTransactionConversion to get inner transactions as separate rows.
+// row.Txn must not be nil.
+func TxnBalanceChange(row idb.TxnRow, addr sdk.Address) BalanceChange {
+	var change BalanceChange
+	stxn := row.Txn
+
+	change.MicroAlgosIn, _ = realizedRewards(stxn, addr)
+	if addr == stxn.Txn.Sender {
+		change.MicroAlgosOut += uint64(stxn.Txn.Fee)
+	}
+
+	switch stxn.Txn.Type {
+	case sdk.PaymentTx:
+		if addr == stxn.Txn.Sender {
+			change.MicroAlgosOut += uint64(stxn.Txn.Amount)
+			if !stxn.Txn.CloseRemainderTo.IsZero() {
+				change.MicroAlgosOut += uint64(stxn.ClosingAmount)
+			}
+		}
+		if addr == stxn.Txn.Receiver {
+			change.MicroAlgosIn += uint64(stxn.Txn.Amount)
+		}
+		if addr == stxn.Txn.CloseRemainderTo {
+			change.MicroAlgosIn += uint64(stxn.ClosingAmount)
+		}
+	case sdk.AssetConfigTx:
+		if stxn.Txn.ConfigAsset == 0 && addr == stxn.Txn.Sender {
+			// the creator receives the entire supply
+			change.AssetID = row.AssetID
+			change.AssetIn = stxn.Txn.AssetParams.Total
+		}
+	case sdk.AssetTransferTx:
+		source := stxn.Txn.Sender
+		if !stxn.Txn.AssetSender.IsZero() {
+			// clawback
+			source = stxn.Txn.AssetSender
+		}
+		if addr == source {
+			change.AssetID = uint64(stxn.Txn.XferAsset)
+			change.AssetOut += stxn.Txn.AssetAmount + row.Extra.AssetCloseAmount
+		}
+		if addr == stxn.Txn.AssetReceiver {
+			change.AssetID = uint64(stxn.Txn.XferAsset)
+			change.AssetIn += stxn.Txn.AssetAmount
+		}
+		if addr == stxn.Txn.AssetCloseTo {
+			change.AssetID = uint64(stxn.Txn.XferAsset)
+			change.AssetIn += row.Extra.AssetCloseAmount
+		}
+	}
+	return change
+}
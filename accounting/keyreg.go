@@ -0,0 +1,43 @@
+package accounting
+
+import (
+	models "github.com/algorand/indexer/v3/api/generated/v2"
+
+	sdk "github.com/algorand/go-algorand-sdk/v2/types"
+)
+
+// Keyreg is the participation state set by a keyreg transaction.
+type Keyreg struct {
+	// Status is "Online", "Offline" or "NotParticipating".
+	Status string
+	// Participation is only set when the account goes online.
+	Participation *models.AccountParticipation
+	// IncentiveEligible is true when going online paid the incentive fee.
+	IncentiveEligible bool
+}
+
+// TxnKeyreg returns the participation state set by the keyreg transaction.
+// It does not consider expired keys or suspensions.
+func TxnKeyreg(txn *sdk.Transaction) Keyreg {
+	switch {
+	case txn.Nonparticipation:
+		return Keyreg{Status: "NotParticipating"}
+	case !allZero(txn.VotePK[:]):
+		part := &models.AccountParticipation{
+			SelectionParticipationKey: txn.SelectionPK[:],
+			VoteParticipationKey:      txn.VotePK[:],
+			VoteFirstValid:            uint64(txn.VoteFirst),
+			VoteLastValid:             uint64(txn.VoteLast),
+			VoteKeyDilution:           txn.VoteKeyDilution,
+		}
+		if !allZero(txn.StateProofPK[:]) {
+			part.StateProofKey = byteSlicePtr(txn.StateProofPK[:])
+		}
+		return Keyreg{
+			Status:            "Online",
+			Participation:     part,
+			IncentiveEligible: uint64(txn.Fee) >= goOnlineFee,
+		}
+	}
+	return Keyreg{Status: "Offline"}
+}
@@ -8,6 +8,8 @@ import (
 	"github.com/algorand/indexer/v3/idb"
 	"github.com/algorand/indexer/v3/types"
 
+	"github.com/algorand/go-algorand-sdk/v2/protocol"
+	"github.com/algorand/go-algorand-sdk/v2/protocol/config"
 	sdk "github.com/algorand/go-algorand-sdk/v2/types"
 )
 
@@ -34,12 +36,10 @@ func assetUpdate(account *models.Account, assetid uint64, add, sub uint64) {
 			return
 		}
 	}
-	// add asset to list
+	// add asset to list, the remaining fields are filled in by rewindAssetHoldings
 	assets = append(assets, models.AssetHolding{
 		Amount:  add - sub,
 		AssetId: assetid,
-		//Creator: base32 addr string of asset creator, TODO
-		//IsFrozen: leave nil? // TODO: on close record frozen state for rewind
 	})
 	*account.Assets = assets
 }
@@ -61,8 +61,66 @@ func (sare *SpecialAccountRewindError) Error() string {
 
 var specialAccounts *types.SpecialAddresses
 
-// AccountAtRound queries the idb.IndexerDb object for transactions and rewinds most fields of the account back to
-// their values at the requested round.
+// position identifies a transaction by its round and intra round offset.
+type position struct {
+	round uint64
+	intra int
+}
+
+func rowPosition(row idb.TxnRow) *position {
+	return &position{round: row.Round, intra: row.Intra}
+}
+
+// after returns true if p is strictly later than o. A nil position is
+// earlier than everything.
+func (p *position) after(o *position) bool {
+	if p == nil {
+		return false
+	}
+	if o == nil {
+		return true
+	}
+	if p.round != o.round {
+		return p.round > o.round
+	}
+	return p.intra > o.intra
+}
+
+// rewinder holds the state used while rewinding one account.
+type rewinder struct {
+	ctx   context.Context
+	db    idb.IndexerDb
+	addr  sdk.Address
+	round uint64
+
+	// current is the account as it was given to AccountAtRound, acct is the
+	// copy being rewound.
+	current models.Account
+	acct    models.Account
+
+	// Collected by the transaction pass over (round, current.Round].
+	assets          map[uint64]*assetDelta // asset holdings which may have changed
+	freezes         map[uint64]bool        // asset holdings which may have been frozen
+	localStates     map[uint64]bool        // app local states which may have changed
+	rewards         uint64                 // rewards realized by the account
+	rewardsTouched  bool                   // the rewards base was updated
+	closed          bool                   // the account was closed
+	rekeyed         bool                   // the account was rekeyed
+	keyreg          bool                   // the account registered participation keys
+	heartbeat       bool                   // a heartbeat was sent for the account
+	lastCloseLoaded bool
+	lastCloseCache  *position
+
+	header *sdk.BlockHeader
+	proto  config.ConsensusParams
+
+	// creation transactions of assets and applications by index
+	creations map[uint64]*sdk.SignedTxnWithAD
+}
+
+// AccountAtRound queries the idb.IndexerDb object for transactions and rewinds the account back to its state
+// at the requested round. Resources which existed at the current round but not at the requested round are
+// returned with Deleted set; resource lists which are nil (excluded by the caller) are left nil.
 // `round` must be <= `account.Round`
 func AccountAtRound(ctx context.Context, account models.Account, round uint64, db idb.IndexerDb) (acct models.Account, err error) {
 	// Make sure special accounts cache has been initialized.
@@ -92,98 +150,235 @@ func AccountAtRound(ctx context.Context, account models.Account, round uint64, d
 		return
 	}
 
-	// Get transactions and rewind account.
+	r := rewinder{
+		ctx:         ctx,
+		db:          db,
+		addr:        addr,
+		round:       round,
+		current:     account,
+		acct:        copyResources(account),
+		assets:      make(map[uint64]*assetDelta),
+		freezes:     make(map[uint64]bool),
+		localStates: make(map[uint64]bool),
+		creations:   make(map[uint64]*sdk.SignedTxnWithAD),
+	}
+
+	// Get transactions and rewind account. Inner transactions are returned
+	// as their own rows so that each one is rewound independently.
 	tf := idb.TransactionFilter{
-		Address:  addr[:],
-		MinRound: round + 1,
-		MaxRound: account.Round,
+		Address:                        addr[:],
+		MinRound:                       round + 1,
+		MaxRound:                       account.Round,
+		SkipInnerTransactionConversion: true,
 	}
 	ctx2, cf := context.WithCancel(ctx)
 	// In case of a panic before the next defer, call cf() here.
 	defer cf()
-	txns, r := db.Transactions(ctx2, tf)
+	txns, rnd := db.Transactions(ctx2, tf)
 	// In case of an error, make sure the context is cancelled, and the channel is cleaned up.
 	defer func() {
 		cf()
 		for range txns {
 		}
 	}()
-	if r < account.Round {
-		err = ConsistencyError{fmt.Sprintf("queried round r: %d < account.Round: %d", r, account.Round)}
+	if rnd < account.Round {
+		err = ConsistencyError{fmt.Sprintf("queried round r: %d < account.Round: %d", rnd, account.Round)}
 		return
 	}
-	txcount := 0
 	for txnrow := range txns {
 		if txnrow.Error != nil {
 			err = txnrow.Error
 			return
 		}
-		txcount++
-		stxn := txnrow.Txn
-		if stxn == nil {
-			return models.Account{},
-				fmt.Errorf("rewinding past inner transactions is not supported")
-		}
-		if addr == stxn.Txn.Sender {
-			acct.AmountWithoutPendingRewards += uint64(stxn.Txn.Fee)
-			acct.AmountWithoutPendingRewards -= uint64(stxn.SenderRewards)
-		}
-		switch stxn.Txn.Type {
-		case sdk.PaymentTx:
-			if addr == stxn.Txn.Sender {
-				acct.AmountWithoutPendingRewards += uint64(stxn.Txn.Amount)
-			}
-			if addr == stxn.Txn.Receiver {
-				acct.AmountWithoutPendingRewards -= uint64(stxn.Txn.Amount)
-				acct.AmountWithoutPendingRewards -= uint64(stxn.ReceiverRewards)
-			}
-			if addr == stxn.Txn.CloseRemainderTo {
-				// unwind receiving a close-to
-				acct.AmountWithoutPendingRewards -= uint64(stxn.ClosingAmount)
-				acct.AmountWithoutPendingRewards -= uint64(stxn.CloseRewards)
-			} else if !stxn.Txn.CloseRemainderTo.IsZero() {
-				// unwind sending a close-to
-				acct.AmountWithoutPendingRewards += uint64(stxn.ClosingAmount)
-			}
-		case sdk.KeyRegistrationTx:
-			// TODO: keyreg does not rewind. workaround: query for txns on an account with typeenum=2 to find previous values it was set to.
-		case sdk.AssetConfigTx:
-			if stxn.Txn.ConfigAsset == 0 {
-				// create asset, unwind the application of the value
-				assetUpdate(&acct, txnrow.AssetID, 0, stxn.Txn.AssetParams.Total)
-			}
-		case sdk.AssetTransferTx:
-			if addr == stxn.Txn.AssetSender || addr == stxn.Txn.Sender {
-				assetUpdate(&acct, uint64(stxn.Txn.XferAsset), stxn.Txn.AssetAmount+txnrow.Extra.AssetCloseAmount, 0)
-			}
-			if addr == stxn.Txn.AssetReceiver {
-				assetUpdate(&acct, uint64(stxn.Txn.XferAsset), 0, stxn.Txn.AssetAmount)
-			}
-			if addr == stxn.Txn.AssetCloseTo {
-				assetUpdate(&acct, uint64(stxn.Txn.XferAsset), 0, txnrow.Extra.AssetCloseAmount)
-			}
-		case sdk.AssetFreezeTx:
-		case sdk.HeartbeatTx:
-		default:
-			err = fmt.Errorf("%s[%d,%d]: rewinding past txn type %s is not currently supported", account.Address, txnrow.Round, txnrow.Intra, stxn.Txn.Type)
+		err = r.rewindTxn(txnrow)
+		if err != nil {
 			return
 		}
 	}
 
-	acct.Round = round
+	steps := []func() error{
+		r.loadHeader,
+		r.rewindCreatedAssets,
+		r.rewindAssetHoldings,
+		r.rewindCreatedApps,
+		r.rewindAppLocalStates,
+		r.rewindClose,
+		r.rewindAuthAddr,
+		r.rewindParticipation,
+		r.rewindRewards,
+	}
+	for _, step := range steps {
+		err = step()
+		if err != nil {
+			return models.Account{}, fmt.Errorf("%s: %w", account.Address, err)
+		}
+	}
+	r.computeMinBalance()
 
-	// Due to accounts being closed and re-opened, we cannot always rewind Rewards. So clear it out.
-	acct.Rewards = 0
+	r.acct.Round = round
+	return r.acct, nil
+}
 
-	// Computing pending rewards is not supported.
-	acct.PendingRewards = 0
-	acct.Amount = acct.AmountWithoutPendingRewards
+// copyResources copies the resource lists so that they can be modified
+// without changing the caller's account.
+func copyResources(account models.Account) models.Account {
+	if account.Assets != nil {
+		assets := append([]models.AssetHolding(nil), *account.Assets...)
+		account.Assets = &assets
+	}
+	if account.CreatedAssets != nil {
+		assets := append([]models.Asset(nil), *account.CreatedAssets...)
+		account.CreatedAssets = &assets
+	}
+	if account.AppsLocalState != nil {
+		states := append([]models.ApplicationLocalState(nil), *account.AppsLocalState...)
+		account.AppsLocalState = &states
+	}
+	if account.CreatedApps != nil {
+		apps := append([]models.Application(nil), *account.CreatedApps...)
+		account.CreatedApps = &apps
+	}
+	return account
+}
 
-	// MinBalance is not supported.
-	acct.MinBalance = 0
+// rewindTxn undoes the effect of a single transaction on the account balance
+// and records which other parts of the account need to be recomputed.
+func (r *rewinder) rewindTxn(txnrow idb.TxnRow) error {
+	addr := r.addr
+	acct := &r.acct
+	stxn := txnrow.Txn
+	if stxn == nil {
+		return fmt.Errorf("%s[%d,%d]: transaction bytes missing", acct.Address, txnrow.Round, txnrow.Intra)
+	}
+	// unwind the balance changes
+	change := TxnBalanceChange(txnrow, addr)
+	acct.AmountWithoutPendingRewards += change.MicroAlgosOut
+	acct.AmountWithoutPendingRewards -= change.MicroAlgosIn
+	if change.AssetID != 0 {
+		r.assetChange(change.AssetID, change.AssetOut, change.AssetIn)
+	}
 
-	// TODO: Clear out the closed-at field as well. Like Rewards we cannot know this value for all accounts.
-	//acct.ClosedAt = 0
+	rewards, touched := realizedRewards(stxn, addr)
+	r.rewards += rewards
+	r.rewardsTouched = r.rewardsTouched || touched
+	if addr == stxn.Txn.Sender && !stxn.Txn.RekeyTo.IsZero() {
+		r.rekeyed = true
+	}
+	switch stxn.Txn.Type {
+	case sdk.PaymentTx:
+		if addr == stxn.Txn.Sender && !stxn.Txn.CloseRemainderTo.IsZero() {
+			r.closed = true
+		}
+	case sdk.KeyRegistrationTx:
+		if addr == stxn.Txn.Sender {
+			r.keyreg = true
+		}
+	case sdk.AssetConfigTx, sdk.AssetTransferTx:
+		// Holdings were updated with the balance change. Destroying an asset
+		// is handled by rewindCreatedAssets because the creator does not
+		// participate when the manager destroys it.
+	case sdk.AssetFreezeTx:
+		if addr == stxn.Txn.FreezeAccount {
+			assetid := uint64(stxn.Txn.FreezeAsset)
+			r.assetChange(assetid, 0, 0)
+			r.freezes[assetid] = true
+		}
+	case sdk.ApplicationCallTx:
+		appid := txnrow.AssetID
+		if addr == stxn.Txn.Sender && stxn.Txn.OnCompletion != sdk.NoOpOC {
+			r.localStates[appid] = true
+		}
+		if hasLocalDelta(stxn, addr) {
+			r.localStates[appid] = true
+		}
+		// Creating and deleting an application is handled by rewindCreatedApps.
+	case sdk.HeartbeatTx:
+		if addr == stxn.Txn.HbAddress {
+			r.heartbeat = true
+		}
+	case sdk.StateProofTx:
+	default:
+		return fmt.Errorf("%s[%d,%d]: rewinding past txn type %s is not currently supported", acct.Address, txnrow.Round, txnrow.Intra, stxn.Txn.Type)
+	}
+	return nil
+}
 
-	return
+// scanTransactions calls fn for each transaction matching tf until fn returns
+// false. Transactions filtered by address are visited newest first, others
+// oldest first. Nothing is visited when tf.MaxRound is 0, lookups at the
+// genesis round never have transactions.
+func (r *rewinder) scanTransactions(tf idb.TransactionFilter, fn func(row idb.TxnRow) (bool, error)) error {
+	if tf.MaxRound == 0 {
+		return nil
+	}
+	tf.SkipInnerTransactionConversion = true
+
+	ctx, cf := context.WithCancel(r.ctx)
+	defer cf()
+	txns, _ := r.db.Transactions(ctx, tf)
+	defer func() {
+		cf()
+		for range txns {
+		}
+	}()
+	for row := range txns {
+		if row.Error != nil {
+			return row.Error
+		}
+		if row.Txn == nil {
+			return fmt.Errorf("%d:%d transaction bytes missing", row.Round, row.Intra)
+		}
+		more, err := fn(row)
+		if err != nil || !more {
+			return err
+		}
+	}
+	return nil
+}
+
+// loadHeader fetches the block header and consensus parameters of the target round.
+func (r *rewinder) loadHeader() error {
+	header, err := r.blockHeader(r.round)
+	if err != nil {
+		return err
+	}
+	proto, ok := config.Consensus[protocol.ConsensusVersion(header.CurrentProtocol)]
+	if !ok {
+		return fmt.Errorf("get protocol err (%s)", header.CurrentProtocol)
+	}
+	r.header = &header
+	r.proto = proto
+	return nil
+}
+
+func (r *rewinder) blockHeader(round uint64) (sdk.BlockHeader, error) {
+	header, _, err := r.db.GetBlock(r.ctx, round, idb.GetBlockOptions{})
+	if err != nil {
+		return sdk.BlockHeader{}, fmt.Errorf("unable to get block header %d: %w", round, err)
+	}
+	return header, nil
+}
+
+// computeMinBalance recomputes the minimum balance from the rewound totals.
+// Box totals cannot be rewound from transactions and keep their current value.
+func (r *rewinder) computeMinBalance() {
+	var schema sdk.StateSchema
+	if r.acct.AppsTotalSchema != nil {
+		schema.NumUint = r.acct.AppsTotalSchema.NumUint
+		schema.NumByteSlice = r.acct.AppsTotalSchema.NumByteSlice
+	}
+	var extraPages uint32
+	if r.acct.AppsTotalExtraPages != nil {
+		extraPages = uint32(*r.acct.AppsTotalExtraPages)
+	}
+	r.acct.MinBalance = types.AccountMinBalance(sdk.AccountData{
+		TotalAppSchema:      schema,
+		TotalExtraAppPages:  extraPages,
+		TotalAppParams:      r.acct.TotalCreatedApps,
+		TotalAppLocalStates: r.acct.TotalAppsOptedIn,
+		TotalAssets:         r.acct.TotalAssetsOptedIn,
+		TotalAssetParams:    r.acct.TotalCreatedAssets,
+		TotalBoxes:          r.acct.TotalBoxes,
+		TotalBoxBytes:       r.acct.TotalBoxBytes,
+	}, &r.proto)
 }
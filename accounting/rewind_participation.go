@@ -0,0 +1,409 @@
+package accounting
+
+import (
+	"context"
+
+	models "github.com/algorand/indexer/v3/api/generated/v2"
+	"github.com/algorand/indexer/v3/idb"
+
+	sdk "github.com/algorand/go-algorand-sdk/v2/types"
+)
+
+// goOnlineFee is the minimum fee of a keyreg transaction which makes the
+// account eligible for block incentives.
+const goOnlineFee = 2_000_000
+
+// realizedRewards returns the rewards applied to addr by a transaction, and
+// whether the rewards base of the account was updated.
+func realizedRewards(stxn *sdk.SignedTxnWithAD, addr sdk.Address) (rewards uint64, touched bool) {
+	if addr == stxn.Txn.Sender {
+		rewards += uint64(stxn.SenderRewards)
+		touched = true
+	}
+	if stxn.Txn.Type == sdk.PaymentTx {
+		if addr == stxn.Txn.Receiver {
+			rewards += uint64(stxn.ReceiverRewards)
+			touched = true
+		}
+		if addr == stxn.Txn.CloseRemainderTo {
+			rewards += uint64(stxn.CloseRewards)
+			touched = true
+		}
+	}
+	return rewards, touched
+}
+
+func allZero(x []byte) bool {
+	for _, v := range x {
+		if v != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// lastClose returns the position of the most recent transaction at or
+// before the target round which closed the account.
+func (r *rewinder) lastClose() (*position, error) {
+	if r.lastCloseLoaded {
+		return r.lastCloseCache, nil
+	}
+	closedAt := r.current.ClosedAtRound
+	if closedAt == nil {
+		// never closed
+		r.lastCloseLoaded = true
+		return nil, nil
+	}
+
+	tf := idb.TransactionFilter{
+		Address:     r.addr[:],
+		AddressRole: idb.AddressRoleSender,
+		TypeEnum:    idb.TypeEnumPay,
+		MaxRound:    r.round,
+	}
+	if *closedAt <= r.round {
+		// the most recent close is before the target round, only look at that round
+		tf.MinRound = *closedAt
+		tf.MaxRound = *closedAt
+	}
+	var pos *position
+	err := r.scanTransactions(tf, func(row idb.TxnRow) (bool, error) {
+		if row.Txn.Txn.Sender == r.addr && !row.Txn.Txn.CloseRemainderTo.IsZero() {
+			pos = rowPosition(row)
+			return false, nil
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	r.lastCloseLoaded = true
+	r.lastCloseCache = pos
+	return pos, nil
+}
+
+// rewindClose sets the close round and deleted flag of the account.
+func (r *rewinder) rewindClose() error {
+	if !r.closed {
+		return nil
+	}
+	pos, err := r.lastClose()
+	if err != nil {
+		return err
+	}
+	r.acct.ClosedAtRound = nil
+	r.acct.Deleted = boolPtr(false)
+	if pos != nil {
+		r.acct.ClosedAtRound = uint64Ptr(pos.round)
+		r.acct.Deleted = boolPtr(r.acct.AmountWithoutPendingRewards == 0)
+	}
+	return nil
+}
+
+// rewindAuthAddr finds the most recent rekey at or before the target round.
+// Closing the account resets it.
+func (r *rewinder) rewindAuthAddr() error {
+	if !r.rekeyed && !r.closed {
+		return nil
+	}
+	lastClose, err := r.lastClose()
+	if err != nil {
+		return err
+	}
+	rekeyTo := true
+	tf := idb.TransactionFilter{
+		Address:     r.addr[:],
+		AddressRole: idb.AddressRoleSender,
+		RekeyTo:     &rekeyTo,
+		MaxRound:    r.round,
+		Limit:       1,
+	}
+	r.acct.AuthAddr = nil
+	return r.scanTransactions(tf, func(row idb.TxnRow) (bool, error) {
+		if rowPosition(row).after(lastClose) && row.Txn.Txn.RekeyTo != r.addr {
+			r.acct.AuthAddr = addrPtr(row.Txn.Txn.RekeyTo)
+		}
+		return false, nil
+	})
+}
+
+// blockWith returns the first block header in [min, max] which contains
+// addr in the list selected by setFilter.
+func (r *rewinder) blockWith(min, max uint64, setFilter func(*idb.BlockHeaderFilter, map[sdk.Address]struct{})) (*sdk.BlockHeader, error) {
+	if min > max {
+		return nil, nil
+	}
+	bf := idb.BlockHeaderFilter{
+		MinRound: &min,
+		MaxRound: &max,
+		Limit:    1,
+	}
+	setFilter(&bf, map[sdk.Address]struct{}{r.addr: {}})
+	headers, err := r.blockHeaders(bf)
+	if err != nil || len(headers) == 0 {
+		return nil, err
+	}
+	return &headers[0], nil
+}
+
+func (r *rewinder) blockHeaders(bf idb.BlockHeaderFilter) ([]sdk.BlockHeader, error) {
+	ctx, cf := context.WithCancel(r.ctx)
+	defer cf()
+	rows, _ := r.db.BlockHeaders(ctx, bf)
+	defer func() {
+		cf()
+		for range rows {
+		}
+	}()
+	var out []sdk.BlockHeader
+	for row := range rows {
+		if row.Error != nil {
+			return nil, row.Error
+		}
+		out = append(out, row.BlockHeader)
+	}
+	return out, nil
+}
+
+func expiredFilter(bf *idb.BlockHeaderFilter, addrs map[sdk.Address]struct{}) {
+	bf.ExpiredParticipationAccounts = addrs
+}
+
+func absentFilter(bf *idb.BlockHeaderFilter, addrs map[sdk.Address]struct{}) {
+	bf.AbsentParticipationAccounts = addrs
+}
+
+func proposerFilter(bf *idb.BlockHeaderFilter, addrs map[sdk.Address]struct{}) {
+	bf.Proposers = addrs
+}
+
+// participationChanged returns true if the account status may have changed
+// after the target round.
+func (r *rewinder) participationChanged() (bool, error) {
+	if r.keyreg || r.closed || r.heartbeat {
+		return true, nil
+	}
+	if r.current.Status != "Offline" {
+		// only a keyreg can bring an account back online
+		return false, nil
+	}
+	for _, filter := range []func(*idb.BlockHeaderFilter, map[sdk.Address]struct{}){expiredFilter, absentFilter} {
+		hdr, err := r.blockWith(r.round+1, r.current.Round, filter)
+		if err != nil {
+			return false, err
+		}
+		if hdr != nil {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// rewindParticipation recomputes the status, participation keys, incentive
+// eligibility and heartbeat of the account from the most recent keyreg at or
+// before the target round.
+func (r *rewinder) rewindParticipation() error {
+	changed, err := r.participationChanged()
+	if err != nil {
+		return err
+	}
+	if changed {
+		if err := r.rewindKeyreg(); err != nil {
+			return err
+		}
+	}
+	return r.rewindLastProposed()
+}
+
+func (r *rewinder) rewindKeyreg() error {
+	lastClose, err := r.lastClose()
+	if err != nil {
+		return err
+	}
+
+	// most recent keyreg since the account was last closed
+	var keyreg *idb.TxnRow
+	tf := idb.TransactionFilter{
+		Address:     r.addr[:],
+		AddressRole: idb.AddressRoleSender,
+		TypeEnum:    idb.TypeEnumKeyreg,
+		MaxRound:    r.round,
+		Limit:       1,
+	}
+	err = r.scanTransactions(tf, func(row idb.TxnRow) (bool, error) {
+		if rowPosition(row).after(lastClose) {
+			keyreg = &row
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	r.acct.Status = "Offline"
+	r.acct.Participation = nil
+	eligible := false
+	var wentOnline uint64
+	if keyreg != nil {
+		state := TxnKeyreg(&keyreg.Txn.Txn)
+		r.acct.Status = state.Status
+		if state.Participation != nil {
+			eligible = state.IncentiveEligible
+			wentOnline = keyreg.Round
+			r.acct.Participation = state.Participation
+
+			// The keys expire, or the account is suspended for being absent.
+			expired, err := r.blockWith(keyreg.Round+1, r.round, expiredFilter)
+			if err != nil {
+				return err
+			}
+			if expired != nil {
+				r.acct.Status = "Offline"
+				r.acct.Participation = nil
+				eligible = false
+			} else {
+				suspended, err := r.blockWith(keyreg.Round+1, r.round, absentFilter)
+				if err != nil {
+					return err
+				}
+				if suspended != nil {
+					r.acct.Status = "Offline"
+					eligible = false
+				}
+			}
+		}
+	}
+	if r.acct.IncentiveEligible != nil || eligible {
+		r.acct.IncentiveEligible = boolPtr(eligible)
+	}
+
+	if r.current.LastHeartbeat == nil {
+		// heartbeats are not tracked by this protocol version
+		return nil
+	}
+	lastHeartbeat := wentOnline
+	tf = idb.TransactionFilter{
+		Address:  r.addr[:],
+		TypeEnum: idb.TypeEnumHeartbeat,
+		MaxRound: r.round,
+	}
+	err = r.scanTransactions(tf, func(row idb.TxnRow) (bool, error) {
+		if row.Txn.Txn.HbAddress != r.addr {
+			return true, nil
+		}
+		if row.Round > lastHeartbeat {
+			lastHeartbeat = row.Round
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+	r.acct.LastHeartbeat = nil
+	if lastHeartbeat != 0 {
+		r.acct.LastHeartbeat = uint64Ptr(lastHeartbeat)
+	}
+	return nil
+}
+
+// rewindLastProposed looks for the most recent block proposed by the
+// account at or before the target round. The search stops at the round the
+// account was created in.
+func (r *rewinder) rewindLastProposed() error {
+	if r.current.LastProposed == nil || *r.current.LastProposed <= r.round {
+		return nil
+	}
+	r.acct.LastProposed = nil
+	bf := idb.BlockHeaderFilter{
+		Order:    idb.SortDescending,
+		MinRound: r.current.CreatedAtRound,
+		MaxRound: &r.round,
+		Limit:    1,
+	}
+	proposerFilter(&bf, map[sdk.Address]struct{}{r.addr: {}})
+	headers, err := r.blockHeaders(bf)
+	if err != nil {
+		return err
+	}
+	if len(headers) > 0 {
+		r.acct.LastProposed = uint64Ptr(uint64(headers[0].Round))
+	}
+	return nil
+}
+
+// rewindRewards recomputes the total rewards, rewards base and pending
+// rewards at the target round.
+func (r *rewinder) rewindRewards() error {
+	lastClose, err := r.lastClose()
+	if err != nil {
+		return err
+	}
+
+	if r.closed {
+		// The rewards were reset by the close, add up the rewards since then.
+		var total uint64
+		tf := idb.TransactionFilter{
+			Address:  r.addr[:],
+			MaxRound: r.round,
+		}
+		if lastClose != nil {
+			tf.MinRound = lastClose.round
+		}
+		err = r.scanTransactions(tf, func(row idb.TxnRow) (bool, error) {
+			if !rowPosition(row).after(lastClose) {
+				return false, nil
+			}
+			rewards, _ := realizedRewards(row.Txn, r.addr)
+			total += rewards
+			return true, nil
+		})
+		if err != nil {
+			return err
+		}
+		r.acct.Rewards = total
+	} else {
+		r.acct.Rewards -= r.rewards
+	}
+
+	if r.rewardsTouched || r.closed {
+		var base uint64
+		var baseRound *uint64
+		tf := idb.TransactionFilter{
+			Address:  r.addr[:],
+			MaxRound: r.round,
+		}
+		if lastClose != nil {
+			tf.MinRound = lastClose.round
+		}
+		err = r.scanTransactions(tf, func(row idb.TxnRow) (bool, error) {
+			if !rowPosition(row).after(lastClose) {
+				return false, nil
+			}
+			if _, touched := realizedRewards(row.Txn, r.addr); touched {
+				baseRound = uint64Ptr(row.Round)
+				return false, nil
+			}
+			return true, nil
+		})
+		if err != nil {
+			return err
+		}
+		if baseRound != nil {
+			header, err := r.blockHeader(*baseRound)
+			if err != nil {
+				return err
+			}
+			base = header.RewardsLevel
+		}
+		r.acct.RewardBase = uint64Ptr(base)
+	}
+
+	r.acct.PendingRewards = 0
+	if r.acct.Status != "NotParticipating" && r.proto.RewardUnit != 0 {
+		rewardsUnits := r.acct.AmountWithoutPendingRewards / r.proto.RewardUnit
+		rewardsDelta := r.header.RewardsLevel - uint64Value(r.acct.RewardBase)
+		r.acct.PendingRewards = rewardsUnits * rewardsDelta
+	}
+	r.acct.Amount = r.acct.AmountWithoutPendingRewards + r.acct.PendingRewards
+	return nil
+}
@@ -0,0 +1,922 @@
+package accounting
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"sort"
+
+	models "github.com/algorand/indexer/v3/api/generated/v2"
+	"github.com/algorand/indexer/v3/idb"
+	"github.com/algorand/indexer/v3/util"
+
+	sdk "github.com/algorand/go-algorand-sdk/v2/types"
+)
+
+// assetDelta accumulates the amount to add and subtract from the current
+// holding to get the holding at the target round.
+type assetDelta struct {
+	add uint64
+	sub uint64
+}
+
+func (r *rewinder) assetChange(assetid uint64, add, sub uint64) {
+	d, ok := r.assets[assetid]
+	if !ok {
+		d = &assetDelta{}
+		r.assets[assetid] = d
+	}
+	d.add += add
+	d.sub += sub
+}
+
+// hasLocalDelta returns true if the transaction modified the local state of addr.
+func hasLocalDelta(stxn *sdk.SignedTxnWithAD, addr sdk.Address) bool {
+	for index := range stxn.EvalDelta.LocalDeltas {
+		if a, ok := localDeltaAddress(index, stxn); ok && a == addr {
+			return true
+		}
+	}
+	return false
+}
+
+// localDeltaAddress resolves a LocalDeltas index into
+// [Sender, txn.Accounts[0], txn.Accounts[1], ..., shared[0], shared[1], ...]
+func localDeltaAddress(index uint64, stxn *sdk.SignedTxnWithAD) (sdk.Address, bool) {
+	accounts := stxn.Txn.Accounts
+	shared := stxn.EvalDelta.SharedAccts
+	switch {
+	case index == 0:
+		return stxn.Txn.Sender, true
+	case int(index-1) < len(accounts):
+		return accounts[index-1], true
+	case int(index-1)-len(accounts) < len(shared):
+		return shared[int(index-1)-len(accounts)], true
+	default:
+		return sdk.Address{}, false
+	}
+}
+
+func applyStateDelta(kv sdk.TealKeyValue, delta sdk.StateDelta) {
+	for k, vd := range delta {
+		switch vd.Action {
+		case sdk.SetBytesAction:
+			kv[k] = sdk.TealValue{Type: sdk.TealBytesType, Bytes: vd.Bytes}
+		case sdk.SetUintAction:
+			kv[k] = sdk.TealValue{Type: sdk.TealUintType, Uint: vd.Uint}
+		case sdk.DeleteAction:
+			delete(kv, k)
+		}
+	}
+}
+
+// tealKeyValueToModel converts a key value store, keys are sorted so the
+// result is stable.
+func tealKeyValueToModel(kv sdk.TealKeyValue) *models.TealKeyValueStore {
+	if len(kv) == 0 {
+		return nil
+	}
+	keys := make([]string, 0, len(kv))
+	for k := range kv {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	out := make(models.TealKeyValueStore, 0, len(kv))
+	for _, k := range keys {
+		tv := kv[k]
+		value := models.TealValue{Type: uint64(tv.Type)}
+		switch tv.Type {
+		case sdk.TealUintType:
+			value.Uint = tv.Uint
+		case sdk.TealBytesType:
+			value.Bytes = base64.StdEncoding.EncodeToString([]byte(tv.Bytes))
+		}
+		out = append(out, models.TealKeyValue{
+			Key:   base64.StdEncoding.EncodeToString([]byte(k)),
+			Value: value,
+		})
+	}
+	return &out
+}
+
+func sortedKeys[V any](m map[uint64]V) []uint64 {
+	keys := make([]uint64, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+	return keys
+}
+
+func uint64Value(x *uint64) uint64 {
+	if x == nil {
+		return 0
+	}
+	return *x
+}
+
+func boolValue(x *bool) bool {
+	return x != nil && *x
+}
+
+func uint64Ptr(x uint64) *uint64 {
+	return &x
+}
+
+func boolPtr(x bool) *bool {
+	return &x
+}
+
+func addrPtr(x sdk.Address) *string {
+	if x.IsZero() {
+		return nil
+	}
+	out := x.String()
+	return &out
+}
+
+func strOmitEmpty(x string) *string {
+	if x == "" {
+		return nil
+	}
+	return &x
+}
+
+func byteSlicePtr(x []byte) *[]byte {
+	if len(x) == 0 {
+		return nil
+	}
+	xx := make([]byte, len(x))
+	copy(xx, x)
+	return &xx
+}
+
+// existedAt returns true if a resource created at `created` and optionally
+// deleted at `deleted` existed at the given round.
+func existedAt(round uint64, created *uint64, deleted *uint64, deletedNow bool) bool {
+	if uint64Value(created) > round {
+		return false
+	}
+	return !deletedNow || deleted == nil || *deleted > round
+}
+
+// creation returns the transaction which created an asset or application.
+func (r *rewinder) creation(id uint64, typeEnum idb.TxnTypeEnum) (*sdk.SignedTxnWithAD, error) {
+	if stxn, ok := r.creations[id]; ok {
+		return stxn, nil
+	}
+	tf := idb.TransactionFilter{
+		TypeEnum: typeEnum,
+		MaxRound: r.current.Round,
+		Limit:    1,
+	}
+	if typeEnum == idb.TypeEnumApplication {
+		tf.ApplicationID = &id
+	} else {
+		tf.AssetID = &id
+	}
+	var creation *sdk.SignedTxnWithAD
+	err := r.scanTransactions(tf, func(row idb.TxnRow) (bool, error) {
+		txn := row.Txn.Txn
+		if (typeEnum == idb.TypeEnumAssetConfig && txn.ConfigAsset == 0) ||
+			(typeEnum == idb.TypeEnumApplication && txn.ApplicationID == 0) {
+			creation = row.Txn
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to find creation of %d: %w", id, err)
+	}
+	r.creations[id] = creation
+	return creation, nil
+}
+
+// hasActivity returns true if there is a transaction of the given type for
+// the creatable after the target round.
+func (r *rewinder) hasActivity(id uint64, typeEnum idb.TxnTypeEnum) (bool, error) {
+	tf := idb.TransactionFilter{
+		TypeEnum: typeEnum,
+		MinRound: r.round + 1,
+		MaxRound: r.current.Round,
+		Limit:    1,
+	}
+	if typeEnum == idb.TypeEnumApplication {
+		tf.ApplicationID = &id
+	} else {
+		tf.AssetID = &id
+	}
+	found := false
+	err := r.scanTransactions(tf, func(row idb.TxnRow) (bool, error) {
+		found = true
+		return false, nil
+	})
+	return found, err
+}
+
+/////////////////////////
+// Created assets
+/////////////////////////
+
+func (r *rewinder) createdAssetRows() ([]idb.AssetRow, error) {
+	ctx, cf := context.WithCancel(r.ctx)
+	defer cf()
+	rows, _ := r.db.Assets(ctx, idb.AssetsQuery{
+		Creator:        r.addr[:],
+		IncludeDeleted: true,
+	})
+	defer func() {
+		cf()
+		for range rows {
+		}
+	}()
+	var out []idb.AssetRow
+	for row := range rows {
+		if row.Error != nil {
+			return nil, row.Error
+		}
+		out = append(out, row)
+	}
+	return out, nil
+}
+
+// assetParamsAt replays the configuration transactions of an asset up to
+// the target round.
+func (r *rewinder) assetParamsAt(assetid uint64) (sdk.AssetParams, error) {
+	var params sdk.AssetParams
+	tf := idb.TransactionFilter{
+		AssetID:  &assetid,
+		TypeEnum: idb.TypeEnumAssetConfig,
+		MaxRound: r.round,
+	}
+	err := r.scanTransactions(tf, func(row idb.TxnRow) (bool, error) {
+		txn := row.Txn.Txn
+		if txn.ConfigAsset == 0 {
+			params = txn.AssetParams
+			return true, nil
+		}
+		if txn.AssetParams == (sdk.AssetParams{}) {
+			// destroyed, the caller checks existence separately
+			return false, nil
+		}
+		params.Manager = txn.AssetParams.Manager
+		params.Reserve = txn.AssetParams.Reserve
+		params.Freeze = txn.AssetParams.Freeze
+		params.Clawback = txn.AssetParams.Clawback
+		return true, nil
+	})
+	if err != nil {
+		return sdk.AssetParams{}, fmt.Errorf("unable to rewind asset %d params: %w", assetid, err)
+	}
+	return params, nil
+}
+
+func assetParamsToModel(creator string, ap sdk.AssetParams) models.AssetParams {
+	var metadataHash *[]byte
+	if ap.MetadataHash != ([32]byte{}) {
+		metadataHash = byteSlicePtr(ap.MetadataHash[:])
+	}
+	return models.AssetParams{
+		Creator:       creator,
+		Total:         ap.Total,
+		Decimals:      uint64(ap.Decimals),
+		DefaultFrozen: boolPtr(ap.DefaultFrozen),
+		UnitName:      strOmitEmpty(util.PrintableUTF8OrEmpty(ap.UnitName)),
+		UnitNameB64:   byteSlicePtr([]byte(ap.UnitName)),
+		Name:          strOmitEmpty(util.PrintableUTF8OrEmpty(ap.AssetName)),
+		NameB64:       byteSlicePtr([]byte(ap.AssetName)),
+		Url:           strOmitEmpty(util.PrintableUTF8OrEmpty(ap.URL)),
+		UrlB64:        byteSlicePtr([]byte(ap.URL)),
+		MetadataHash:  metadataHash,
+		Manager:       addrPtr(ap.Manager),
+		Reserve:       addrPtr(ap.Reserve),
+		Freeze:        addrPtr(ap.Freeze),
+		Clawback:      addrPtr(ap.Clawback),
+	}
+}
+
+// rewindCreatedAssets removes assets created after the target round, restores
+// assets destroyed after it and rewinds the configuration of the others.
+func (r *rewinder) rewindCreatedAssets() error {
+	rows, err := r.createdAssetRows()
+	if err != nil {
+		return err
+	}
+	for _, row := range rows {
+		existsNow := !boolValue(row.Deleted)
+		existed := existedAt(r.round, row.CreatedRound, row.ClosedRound, !existsNow)
+
+		if !existsNow && row.ClosedRound != nil && *row.ClosedRound > r.round {
+			// Destroying an asset removes the creator holding, which must
+			// contain the entire supply.
+			creation, err := r.creation(row.AssetID, idb.TypeEnumAssetConfig)
+			if err != nil {
+				return err
+			}
+			if creation != nil {
+				r.assetChange(row.AssetID, creation.Txn.AssetParams.Total, 0)
+			}
+		}
+
+		switch {
+		case existsNow && !existed:
+			r.acct.TotalCreatedAssets--
+		case !existsNow && existed:
+			r.acct.TotalCreatedAssets++
+		}
+
+		if r.acct.CreatedAssets == nil {
+			continue
+		}
+		if !existed {
+			r.removeCreatedAsset(row.AssetID)
+			continue
+		}
+		if existsNow {
+			changed, err := r.hasActivity(row.AssetID, idb.TypeEnumAssetConfig)
+			if err != nil {
+				return err
+			}
+			if !changed {
+				continue
+			}
+		}
+		params, err := r.assetParamsAt(row.AssetID)
+		if err != nil {
+			return err
+		}
+		r.setCreatedAsset(models.Asset{
+			Index:          row.AssetID,
+			CreatedAtRound: row.CreatedRound,
+			Deleted:        boolPtr(false),
+			Params:         assetParamsToModel(r.acct.Address, params),
+		})
+	}
+	return nil
+}
+
+func (r *rewinder) removeCreatedAsset(assetid uint64) {
+	assets := (*r.acct.CreatedAssets)[:0]
+	for _, asset := range *r.acct.CreatedAssets {
+		if asset.Index != assetid {
+			assets = append(assets, asset)
+		}
+	}
+	*r.acct.CreatedAssets = assets
+}
+
+func (r *rewinder) setCreatedAsset(asset models.Asset) {
+	for i := range *r.acct.CreatedAssets {
+		if (*r.acct.CreatedAssets)[i].Index == asset.Index {
+			(*r.acct.CreatedAssets)[i] = asset
+			return
+		}
+	}
+	*r.acct.CreatedAssets = append(*r.acct.CreatedAssets, asset)
+}
+
+/////////////////////////
+// Asset holdings
+/////////////////////////
+
+// holdingState describes an asset holding at the target round.
+type holdingState struct {
+	exists    bool
+	frozen    *bool
+	lastClose *position
+}
+
+func (r *rewinder) currentHolding(assetid uint64) (*idb.AssetBalanceRow, error) {
+	ctx, cf := context.WithCancel(r.ctx)
+	defer cf()
+	rows, _ := r.db.AssetBalances(ctx, idb.AssetBalanceQuery{
+		AssetID:        &assetid,
+		Address:        r.addr[:],
+		IncludeDeleted: true,
+		Limit:          1,
+	})
+	defer func() {
+		cf()
+		for range rows {
+		}
+	}()
+	for row := range rows {
+		if row.Error != nil {
+			return nil, row.Error
+		}
+		return &row, nil
+	}
+	return nil, nil
+}
+
+// holdingAt looks at the account transactions for an asset, newest first,
+// to find whether the holding existed at the target round and whether it
+// was frozen.
+func (r *rewinder) holdingAt(assetid uint64) (holdingState, error) {
+	var h holdingState
+	decided := false
+	creator := false
+	tf := idb.TransactionFilter{
+		Address:  r.addr[:],
+		AssetID:  &assetid,
+		MaxRound: r.round,
+	}
+	err := r.scanTransactions(tf, func(row idb.TxnRow) (bool, error) {
+		txn := row.Txn.Txn
+		switch txn.Type {
+		case sdk.AssetTransferTx:
+			if txn.Sender == r.addr && txn.AssetSender.IsZero() && !txn.AssetCloseTo.IsZero() {
+				if !decided {
+					decided = true
+					h.exists = false
+				}
+				h.lastClose = rowPosition(row)
+				// Anything older than the close does not apply to this holding.
+				return false, nil
+			}
+		case sdk.AssetFreezeTx:
+			if txn.FreezeAccount == r.addr && h.frozen == nil {
+				h.frozen = boolPtr(txn.AssetFrozen)
+			}
+		case sdk.AssetConfigTx:
+			if txn.ConfigAsset == 0 {
+				creator = true
+				if !decided {
+					decided = true
+					h.exists = true
+				}
+				return false, nil
+			}
+			if txn.AssetParams == (sdk.AssetParams{}) {
+				// destroyed
+				if !decided {
+					decided = true
+					h.exists = false
+				}
+				return false, nil
+			}
+			// Reconfiguring the asset says nothing about the holding.
+			return true, nil
+		}
+		if !decided {
+			decided = true
+			h.exists = true
+		}
+		return true, nil
+	})
+	if err != nil {
+		return holdingState{}, fmt.Errorf("unable to rewind asset holding %d: %w", assetid, err)
+	}
+	if h.frozen == nil {
+		h.frozen = boolPtr(false)
+		if !creator {
+			creation, err := r.creation(assetid, idb.TypeEnumAssetConfig)
+			if err != nil {
+				return holdingState{}, err
+			}
+			if creation != nil {
+				h.frozen = boolPtr(creation.Txn.AssetParams.DefaultFrozen)
+			}
+		}
+	}
+	return h, nil
+}
+
+// rewindAssetHoldings applies the accumulated amount changes to the asset
+// holdings and recomputes which holdings existed at the target round.
+func (r *rewinder) rewindAssetHoldings() error {
+	for _, assetid := range sortedKeys(r.assets) {
+		d := r.assets[assetid]
+		cur, err := r.currentHolding(assetid)
+		if err != nil {
+			return err
+		}
+		existsNow := cur != nil && !boolValue(cur.Deleted)
+
+		var h holdingState
+		if existsNow && cur.ClosedRound == nil && uint64Value(cur.CreatedRound) <= r.round && !r.freezes[assetid] {
+			// The holding was never closed and the freeze flag did not change.
+			h = holdingState{exists: true, frozen: boolPtr(cur.Frozen)}
+		} else {
+			h, err = r.holdingAt(assetid)
+			if err != nil {
+				return err
+			}
+		}
+
+		switch {
+		case existsNow && !h.exists:
+			r.acct.TotalAssetsOptedIn--
+		case !existsNow && h.exists:
+			r.acct.TotalAssetsOptedIn++
+		}
+
+		if r.acct.Assets == nil {
+			continue
+		}
+		if !h.exists && h.lastClose == nil {
+			// the account never held the asset at the target round
+			r.removeHolding(assetid)
+			continue
+		}
+		// Start from the current holding and unwind the changes.
+		r.setHolding(models.AssetHolding{AssetId: assetid})
+		if cur != nil {
+			assetUpdate(&r.acct, assetid, cur.Amount, 0)
+		}
+		assetUpdate(&r.acct, assetid, d.add, d.sub)
+		holding := r.holding(assetid)
+		holding.IsFrozen = h.exists && *h.frozen
+		holding.Deleted = boolPtr(!h.exists)
+		if cur != nil && uint64Value(cur.CreatedRound) <= r.round {
+			holding.OptedInAtRound = cur.CreatedRound
+		}
+		if h.lastClose != nil {
+			holding.OptedOutAtRound = uint64Ptr(h.lastClose.round)
+		}
+		if !h.exists {
+			if holding.Amount != 0 {
+				return ConsistencyError{fmt.Sprintf("asset %d closed with amount %d at round %d", assetid, holding.Amount, r.round)}
+			}
+		}
+	}
+	return nil
+}
+
+func (r *rewinder) holding(assetid uint64) *models.AssetHolding {
+	for i := range *r.acct.Assets {
+		if (*r.acct.Assets)[i].AssetId == assetid {
+			return &(*r.acct.Assets)[i]
+		}
+	}
+	return nil
+}
+
+func (r *rewinder) setHolding(holding models.AssetHolding) {
+	if h := r.holding(holding.AssetId); h != nil {
+		*h = holding
+		return
+	}
+	*r.acct.Assets = append(*r.acct.Assets, holding)
+}
+
+func (r *rewinder) removeHolding(assetid uint64) {
+	assets := (*r.acct.Assets)[:0]
+	for _, holding := range *r.acct.Assets {
+		if holding.AssetId != assetid {
+			assets = append(assets, holding)
+		}
+	}
+	*r.acct.Assets = assets
+}
+
+/////////////////////////
+// Created applications
+/////////////////////////
+
+func (r *rewinder) createdAppRows() ([]models.Application, error) {
+	ctx, cf := context.WithCancel(r.ctx)
+	defer cf()
+	rows, _ := r.db.Applications(ctx, idb.ApplicationQuery{
+		Address:        r.addr[:],
+		IncludeDeleted: true,
+	})
+	defer func() {
+		cf()
+		for range rows {
+		}
+	}()
+	var out []models.Application
+	for row := range rows {
+		if row.Error != nil {
+			return nil, row.Error
+		}
+		out = append(out, row.Application)
+	}
+	return out, nil
+}
+
+// appParamsAt replays the application calls of an application up to the
+// target round.
+func (r *rewinder) appParamsAt(appid uint64) (models.ApplicationParams, error) {
+	var params models.ApplicationParams
+	var version uint64
+	globalState := make(sdk.TealKeyValue)
+	tf := idb.TransactionFilter{
+		ApplicationID: &appid,
+		TypeEnum:      idb.TypeEnumApplication,
+		MaxRound:      r.round,
+	}
+	err := r.scanTransactions(tf, func(row idb.TxnRow) (bool, error) {
+		stxn := row.Txn
+		txn := stxn.Txn
+		switch {
+		case txn.ApplicationID == 0:
+			params = models.ApplicationParams{
+				Creator:           addrPtr(txn.Sender),
+				ApprovalProgram:   txn.ApprovalProgram,
+				ClearStateProgram: txn.ClearStateProgram,
+				GlobalStateSchema: &models.ApplicationStateSchema{
+					NumByteSlice: txn.GlobalStateSchema.NumByteSlice,
+					NumUint:      txn.GlobalStateSchema.NumUint,
+				},
+				LocalStateSchema: &models.ApplicationStateSchema{
+					NumByteSlice: txn.LocalStateSchema.NumByteSlice,
+					NumUint:      txn.LocalStateSchema.NumUint,
+				},
+			}
+			if txn.ExtraProgramPages != 0 {
+				params.ExtraProgramPages = uint64Ptr(uint64(txn.ExtraProgramPages))
+			}
+		case txn.OnCompletion == sdk.UpdateApplicationOC:
+			params.ApprovalProgram = txn.ApprovalProgram
+			params.ClearStateProgram = txn.ClearStateProgram
+			version++
+		}
+		applyStateDelta(globalState, stxn.EvalDelta.GlobalDelta)
+		return true, nil
+	})
+	if err != nil {
+		return models.ApplicationParams{}, fmt.Errorf("unable to rewind application %d params: %w", appid, err)
+	}
+	params.GlobalState = tealKeyValueToModel(globalState)
+	if version != 0 {
+		params.Version = uint64Ptr(version)
+	}
+	return params, nil
+}
+
+func schemaOf(params models.ApplicationParams) (schema models.ApplicationStateSchema, extraPages uint64) {
+	if params.GlobalStateSchema != nil {
+		schema = *params.GlobalStateSchema
+	}
+	return schema, uint64Value(params.ExtraProgramPages)
+}
+
+// adjustAppTotals adds (or removes) the global schema and extra pages of an
+// application from the account totals.
+func (r *rewinder) adjustAppTotals(params models.ApplicationParams, add bool) {
+	schema, extraPages := schemaOf(params)
+	total := models.ApplicationStateSchema{}
+	if r.acct.AppsTotalSchema != nil {
+		total = *r.acct.AppsTotalSchema
+	}
+	pages := uint64Value(r.acct.AppsTotalExtraPages)
+	if add {
+		r.acct.TotalCreatedApps++
+		total.NumUint += schema.NumUint
+		total.NumByteSlice += schema.NumByteSlice
+		pages += extraPages
+	} else {
+		r.acct.TotalCreatedApps--
+		total.NumUint -= schema.NumUint
+		total.NumByteSlice -= schema.NumByteSlice
+		pages -= extraPages
+	}
+	r.acct.AppsTotalSchema = nil
+	if total != (models.ApplicationStateSchema{}) {
+		r.acct.AppsTotalSchema = &total
+	}
+	r.acct.AppsTotalExtraPages = nil
+	if pages != 0 {
+		r.acct.AppsTotalExtraPages = uint64Ptr(pages)
+	}
+}
+
+// rewindCreatedApps removes applications created after the target round,
+// restores applications deleted after it and rewinds the parameters and
+// global state of the others.
+func (r *rewinder) rewindCreatedApps() error {
+	apps, err := r.createdAppRows()
+	if err != nil {
+		return err
+	}
+	for _, app := range apps {
+		existsNow := !boolValue(app.Deleted)
+		existed := existedAt(r.round, app.CreatedAtRound, app.DeletedAtRound, !existsNow)
+
+		needParams := existed && !existsNow
+		if existed && existsNow && r.acct.CreatedApps != nil {
+			needParams, err = r.hasActivity(app.Id, idb.TypeEnumApplication)
+			if err != nil {
+				return err
+			}
+		}
+		var params models.ApplicationParams
+		if needParams {
+			params, err = r.appParamsAt(app.Id)
+			if err != nil {
+				return err
+			}
+		}
+
+		switch {
+		case existsNow && !existed:
+			r.adjustAppTotals(app.Params, false)
+		case !existsNow && existed:
+			r.adjustAppTotals(params, true)
+		}
+
+		if r.acct.CreatedApps == nil {
+			continue
+		}
+		if !existed {
+			r.removeCreatedApp(app.Id)
+			continue
+		}
+		if needParams {
+			r.setCreatedApp(models.Application{
+				Id:             app.Id,
+				CreatedAtRound: app.CreatedAtRound,
+				Deleted:        boolPtr(false),
+				Params:         params,
+			})
+		}
+	}
+	return nil
+}
+
+func (r *rewinder) removeCreatedApp(appid uint64) {
+	apps := (*r.acct.CreatedApps)[:0]
+	for _, app := range *r.acct.CreatedApps {
+		if app.Id != appid {
+			apps = append(apps, app)
+		}
+	}
+	*r.acct.CreatedApps = apps
+}
+
+func (r *rewinder) setCreatedApp(app models.Application) {
+	for i := range *r.acct.CreatedApps {
+		if (*r.acct.CreatedApps)[i].Id == app.Id {
+			(*r.acct.CreatedApps)[i] = app
+			return
+		}
+	}
+	*r.acct.CreatedApps = append(*r.acct.CreatedApps, app)
+}
+
+/////////////////////////
+// App local states
+/////////////////////////
+
+// localState describes an application local state at the target round.
+type localState struct {
+	exists    bool
+	keyValue  sdk.TealKeyValue
+	lastClose *position
+}
+
+func (r *rewinder) currentLocalState(appid uint64) (*models.ApplicationLocalState, error) {
+	ctx, cf := context.WithCancel(r.ctx)
+	defer cf()
+	rows, _ := r.db.AppLocalState(ctx, idb.ApplicationQuery{
+		Address:        r.addr[:],
+		ApplicationID:  &appid,
+		IncludeDeleted: true,
+		Limit:          1,
+	})
+	defer func() {
+		cf()
+		for range rows {
+		}
+	}()
+	for row := range rows {
+		if row.Error != nil {
+			return nil, row.Error
+		}
+		return &row.AppLocalState, nil
+	}
+	return nil, nil
+}
+
+// localStateAt looks at the account application calls, newest first, back
+// to the most recent opt in and replays the local state changes since then.
+// Changes made through accounts shared by other transactions in the group
+// are not indexed for the account and are not seen.
+func (r *rewinder) localStateAt(appid uint64) (localState, error) {
+	var ls localState
+	decided := false
+	var changes []*sdk.SignedTxnWithAD
+	tf := idb.TransactionFilter{
+		Address:       r.addr[:],
+		ApplicationID: &appid,
+		TypeEnum:      idb.TypeEnumApplication,
+		MaxRound:      r.round,
+	}
+	err := r.scanTransactions(tf, func(row idb.TxnRow) (bool, error) {
+		stxn := row.Txn
+		txn := stxn.Txn
+		if txn.Sender == r.addr && (txn.OnCompletion == sdk.CloseOutOC || txn.OnCompletion == sdk.ClearStateOC) {
+			if !decided {
+				decided = true
+				ls.exists = false
+			}
+			ls.lastClose = rowPosition(row)
+			return false, nil
+		}
+		optIn := txn.Sender == r.addr && txn.OnCompletion == sdk.OptInOC
+		if optIn || hasLocalDelta(stxn, r.addr) {
+			if !decided {
+				decided = true
+				ls.exists = true
+			}
+			changes = append(changes, stxn)
+		}
+		return !optIn, nil
+	})
+	if err != nil {
+		return localState{}, fmt.Errorf("unable to rewind local state %d: %w", appid, err)
+	}
+	if ls.exists {
+		ls.keyValue = make(sdk.TealKeyValue)
+		for i := len(changes) - 1; i >= 0; i-- {
+			stxn := changes[i]
+			for index, delta := range stxn.EvalDelta.LocalDeltas {
+				if a, ok := localDeltaAddress(index, stxn); ok && a == r.addr {
+					applyStateDelta(ls.keyValue, delta)
+				}
+			}
+		}
+	}
+	return ls, nil
+}
+
+// rewindAppLocalStates recomputes the local states which were modified after
+// the target round.
+func (r *rewinder) rewindAppLocalStates() error {
+	for _, appid := range sortedKeys(r.localStates) {
+		cur, err := r.currentLocalState(appid)
+		if err != nil {
+			return err
+		}
+		existsNow := cur != nil && !boolValue(cur.Deleted)
+
+		ls, err := r.localStateAt(appid)
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case existsNow && !ls.exists:
+			r.acct.TotalAppsOptedIn--
+		case !existsNow && ls.exists:
+			r.acct.TotalAppsOptedIn++
+		}
+
+		if r.acct.AppsLocalState == nil {
+			continue
+		}
+		if !ls.exists && ls.lastClose == nil {
+			// the account never opted in at the target round
+			r.removeLocalState(appid)
+			continue
+		}
+		state := models.ApplicationLocalState{
+			Id:       appid,
+			Deleted:  boolPtr(!ls.exists),
+			KeyValue: tealKeyValueToModel(ls.keyValue),
+		}
+		if cur != nil {
+			state.Schema = cur.Schema
+			if uint64Value(cur.OptedInAtRound) <= r.round {
+				state.OptedInAtRound = cur.OptedInAtRound
+			}
+		} else {
+			creation, err := r.creation(appid, idb.TypeEnumApplication)
+			if err != nil {
+				return err
+			}
+			if creation != nil {
+				state.Schema = models.ApplicationStateSchema{
+					NumByteSlice: creation.Txn.LocalStateSchema.NumByteSlice,
+					NumUint:      creation.Txn.LocalStateSchema.NumUint,
+				}
+			}
+		}
+		if ls.lastClose != nil {
+			state.ClosedOutAtRound = uint64Ptr(ls.lastClose.round)
+		}
+		r.setLocalState(state)
+	}
+	return nil
+}
+
+func (r *rewinder) removeLocalState(appid uint64) {
+	states := (*r.acct.AppsLocalState)[:0]
+	for _, state := range *r.acct.AppsLocalState {
+		if state.Id != appid {
+			states = append(states, state)
+		}
+	}
+	*r.acct.AppsLocalState = states
+}
+
+func (r *rewinder) setLocalState(state models.ApplicationLocalState) {
+	for i := range *r.acct.AppsLocalState {
+		if (*r.acct.AppsLocalState)[i].Id == state.Id {
+			(*r.acct.AppsLocalState)[i] = state
+			return
+		}
+	}
+	*r.acct.AppsLocalState = append(*r.acct.AppsLocalState, state)
+}
@@ -3,19 +3,117 @@ package accounting
 import (
 	"context"
 	"errors"
+	"sort"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 
 	models "github.com/algorand/indexer/v3/api/generated/v2"
 	"github.com/algorand/indexer/v3/idb"
 	"github.com/algorand/indexer/v3/idb/mocks"
 	"github.com/algorand/indexer/v3/types"
 
+	"github.com/algorand/go-algorand-sdk/v2/protocol"
+	"github.com/algorand/go-algorand-sdk/v2/protocol/config"
 	sdk "github.com/algorand/go-algorand-sdk/v2/types"
 )
 
+// participates mirrors the txn_participation table, root transactions
+// include the participants of their inner transactions.
+func participates(stxn *sdk.SignedTxnWithAD, addr sdk.Address, includeInner bool) bool {
+	txn := stxn.Txn
+	addrs := append([]sdk.Address{txn.Sender, txn.Receiver, txn.CloseRemainderTo,
+		txn.AssetSender, txn.AssetReceiver, txn.AssetCloseTo, txn.FreezeAccount}, txn.Accounts...)
+	if txn.Type == sdk.HeartbeatTx {
+		addrs = append(addrs, txn.HbAddress)
+	}
+	for _, a := range addrs {
+		if a == addr {
+			return true
+		}
+	}
+	if includeInner {
+		for i := range stxn.EvalDelta.InnerTxns {
+			if participates(&stxn.EvalDelta.InnerTxns[i], addr, true) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// mockTransactions answers transaction queries from rows, filtered and
+// ordered like the postgres implementation.
+func mockTransactions(rows []idb.TxnRow, round uint64) func(context.Context, idb.TransactionFilter) (<-chan idb.TxnRow, uint64) {
+	return func(ctx context.Context, tf idb.TransactionFilter) (<-chan idb.TxnRow, uint64) {
+		var addr sdk.Address
+		copy(addr[:], tf.Address)
+		var out []idb.TxnRow
+		for _, row := range rows {
+			txn := row.Txn.Txn
+			typeEnum, _ := idb.GetTypeEnum(txn.Type)
+			switch {
+			case tf.MinRound != 0 && row.Round < tf.MinRound:
+			case tf.MaxRound != 0 && row.Round > tf.MaxRound:
+			case tf.Address != nil && !participates(row.Txn, addr, row.Extra.RootTxid == ""):
+			case tf.AddressRole == idb.AddressRoleSender && txn.Sender != addr:
+			case tf.TypeEnum != 0 && tf.TypeEnum != typeEnum:
+			case tf.RekeyTo != nil && *tf.RekeyTo == txn.RekeyTo.IsZero():
+			case tf.AssetID != nil && *tf.AssetID != row.AssetID:
+			case tf.ApplicationID != nil && *tf.ApplicationID != row.AssetID:
+			default:
+				out = append(out, row)
+			}
+		}
+		sort.SliceStable(out, func(i, j int) bool {
+			if out[i].Round != out[j].Round {
+				return (out[i].Round < out[j].Round) == (tf.Address == nil)
+			}
+			return (out[i].Intra < out[j].Intra) == (tf.Address == nil)
+		})
+		if tf.Limit != 0 && uint64(len(out)) > tf.Limit {
+			out = out[:tf.Limit]
+		}
+		ch := make(chan idb.TxnRow, len(out))
+		for _, row := range out {
+			ch <- row
+		}
+		close(ch)
+		return ch, round
+	}
+}
+
+func makeMockDB(round uint64, rows []idb.TxnRow) *mocks.IndexerDb {
+	header := sdk.BlockHeader{
+		UpgradeState: sdk.UpgradeState{CurrentProtocol: string(protocol.ConsensusFuture)},
+	}
+	db := &mocks.IndexerDb{}
+	db.On("GetSpecialAccounts", mock.Anything).Return(types.SpecialAddresses{}, nil)
+	db.On("GetBlock", mock.Anything, mock.Anything, mock.Anything).Return(header, nil, nil)
+	db.On("Transactions", mock.Anything, mock.Anything).Return(mockTransactions(rows, round))
+	db.On("Assets", mock.Anything, mock.Anything).Return(
+		func(context.Context, idb.AssetsQuery) (<-chan idb.AssetRow, uint64) {
+			ch := make(chan idb.AssetRow)
+			close(ch)
+			return ch, round
+		})
+	db.On("Applications", mock.Anything, mock.Anything).Return(
+		func(context.Context, idb.ApplicationQuery) (<-chan idb.ApplicationRow, uint64) {
+			ch := make(chan idb.ApplicationRow)
+			close(ch)
+			return ch, round
+		})
+	db.On("BlockHeaders", mock.Anything, mock.Anything).Return(
+		func(context.Context, idb.BlockHeaderFilter) (<-chan idb.BlockRow, uint64) {
+			ch := make(chan idb.BlockRow)
+			close(ch)
+			return ch, round
+		})
+	return db
+}
+
 func TestBasic(t *testing.T) {
 	var a sdk.Address
 	a[0] = 'a'
@@ -50,6 +148,9 @@ func TestBasic(t *testing.T) {
 	db := &mocks.IndexerDb{}
 	db.On("GetSpecialAccounts", mock.Anything).Return(types.SpecialAddresses{}, nil)
 	db.On("Transactions", mock.Anything, mock.Anything).Return(outCh, uint64(8))
+	db.On("GetBlock", mock.Anything, mock.Anything, mock.Anything).Return(sdk.BlockHeader{
+		UpgradeState: sdk.UpgradeState{CurrentProtocol: string(protocol.ConsensusFuture)},
+	}, nil, nil)
 
 	account, err := AccountAtRound(context.Background(), account, 6, db)
 	assert.NoError(t, err)
@@ -78,3 +179,266 @@ func TestStaleTransactions1(t *testing.T) {
 	account, err := AccountAtRound(context.Background(), account, 6, db)
 	assert.True(t, errors.As(err, &ConsistencyError{}), "err: %v", err)
 }
+
+// Receiving the remainder of a closed account only undoes the closing amount.
+func TestCloseRemainderReceiver(t *testing.T) {
+	var a, b sdk.Address
+	a[0] = 'a'
+	b[0] = 'b'
+
+	account := models.Account{
+		Address:                     a.String(),
+		Amount:                      100,
+		AmountWithoutPendingRewards: 100,
+		Round:                       8,
+	}
+
+	stxn := &sdk.SignedTxnWithAD{
+		SignedTxn: sdk.SignedTxn{
+			Txn: sdk.Transaction{
+				Type:   sdk.PaymentTx,
+				Header: sdk.Header{Sender: b},
+				PaymentTxnFields: sdk.PaymentTxnFields{
+					Receiver:         b,
+					CloseRemainderTo: a,
+				},
+			},
+		},
+		ApplyData: sdk.ApplyData{ClosingAmount: 30},
+	}
+	db := makeMockDB(8, []idb.TxnRow{{Round: 7, Txn: stxn}})
+
+	account, err := AccountAtRound(context.Background(), account, 6, db)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(70), account.Amount)
+}
+
+// Inner transactions are rewound individually.
+func TestInnerPayment(t *testing.T) {
+	var a, b, app sdk.Address
+	a[0] = 'a'
+	b[0] = 'b'
+	app[0] = 'p'
+
+	account := models.Account{
+		Address:                     a.String(),
+		Amount:                      100,
+		AmountWithoutPendingRewards: 100,
+		Round:                       8,
+	}
+
+	inner := sdk.SignedTxnWithAD{
+		SignedTxn: sdk.SignedTxn{
+			Txn: sdk.Transaction{
+				Type:   sdk.PaymentTx,
+				Header: sdk.Header{Sender: app},
+				PaymentTxnFields: sdk.PaymentTxnFields{
+					Receiver: a,
+					Amount:   5,
+				},
+			},
+		},
+	}
+	root := &sdk.SignedTxnWithAD{
+		SignedTxn: sdk.SignedTxn{
+			Txn: sdk.Transaction{
+				Type:   sdk.ApplicationCallTx,
+				Header: sdk.Header{Sender: b, Fee: 2000},
+			},
+		},
+		ApplyData: sdk.ApplyData{
+			EvalDelta: sdk.EvalDelta{InnerTxns: []sdk.SignedTxnWithAD{inner}},
+		},
+	}
+	rows := []idb.TxnRow{
+		{Round: 7, Intra: 0, Txn: root, AssetID: 10},
+		{Round: 7, Intra: 1, Txn: &inner, Extra: idb.TxnExtra{RootTxid: "root"}},
+	}
+	db := makeMockDB(8, rows)
+
+	account, err := AccountAtRound(context.Background(), account, 6, db)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(95), account.Amount)
+}
+
+func TestRekeyRewind(t *testing.T) {
+	var a, c, d sdk.Address
+	a[0] = 'a'
+	c[0] = 'c'
+	d[0] = 'd'
+
+	account := models.Account{
+		Address:                     a.String(),
+		Amount:                      100,
+		AmountWithoutPendingRewards: 100,
+		AuthAddr:                    addrPtr(c),
+		Round:                       8,
+	}
+
+	rekey := func(round uint64, to sdk.Address) idb.TxnRow {
+		return idb.TxnRow{
+			Round: round,
+			Txn: &sdk.SignedTxnWithAD{
+				SignedTxn: sdk.SignedTxn{
+					Txn: sdk.Transaction{
+						Type:             sdk.PaymentTx,
+						Header:           sdk.Header{Sender: a, RekeyTo: to},
+						PaymentTxnFields: sdk.PaymentTxnFields{Receiver: a},
+					},
+				},
+			},
+		}
+	}
+	db := makeMockDB(8, []idb.TxnRow{rekey(3, d), rekey(7, c)})
+
+	acct, err := AccountAtRound(context.Background(), account, 6, db)
+	require.NoError(t, err)
+	require.NotNil(t, acct.AuthAddr)
+	assert.Equal(t, d.String(), *acct.AuthAddr)
+
+	acct, err = AccountAtRound(context.Background(), account, 2, db)
+	require.NoError(t, err)
+	assert.Nil(t, acct.AuthAddr)
+}
+
+func TestKeyregRewind(t *testing.T) {
+	var a sdk.Address
+	a[0] = 'a'
+
+	account := models.Account{
+		Address:                     a.String(),
+		Amount:                      100,
+		AmountWithoutPendingRewards: 100,
+		Status:                      "Online",
+		Participation: &models.AccountParticipation{
+			VoteParticipationKey: []byte{1},
+			VoteLastValid:        1000,
+		},
+		Round: 8,
+	}
+
+	keyreg := &sdk.SignedTxnWithAD{
+		SignedTxn: sdk.SignedTxn{
+			Txn: sdk.Transaction{
+				Type:   sdk.KeyRegistrationTx,
+				Header: sdk.Header{Sender: a},
+				KeyregTxnFields: sdk.KeyregTxnFields{
+					VotePK:   sdk.VotePK{1},
+					VoteLast: 1000,
+				},
+			},
+		},
+	}
+	db := makeMockDB(8, []idb.TxnRow{{Round: 7, Txn: keyreg}})
+
+	acct, err := AccountAtRound(context.Background(), account, 6, db)
+	require.NoError(t, err)
+	assert.Equal(t, "Offline", acct.Status)
+	assert.Nil(t, acct.Participation)
+
+	acct, err = AccountAtRound(context.Background(), account, 7, db)
+	require.NoError(t, err)
+	assert.Equal(t, "Online", acct.Status)
+	require.NotNil(t, acct.Participation)
+	assert.Equal(t, uint64(1000), acct.Participation.VoteLastValid)
+}
+
+func TestAssetOptInRewind(t *testing.T) {
+	var a, b sdk.Address
+	a[0] = 'a'
+	b[0] = 'b'
+	const assetID = 5
+
+	account := models.Account{
+		Address:                     a.String(),
+		Amount:                      100,
+		AmountWithoutPendingRewards: 100,
+		Assets: &[]models.AssetHolding{
+			{AssetId: assetID, Amount: 10, OptedInAtRound: uint64Ptr(7)},
+		},
+		TotalAssetsOptedIn: 1,
+		Round:              8,
+	}
+
+	axfer := func(sender sdk.Address, amount uint64) *sdk.SignedTxnWithAD {
+		return &sdk.SignedTxnWithAD{
+			SignedTxn: sdk.SignedTxn{
+				Txn: sdk.Transaction{
+					Type:   sdk.AssetTransferTx,
+					Header: sdk.Header{Sender: sender},
+					AssetTransferTxnFields: sdk.AssetTransferTxnFields{
+						XferAsset:     assetID,
+						AssetAmount:   amount,
+						AssetReceiver: a,
+					},
+				},
+			},
+		}
+	}
+	rows := []idb.TxnRow{
+		{Round: 7, Txn: axfer(a, 0), AssetID: assetID},
+		{Round: 8, Txn: axfer(b, 10), AssetID: assetID},
+	}
+	db := makeMockDB(8, rows)
+	db.On("AssetBalances", mock.Anything, mock.Anything).Return(
+		func(context.Context, idb.AssetBalanceQuery) (<-chan idb.AssetBalanceRow, uint64) {
+			ch := make(chan idb.AssetBalanceRow, 1)
+			ch <- idb.AssetBalanceRow{
+				Address:      a[:],
+				AssetID:      assetID,
+				Amount:       10,
+				CreatedRound: uint64Ptr(7),
+				Deleted:      boolPtr(false),
+			}
+			close(ch)
+			return ch, 8
+		})
+
+	acct, err := AccountAtRound(context.Background(), account, 7, db)
+	require.NoError(t, err)
+	require.Len(t, *acct.Assets, 1)
+	assert.Equal(t, uint64(0), (*acct.Assets)[0].Amount)
+	assert.Equal(t, uint64(1), acct.TotalAssetsOptedIn)
+
+	acct, err = AccountAtRound(context.Background(), account, 6, db)
+	require.NoError(t, err)
+	assert.Empty(t, *acct.Assets)
+	assert.Equal(t, uint64(0), acct.TotalAssetsOptedIn)
+	assert.Equal(t, config.Consensus[protocol.ConsensusFuture].MinBalance, acct.MinBalance)
+
+	// the caller's account is not modified
+	assert.Len(t, *account.Assets, 1)
+}
+
+// The last proposed round is found with a single query bounded by the creation round.
+func TestRewindLastProposed(t *testing.T) {
+	var a sdk.Address
+	a[0] = 'a'
+
+	account := models.Account{
+		Address:        a.String(),
+		Round:          8,
+		CreatedAtRound: uint64Ptr(3),
+		LastProposed:   uint64Ptr(7),
+	}
+	db := makeMockDB(8, nil)
+
+	account, err := AccountAtRound(context.Background(), account, 6, db)
+	require.NoError(t, err)
+	assert.Nil(t, account.LastProposed)
+
+	var filters []idb.BlockHeaderFilter
+	for _, call := range db.Calls {
+		if call.Method != "BlockHeaders" {
+			continue
+		}
+		if bf := call.Arguments.Get(1).(idb.BlockHeaderFilter); len(bf.Proposers) > 0 {
+			filters = append(filters, bf)
+		}
+	}
+	require.Len(t, filters, 1)
+	assert.Equal(t, idb.SortDescending, filters[0].Order)
+	assert.Equal(t, uint64(1), filters[0].Limit)
+	assert.Equal(t, uint64Ptr(3), filters[0].MinRound)
+	assert.Equal(t, uint64Ptr(6), filters[0].MaxRound)
+}
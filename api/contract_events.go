@@ -0,0 +1,475 @@
+package api
+
+import (
+	"bytes"
+	"crypto/sha512"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/algorand/indexer/v3/api/generated/v2"
+
+	"github.com/algorand/avm-abi/abi"
+	sdk "github.com/algorand/go-algorand-sdk/v2/types"
+)
+
+// selectorLength is the length of ARC-4 method selectors and ARC-28 event
+// selectors.
+const selectorLength = 4
+
+// maxMethodAppArgs is the number of application arguments available to ARC-4
+// method arguments, the last one holds a tuple of the remaining arguments.
+const maxMethodAppArgs = 15
+
+// methodReturnPrefix prefixes the log holding the return value of an ARC-4 method.
+var methodReturnPrefix = []byte{0x15, 0x1f, 0x7c, 0x75}
+
+// contractEventsConfig is the format of the contract events file. For example:
+//
+//	contracts:
+//	  - application-ids: [1234, 5678]
+//	    contract: ./amm.arc56.json
+//	  - application-ids: [9012]
+//	    methods:
+//	      - name: swap
+//	        args:
+//	          - type: axfer
+//	            name: input
+//	          - type: uint64
+//	            name: min
+//	        returns:
+//	          type: uint64
+//	    events:
+//	      - name: Swapped
+//	        args:
+//	          - type: uint64
+//	            name: in
+//	          - type: uint64
+//	            name: out
+type contractEventsConfig struct {
+	Contracts []contractEventsEntry `yaml:"contracts"`
+}
+
+// contractEventsEntry registers a contract for a list of applications. The methods
+// and events are read from an ARC-4 or ARC-56 contract description, or listed
+// directly.
+type contractEventsEntry struct {
+	ApplicationIDs []uint64 `yaml:"application-ids"`
+	Contract       string   `yaml:"contract"`
+	contractSpec   `yaml:",inline"`
+}
+
+// contractSpec holds the parts of an ARC-4 or ARC-56 contract description used
+// by the indexer.
+type contractSpec struct {
+	Methods []methodSpecEntry `yaml:"methods"`
+	Events  []eventSpecEntry  `yaml:"events"`
+}
+
+// argSpecEntry is a method or event argument description.
+type argSpecEntry struct {
+	Type string `yaml:"type"`
+	Name string `yaml:"name"`
+}
+
+// methodSpecEntry is an ARC-4 method description.
+type methodSpecEntry struct {
+	Name    string         `yaml:"name"`
+	Args    []argSpecEntry `yaml:"args"`
+	Returns struct {
+		Type string `yaml:"type"`
+	} `yaml:"returns"`
+}
+
+// eventSpecEntry is an ARC-28 event description, as found in the "events"
+// field of ARC-4 and ARC-56 contract descriptions.
+type eventSpecEntry struct {
+	Name string         `yaml:"name"`
+	Args []argSpecEntry `yaml:"args"`
+}
+
+// methodArg is an ARC-4 method argument. Only arguments which are not
+// transactions have an ABI type, reference types are encoded as uint8.
+type methodArg struct {
+	name     string
+	typeName string
+	abiType  *abi.Type
+}
+
+// contractMethod is an ARC-4 method which can be decoded from application calls.
+type contractMethod struct {
+	name       string
+	signature  string
+	selector   []byte
+	args       []methodArg
+	returnType *abi.Type
+}
+
+// contractEvent is an ARC-28 event which can be decoded from application logs.
+type contractEvent struct {
+	name      string
+	selector  []byte
+	argNames  []string
+	argTypes  []abi.Type
+	tupleType abi.Type
+}
+
+// ContractEvents holds the ARC-4 methods and ARC-28 events of each application.
+type ContractEvents struct {
+	appMethods map[uint64][]contractMethod
+	appEvents  map[uint64][]contractEvent
+}
+
+// MakeContractEventsFromFile loads the contract events file. Contract
+// description paths are relative to the directory of the file.
+func MakeContractEventsFromFile(filePath string) (*ContractEvents, error) {
+	f, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	var config contractEventsConfig
+	if err := yaml.Unmarshal(f, &config); err != nil {
+		return nil, fmt.Errorf("unable to parse contract events file (%s): %w", filePath, err)
+	}
+
+	c := &ContractEvents{
+		appMethods: make(map[uint64][]contractMethod),
+		appEvents:  make(map[uint64][]contractEvent),
+	}
+	for _, entry := range config.Contracts {
+		spec := entry.contractSpec
+		if entry.Contract != "" {
+			contractPath := entry.Contract
+			if !filepath.IsAbs(contractPath) {
+				contractPath = filepath.Join(filepath.Dir(filePath), contractPath)
+			}
+			contract, err := readContractSpec(contractPath)
+			if err != nil {
+				return nil, err
+			}
+			spec.Methods = append(spec.Methods, contract.Methods...)
+			spec.Events = append(spec.Events, contract.Events...)
+		}
+
+		for _, methodSpec := range spec.Methods {
+			method, err := makeContractMethod(methodSpec)
+			if err != nil {
+				return nil, err
+			}
+			for _, appID := range entry.ApplicationIDs {
+				c.appMethods[appID] = append(c.appMethods[appID], method)
+			}
+		}
+		for _, eventSpec := range spec.Events {
+			event, err := makeContractEvent(eventSpec)
+			if err != nil {
+				return nil, err
+			}
+			for _, appID := range entry.ApplicationIDs {
+				c.appEvents[appID] = append(c.appEvents[appID], event)
+			}
+		}
+	}
+	return c, nil
+}
+
+// readContractSpec reads an ARC-4 or ARC-56 contract description.
+func readContractSpec(filePath string) (contractSpec, error) {
+	f, err := os.ReadFile(filePath)
+	if err != nil {
+		return contractSpec{}, err
+	}
+
+	// The contract description is JSON, which the YAML decoder accepts as well.
+	var contract contractSpec
+	if err := yaml.Unmarshal(f, &contract); err != nil {
+		return contractSpec{}, fmt.Errorf("unable to parse contract description (%s): %w", filePath, err)
+	}
+	return contract, nil
+}
+
+// computeSelector returns the first bytes of the hash of a method or event signature.
+func computeSelector(signature string) []byte {
+	hash := sha512.Sum512_256([]byte(signature))
+	return hash[:selectorLength]
+}
+
+// makeContractMethod parses the argument types and computes the selector of a method.
+func makeContractMethod(spec methodSpecEntry) (contractMethod, error) {
+	if spec.Name == "" {
+		return contractMethod{}, fmt.Errorf("method without a name")
+	}
+
+	method := contractMethod{name: spec.Name}
+	typeNames := make([]string, 0, len(spec.Args))
+	for _, arg := range spec.Args {
+		ma := methodArg{name: arg.Name, typeName: arg.Type}
+		switch {
+		case abi.IsTransactionType(arg.Type):
+		case abi.IsReferenceType(arg.Type):
+			argType, _ := abi.TypeOf("uint8")
+			ma.abiType = &argType
+		default:
+			argType, err := abi.TypeOf(arg.Type)
+			if err != nil {
+				return contractMethod{}, fmt.Errorf("method %s: invalid argument type '%s': %w", spec.Name, arg.Type, err)
+			}
+			ma.typeName = argType.String()
+			ma.abiType = &argType
+		}
+		method.args = append(method.args, ma)
+		typeNames = append(typeNames, ma.typeName)
+	}
+
+	returnType := spec.Returns.Type
+	if returnType == "" {
+		returnType = abi.VoidReturnType
+	}
+	if returnType != abi.VoidReturnType {
+		argType, err := abi.TypeOf(returnType)
+		if err != nil {
+			return contractMethod{}, fmt.Errorf("method %s: invalid return type '%s': %w", spec.Name, returnType, err)
+		}
+		returnType = argType.String()
+		method.returnType = &argType
+	}
+
+	method.signature = fmt.Sprintf("%s(%s)%s", spec.Name, strings.Join(typeNames, ","), returnType)
+	method.selector = computeSelector(method.signature)
+	return method, nil
+}
+
+// makeContractEvent parses the argument types and computes the selector of an event.
+func makeContractEvent(spec eventSpecEntry) (contractEvent, error) {
+	if spec.Name == "" {
+		return contractEvent{}, fmt.Errorf("event without a name")
+	}
+
+	event := contractEvent{name: spec.Name}
+	typeNames := make([]string, 0, len(spec.Args))
+	for _, arg := range spec.Args {
+		argType, err := abi.TypeOf(arg.Type)
+		if err != nil {
+			return contractEvent{}, fmt.Errorf("event %s: invalid argument type '%s': %w", spec.Name, arg.Type, err)
+		}
+		event.argNames = append(event.argNames, arg.Name)
+		event.argTypes = append(event.argTypes, argType)
+		typeNames = append(typeNames, argType.String())
+	}
+
+	tupleType, err := abi.MakeTupleType(event.argTypes)
+	if err != nil {
+		return contractEvent{}, fmt.Errorf("event %s: %w", spec.Name, err)
+	}
+	event.tupleType = tupleType
+	event.selector = computeSelector(fmt.Sprintf("%s(%s)", spec.Name, strings.Join(typeNames, ",")))
+	return event, nil
+}
+
+// events returns the events registered for an application.
+func (c *ContractEvents) events(appID uint64) []contractEvent {
+	if c == nil {
+		return nil
+	}
+	return c.appEvents[appID]
+}
+
+// method returns the method of an application with the given selector.
+func (c *ContractEvents) method(appID uint64, selector []byte) *contractMethod {
+	if c == nil {
+		return nil
+	}
+	methods := c.appMethods[appID]
+	for i := range methods {
+		if bytes.Equal(methods[i].selector, selector) {
+			return &methods[i]
+		}
+	}
+	return nil
+}
+
+// decodeMethodCall decodes the ARC-4 method call of an application call
+// transaction. Nil is returned if the application arguments do not match a
+// registered method.
+func (c *ContractEvents) decodeMethodCall(stxn *sdk.SignedTxnWithAD, appID uint64) *generated.AbiMethod {
+	appArgs := stxn.Txn.ApplicationArgs
+	if len(appArgs) == 0 || len(appArgs[0]) != selectorLength {
+		return nil
+	}
+	method := c.method(appID, appArgs[0])
+	if method == nil {
+		return nil
+	}
+
+	values, err := method.decodeArgs(appArgs[1:])
+	if err != nil {
+		return nil
+	}
+
+	args := make([]generated.AbiMethodArg, 0, len(method.args))
+	for _, arg := range method.args {
+		a := generated.AbiMethodArg{
+			Name: strPtr(arg.name),
+			Type: arg.typeName,
+		}
+		if arg.abiType != nil {
+			value, err := methodArgValue(stxn, appID, arg, values[0])
+			if err != nil {
+				return nil
+			}
+			a.Value = &value
+			values = values[1:]
+		}
+		args = append(args, a)
+	}
+
+	result := &generated.AbiMethod{
+		Name:      method.name,
+		Signature: method.signature,
+		Args:      args,
+	}
+
+	// The return value is logged last, it is missing if the method is void.
+	logs := stxn.ApplyData.EvalDelta.Logs
+	if method.returnType != nil && len(logs) > 0 && strings.HasPrefix(logs[len(logs)-1], string(methodReturnPrefix)) {
+		encoded := []byte(logs[len(logs)-1][len(methodReturnPrefix):])
+		if decoded, err := decodeABIValue(*method.returnType, encoded); err == nil {
+			if value, err := method.returnType.MarshalToJSON(decoded); err == nil {
+				var returnValue interface{} = json.RawMessage(value)
+				result.Return = &generated.AbiMethodArg{
+					Type:  method.returnType.String(),
+					Value: &returnValue,
+				}
+			}
+		}
+	}
+	return result
+}
+
+// decodeArgs decodes the application arguments of the method arguments which
+// are not transactions. When there are more than 15 arguments, the last
+// application argument is a tuple of the remaining arguments.
+func (m contractMethod) decodeArgs(appArgs [][]byte) ([]interface{}, error) {
+	var types []abi.Type
+	for _, arg := range m.args {
+		if arg.abiType != nil {
+			types = append(types, *arg.abiType)
+		}
+	}
+
+	packed := len(types) > maxMethodAppArgs
+	if (packed && len(appArgs) != maxMethodAppArgs) || (!packed && len(appArgs) != len(types)) {
+		return nil, fmt.Errorf("expected %d application arguments, got %d", len(types), len(appArgs))
+	}
+
+	values := make([]interface{}, 0, len(types))
+	for i, encoded := range appArgs {
+		if packed && i == maxMethodAppArgs-1 {
+			tupleType, err := abi.MakeTupleType(types[i:])
+			if err != nil {
+				return nil, err
+			}
+			decoded, err := decodeABIValue(tupleType, encoded)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, decoded.([]interface{})...)
+			break
+		}
+		decoded, err := decodeABIValue(types[i], encoded)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, decoded)
+	}
+	return values, nil
+}
+
+// decodeABIValue decodes an ABI value. Logs and application arguments are
+// arbitrary bytes, so a panic of the decoder on malformed input is returned
+// as an error.
+func decodeABIValue(t abi.Type, encoded []byte) (value interface{}, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("unable to decode %s: %v", t.String(), r)
+		}
+	}()
+	return t.Decode(encoded)
+}
+
+// methodArgValue returns the JSON representation of a decoded method
+// argument, reference types are resolved to the referenced address or id.
+func methodArgValue(stxn *sdk.SignedTxnWithAD, appID uint64, arg methodArg, decoded interface{}) (interface{}, error) {
+	if !abi.IsReferenceType(arg.typeName) {
+		value, err := arg.abiType.MarshalToJSON(decoded)
+		if err != nil {
+			return nil, err
+		}
+		return json.RawMessage(value), nil
+	}
+
+	index, ok := decoded.(uint8)
+	if !ok {
+		return nil, fmt.Errorf("invalid %s reference", arg.typeName)
+	}
+	switch arg.typeName {
+	case abi.AccountReferenceType:
+		if index == 0 {
+			return stxn.Txn.Sender.String(), nil
+		}
+		if int(index) <= len(stxn.Txn.Accounts) {
+			return stxn.Txn.Accounts[index-1].String(), nil
+		}
+	case abi.AssetReferenceType:
+		if int(index) < len(stxn.Txn.ForeignAssets) {
+			return uint64(stxn.Txn.ForeignAssets[index]), nil
+		}
+	case abi.ApplicationReferenceType:
+		if index == 0 {
+			return appID, nil
+		}
+		if int(index) <= len(stxn.Txn.ForeignApps) {
+			return uint64(stxn.Txn.ForeignApps[index-1]), nil
+		}
+	}
+	return nil, fmt.Errorf("invalid %s reference %d", arg.typeName, index)
+}
+
+// decode returns the arguments of the event if the log was emitted by this event.
+func (e contractEvent) decode(log []byte) ([]generated.ApplicationEventArg, bool) {
+	if len(log) < selectorLength || !bytes.Equal(log[:selectorLength], e.selector) {
+		return nil, false
+	}
+
+	data := log[selectorLength:]
+	args := make([]generated.ApplicationEventArg, 0, len(e.argTypes))
+	if len(e.argTypes) == 0 {
+		return args, len(data) == 0
+	}
+
+	decoded, err := decodeABIValue(e.tupleType, data)
+	if err != nil {
+		return nil, false
+	}
+	values := decoded.([]interface{})
+	for i, argType := range e.argTypes {
+		value, err := argType.MarshalToJSON(values[i])
+		if err != nil {
+			return nil, false
+		}
+		arg := generated.ApplicationEventArg{
+			Type:  argType.String(),
+			Value: json.RawMessage(value),
+		}
+		if e.argNames[i] != "" {
+			arg.Name = strPtr(e.argNames[i])
+		}
+		args = append(args, arg)
+	}
+	return args, true
+}
@@ -0,0 +1,420 @@
+package api
+
+import (
+	"crypto/sha512"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/indexer/v3/api/generated/v2"
+	"github.com/algorand/indexer/v3/idb"
+	"github.com/algorand/indexer/v3/idb/mocks"
+	"github.com/algorand/indexer/v3/util/test"
+
+	"github.com/algorand/avm-abi/abi"
+	sdk "github.com/algorand/go-algorand-sdk/v2/types"
+)
+
+const testContractEventsFile = `
+contracts:
+  - application-ids: [10, 11]
+    contract: contract.arc56.json
+  - application-ids: [12]
+    events:
+      - name: Closed
+        args: []
+`
+
+const testContractDescription = `{
+  "name": "AMM",
+  "methods": [
+    {
+      "name": "swap",
+      "args": [
+        {"type": "axfer", "name": "input"},
+        {"type": "account", "name": "to"},
+        {"type": "application"},
+        {"type": "uint64", "name": "min"}
+      ],
+      "returns": {"type": "uint64"}
+    },
+    {
+      "name": "pause",
+      "args": [],
+      "returns": {"type": "void"}
+    }
+  ],
+  "events": [
+    {
+      "name": "Swapped",
+      "args": [
+        {"type": "uint64", "name": "amount"},
+        {"type": "string", "name": "memo"}
+      ]
+    },
+    {
+      "name": "Paused",
+      "args": []
+    }
+  ]
+}`
+
+func writeContractEvents(t *testing.T, config, contract string) string {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "contract.arc56.json"), []byte(contract), 0644))
+	path := filepath.Join(dir, "events.yml")
+	require.NoError(t, os.WriteFile(path, []byte(config), 0644))
+	return path
+}
+
+// makeEventLog ABI encodes an event log.
+func makeEventLog(t *testing.T, event contractEvent, args ...interface{}) string {
+	data, err := event.tupleType.Encode(args)
+	require.NoError(t, err)
+	return string(append(append([]byte{}, event.selector...), data...))
+}
+
+func TestMakeContractEventsFromFile(t *testing.T) {
+	ce, err := MakeContractEventsFromFile(writeContractEvents(t, testContractEventsFile, testContractDescription))
+	require.NoError(t, err)
+
+	for _, appID := range []uint64{10, 11} {
+		events := ce.events(appID)
+		require.Len(t, events, 2)
+		assert.Equal(t, "Swapped", events[0].name)
+		assert.Equal(t, []string{"amount", "memo"}, events[0].argNames)
+		hash := sha512.Sum512_256([]byte("Swapped(uint64,string)"))
+		assert.Equal(t, hash[:4], events[0].selector)
+		assert.Equal(t, "Paused", events[1].name)
+	}
+	require.Len(t, ce.events(12), 1)
+	assert.Equal(t, "Closed", ce.events(12)[0].name)
+	assert.Empty(t, ce.events(13))
+
+	var none *ContractEvents
+	assert.Empty(t, none.events(10))
+}
+
+func TestMakeContractEventsFromFileMethods(t *testing.T) {
+	c, err := MakeContractEventsFromFile(writeContractEvents(t, testContractEventsFile, testContractDescription))
+	require.NoError(t, err)
+
+	swap := c.method(10, computeSelector("swap(axfer,account,application,uint64)uint64"))
+	require.NotNil(t, swap)
+	assert.Equal(t, "swap", swap.name)
+	assert.Equal(t, "swap(axfer,account,application,uint64)uint64", swap.signature)
+	hash := sha512.Sum512_256([]byte(swap.signature))
+	assert.Equal(t, hash[:4], swap.selector)
+	require.Len(t, swap.args, 4)
+	assert.Nil(t, swap.args[0].abiType)
+	assert.NotNil(t, swap.args[1].abiType)
+
+	pause := c.method(11, computeSelector("pause()void"))
+	require.NotNil(t, pause)
+	assert.Nil(t, pause.returnType)
+
+	assert.Nil(t, c.method(12, swap.selector))
+	var none *ContractEvents
+	assert.Nil(t, none.method(10, swap.selector))
+}
+
+func TestMakeContractEventsFromFileErrors(t *testing.T) {
+	testcases := []struct {
+		name     string
+		config   string
+		contract string
+	}{
+		{"bad yaml", "contracts: [", testContractDescription},
+		{"bad contract", testContractEventsFile, "{"},
+		{"bad type", testContractEventsFile, `{"events": [{"name": "E", "args": [{"type": "uint7"}]}]}`},
+		{"missing name", testContractEventsFile, `{"events": [{"args": []}]}`},
+		{"bad method type", testContractEventsFile, `{"methods": [{"name": "m", "args": [{"type": "uint7"}]}]}`},
+		{"bad return type", testContractEventsFile, `{"methods": [{"name": "m", "args": [], "returns": {"type": "uint7"}}]}`},
+		{"missing method name", testContractEventsFile, `{"methods": [{"args": []}]}`},
+		{"missing contract", "contracts:\n  - application-ids: [1]\n    contract: missing.json\n", ""},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := MakeContractEventsFromFile(writeContractEvents(t, tc.config, tc.contract))
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestContractEventDecode(t *testing.T) {
+	ce, err := MakeContractEventsFromFile(writeContractEvents(t, testContractEventsFile, testContractDescription))
+	require.NoError(t, err)
+	swapped := ce.events(10)[0]
+	paused := ce.events(10)[1]
+
+	args, ok := swapped.decode([]byte(makeEventLog(t, swapped, uint64(5), "hi")))
+	require.True(t, ok)
+	require.Len(t, args, 2)
+	assert.Equal(t, "amount", *args[0].Name)
+	assert.Equal(t, "uint64", args[0].Type)
+	assert.Equal(t, json.RawMessage("5"), args[0].Value)
+	assert.Equal(t, json.RawMessage(`"hi"`), args[1].Value)
+
+	// Other events, ABI return values and truncated logs are not decoded.
+	_, ok = swapped.decode([]byte(makeEventLog(t, paused)))
+	assert.False(t, ok)
+	_, ok = swapped.decode([]byte{0x15, 0x1f, 0x7c, 0x75, 0, 0, 0, 0, 0, 0, 0, 5})
+	assert.False(t, ok)
+	_, ok = swapped.decode(swapped.selector[:2])
+	assert.False(t, ok)
+	_, ok = swapped.decode(append(append([]byte{}, swapped.selector...), 1, 2))
+	assert.False(t, ok)
+
+	args, ok = paused.decode([]byte(makeEventLog(t, paused)))
+	require.True(t, ok)
+	assert.Empty(t, args)
+}
+
+// encodeArgs ABI encodes each value with its type.
+func encodeArgs(t *testing.T, typeNames []string, values ...interface{}) [][]byte {
+	var encoded [][]byte
+	for i, typeName := range typeNames {
+		argType, err := abi.TypeOf(typeName)
+		require.NoError(t, err)
+		value, err := argType.Encode(values[i])
+		require.NoError(t, err)
+		encoded = append(encoded, value)
+	}
+	return encoded
+}
+
+func TestDecodeMethodCall(t *testing.T) {
+	c, err := MakeContractEventsFromFile(writeContractEvents(t, testContractEventsFile, testContractDescription))
+	require.NoError(t, err)
+	swap := c.method(10, computeSelector("swap(axfer,account,application,uint64)uint64"))
+	require.NotNil(t, swap)
+
+	stxn := test.MakeSimpleAppCallTxn(10, test.AccountA)
+	stxn.Txn.Accounts = []sdk.Address{test.AccountB}
+	stxn.Txn.ForeignApps = []sdk.AppIndex{20}
+	stxn.Txn.ApplicationArgs = append([][]byte{swap.selector},
+		encodeArgs(t, []string{"uint8", "uint8", "uint64"}, uint8(1), uint8(1), uint64(7))...)
+	stxn.ApplyData.EvalDelta.Logs = []string{"log", string(append([]byte{0x15, 0x1f, 0x7c, 0x75}, 0, 0, 0, 0, 0, 0, 0, 9))}
+
+	method := c.decodeMethodCall(&stxn, 10)
+	require.NotNil(t, method)
+	assert.Equal(t, "swap", method.Name)
+	assert.Equal(t, swap.signature, method.Signature)
+	require.Len(t, method.Args, 4)
+
+	assert.Equal(t, "input", *method.Args[0].Name)
+	assert.Equal(t, "axfer", method.Args[0].Type)
+	assert.Nil(t, method.Args[0].Value)
+	assert.Equal(t, "account", method.Args[1].Type)
+	assert.Equal(t, test.AccountB.String(), *method.Args[1].Value)
+	assert.Nil(t, method.Args[2].Name)
+	assert.Equal(t, uint64(20), *method.Args[2].Value)
+	assert.Equal(t, json.RawMessage("7"), *method.Args[3].Value)
+	require.NotNil(t, method.Return)
+	assert.Equal(t, "uint64", method.Return.Type)
+	assert.Equal(t, json.RawMessage("9"), *method.Return.Value)
+
+	// The sender and the called application are the first references.
+	stxn.Txn.ApplicationArgs = append([][]byte{swap.selector},
+		encodeArgs(t, []string{"uint8", "uint8", "uint64"}, uint8(0), uint8(0), uint64(7))...)
+	method = c.decodeMethodCall(&stxn, 10)
+	require.NotNil(t, method)
+	assert.Equal(t, test.AccountA.String(), *method.Args[1].Value)
+	assert.Equal(t, uint64(10), *method.Args[2].Value)
+
+	// Calls which don't match a method are not decoded.
+	invalid := map[string][][]byte{
+		"no args":         nil,
+		"unknown":         {{1, 2, 3, 4}},
+		"missing args":    {swap.selector},
+		"bad reference":   append([][]byte{swap.selector}, encodeArgs(t, []string{"uint8", "uint8", "uint64"}, uint8(2), uint8(0), uint64(7))...),
+		"bad encoding":    {swap.selector, {1}, {1}, {7}},
+		"selector prefix": {append(append([]byte{}, swap.selector...), 0)},
+	}
+	for name, appArgs := range invalid {
+		stxn.Txn.ApplicationArgs = appArgs
+		assert.Nil(t, c.decodeMethodCall(&stxn, 10), name)
+	}
+	stxn.Txn.ApplicationArgs = append([][]byte{swap.selector},
+		encodeArgs(t, []string{"uint8", "uint8", "uint64"}, uint8(0), uint8(0), uint64(7))...)
+	assert.Nil(t, c.decodeMethodCall(&stxn, 12), "other application")
+	var none *ContractEvents
+	assert.Nil(t, none.decodeMethodCall(&stxn, 10))
+}
+
+func TestDecodeMethodCallPackedArgs(t *testing.T) {
+	spec := methodSpecEntry{Name: "many"}
+	var typeNames []string
+	var values []interface{}
+	for i := 0; i < 17; i++ {
+		spec.Args = append(spec.Args, argSpecEntry{Type: "uint64"})
+		typeNames = append(typeNames, "uint64")
+		values = append(values, uint64(i))
+	}
+	method, err := makeContractMethod(spec)
+	require.NoError(t, err)
+	c := &ContractEvents{appMethods: map[uint64][]contractMethod{10: {method}}}
+
+	// The last 3 arguments are packed in the last application argument.
+	appArgs := append([][]byte{method.selector}, encodeArgs(t, typeNames[:14], values[:14]...)...)
+	appArgs = append(appArgs, encodeArgs(t, []string{"(uint64,uint64,uint64)"}, values[14:])...)
+	stxn := test.MakeSimpleAppCallTxn(10, test.AccountA)
+	stxn.Txn.ApplicationArgs = appArgs
+
+	decoded := c.decodeMethodCall(&stxn, 10)
+	require.NotNil(t, decoded)
+	require.Len(t, decoded.Args, 17)
+	for i, arg := range decoded.Args {
+		assert.Equal(t, json.RawMessage(strconv.Itoa(i)), *arg.Value)
+	}
+	assert.Nil(t, decoded.Return)
+}
+
+func TestLookupApplicationEvents(t *testing.T) {
+	ce, err := MakeContractEventsFromFile(writeContractEvents(t, testContractEventsFile, testContractDescription))
+	require.NoError(t, err)
+	swapped := ce.events(10)[0]
+	paused := ce.events(10)[1]
+
+	stxn := test.MakeSimpleAppCallTxn(10, test.AccountA)
+	stxn.ApplyData.EvalDelta.Logs = []string{
+		"not an event",
+		makeEventLog(t, swapped, uint64(5), "hi"),
+		makeEventLog(t, paused),
+	}
+
+	testcases := []struct {
+		name     string
+		params   generated.LookupApplicationEventsParams
+		expected []string
+	}{
+		{"all", generated.LookupApplicationEventsParams{}, []string{"Swapped", "Paused"}},
+		{"by name", generated.LookupApplicationEventsParams{Name: strPtr("Paused")}, []string{"Paused"}},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockIndexer := &mocks.IndexerDb{}
+			si := testServerImplementation(mockIndexer)
+			si.opts.ContractEvents = ce
+
+			ch := make(chan idb.TxnRow, 1)
+			ch <- idb.TxnRow{Round: 3, Intra: 1, RoundTime: time.Unix(1000, 0), Txn: &stxn}
+			close(ch)
+			var outCh <-chan idb.TxnRow = ch
+			mockIndexer.On("Transactions", mock.Anything, mock.MatchedBy(func(filter idb.TransactionFilter) bool {
+				return filter.ApplicationID != nil && *filter.ApplicationID == 10 && filter.RequireApplicationLogs
+			})).Return(outCh, uint64(5))
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			require.NoError(t, si.LookupApplicationEvents(c, 10, tc.params))
+			require.Equal(t, http.StatusOK, rec.Code)
+
+			var response generated.ApplicationEventsResponse
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+			assert.Equal(t, uint64(10), response.ApplicationId)
+			assert.Equal(t, uint64(5), response.CurrentRound)
+
+			var names []string
+			for _, event := range response.Events {
+				names = append(names, event.Name)
+				assert.Equal(t, uint64(3), event.Round)
+			}
+			assert.Equal(t, tc.expected, names)
+			if tc.params.Name == nil {
+				assert.Equal(t, uint64(1), response.Events[0].LogIndex)
+				assert.Equal(t, uint64(1), response.Events[0].IntraRoundOffset)
+				assert.Equal(t, []byte(swapped.selector), response.Events[0].Selector)
+				require.Len(t, response.Events[0].Args, 2)
+				assert.Equal(t, float64(5), response.Events[0].Args[0].Value)
+				assert.Equal(t, "hi", response.Events[0].Args[1].Value)
+			}
+		})
+	}
+}
+
+// Events of inner transactions with the same root are told apart by their offset.
+func TestLookupApplicationEventsInner(t *testing.T) {
+	ce, err := MakeContractEventsFromFile(writeContractEvents(t, testContractEventsFile, testContractDescription))
+	require.NoError(t, err)
+	paused := ce.events(10)[1]
+
+	mockIndexer := &mocks.IndexerDb{}
+	si := testServerImplementation(mockIndexer)
+	si.opts.ContractEvents = ce
+
+	ch := make(chan idb.TxnRow, 2)
+	for _, intra := range []int{2, 3} {
+		stxn := test.MakeSimpleAppCallTxn(10, test.AccountA)
+		stxn.ApplyData.EvalDelta.Logs = []string{makeEventLog(t, paused)}
+		ch <- idb.TxnRow{
+			Round:     3,
+			Intra:     intra,
+			RoundTime: time.Unix(1000, 0),
+			Txn:       &stxn,
+			Extra:     idb.TxnExtra{RootIntra: idb.OptionalUint{Present: true, Value: 1}, RootTxid: "ROOT"},
+		}
+	}
+	close(ch)
+	var outCh <-chan idb.TxnRow = ch
+	mockIndexer.On("Transactions", mock.Anything, mock.Anything).Return(outCh, uint64(5))
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	require.NoError(t, si.LookupApplicationEvents(c, 10, generated.LookupApplicationEventsParams{}))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var response generated.ApplicationEventsResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	require.Len(t, response.Events, 2)
+	for i, event := range response.Events {
+		assert.Equal(t, "ROOT", event.Txid)
+		assert.Equal(t, uint64(0), event.LogIndex)
+		assert.Equal(t, uint64(2+i), event.IntraRoundOffset)
+	}
+}
+
+func TestLookupApplicationEventsErrors(t *testing.T) {
+	ce, err := MakeContractEventsFromFile(writeContractEvents(t, testContractEventsFile, testContractDescription))
+	require.NoError(t, err)
+
+	testcases := []struct {
+		name   string
+		appID  uint64
+		params generated.LookupApplicationEventsParams
+		code   int
+	}{
+		{"no events", 13, generated.LookupApplicationEventsParams{}, http.StatusNotFound},
+		{"unknown name", 10, generated.LookupApplicationEventsParams{Name: strPtr("Closed")}, http.StatusBadRequest},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			si := testServerImplementation(&mocks.IndexerDb{})
+			si.opts.ContractEvents = ce
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			require.NoError(t, si.LookupApplicationEvents(c, tc.appID, tc.params))
+			require.Equal(t, tc.code, rec.Code)
+		})
+	}
+}
@@ -3,18 +3,24 @@ package api
 import (
 	"encoding/base32"
 	"encoding/base64"
+	"encoding/binary"
 	"errors"
 	"fmt"
 	"slices"
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
+	"github.com/algorand/avm-abi/apps"
+	"github.com/algorand/indexer/v3/accounting"
 	"github.com/algorand/indexer/v3/api/generated/v2"
 	"github.com/algorand/indexer/v3/idb"
 	"github.com/algorand/indexer/v3/util"
 
 	"github.com/algorand/go-algorand-sdk/v2/crypto"
+	"github.com/algorand/go-algorand-sdk/v2/protocol"
+	"github.com/algorand/go-algorand-sdk/v2/protocol/config"
 	sdk "github.com/algorand/go-algorand-sdk/v2/types"
 )
 
@@ -72,19 +78,54 @@ func decodeAddressRole(role *string, excludeCloseTo *bool, errorArr []string) (i
 		return idb.AddressRoleFreeze, errorArr
 	}
 
+	if lc == addrRoleAppAccount {
+		return idb.AddressRoleAppAccount, errorArr
+	}
+
+	if lc == addrRoleInnerReceiver {
+		return idb.AddressRoleInnerReceiver, errorArr
+	}
+
+	if lc == addrRoleHeartbeat {
+		return idb.AddressRoleHeartbeat, errorArr
+	}
+
 	return 0, append(errorArr, fmt.Sprintf("%s: '%s'", errUnknownAddressRole, lc))
 }
 
 const (
-	addrRoleSender   = "sender"
-	addrRoleReceiver = "receiver"
-	addrRoleFreeze   = "freeze-target"
+	addrRoleSender        = "sender"
+	addrRoleReceiver      = "receiver"
+	addrRoleFreeze        = "freeze-target"
+	addrRoleAppAccount    = "application-account"
+	addrRoleInnerReceiver = "inner-receiver"
+	addrRoleHeartbeat     = "heartbeat"
 )
 
 var addressRoleEnumMap = map[string]bool{
-	addrRoleSender:   true,
-	addrRoleReceiver: true,
-	addrRoleFreeze:   true,
+	addrRoleSender:        true,
+	addrRoleReceiver:      true,
+	addrRoleFreeze:        true,
+	addrRoleAppAccount:    true,
+	addrRoleInnerReceiver: true,
+	addrRoleHeartbeat:     true,
+}
+
+// decodeSortOrder converts the order parameter into an idb.SortOrder, or appends an error to errorArr
+func decodeSortOrder(order *string, errorArr []string) (idb.SortOrder, []string) {
+	if order == nil {
+		return idb.SortDefault, errorArr
+	}
+
+	if o, ok := sortOrderEnumMap[strings.ToLower(*order)]; ok {
+		return o, errorArr
+	}
+	return idb.SortDefault, append(errorArr, fmt.Sprintf("%s: '%s'", errUnknownSortOrder, *order))
+}
+
+var sortOrderEnumMap = map[string]idb.SortOrder{
+	"asc":  idb.SortAscending,
+	"desc": idb.SortDescending,
 }
 
 func decodeBase64Byte(str *string, field string, errorArr []string) ([]byte, []string) {
@@ -140,6 +181,51 @@ func decodeGroupID(str *string, field string, errorArr []string) ([]byte, []stri
 	return nil, errorArr
 }
 
+// decodeMethodSelector validates the input base64 string as an ARC-4 method
+// selector and decodes it, or appends an error to errorArr
+func decodeMethodSelector(str *string, field string, errorArr []string) ([]byte, []string) {
+	if str != nil {
+		data, err := base64.StdEncoding.DecodeString(*str)
+		if err != nil {
+			return nil, append(errorArr, fmt.Sprintf("%s: '%s'", errUnableToParseBase64, field))
+		}
+		if len(data) != selectorLength {
+			return nil, append(errorArr, fmt.Sprintf("%s: '%s'", errBadMethodSelectorLen, field))
+		}
+		return data, errorArr
+	}
+	return nil, errorArr
+}
+
+// decodeOnCompletion validates the input string and converts it to an OnCompletion, or appends an error to errorArr
+func decodeOnCompletion(str *string, errorArr []string) (*sdk.OnCompletion, []string) {
+	if str != nil {
+		for _, oc := range []sdk.OnCompletion{sdk.NoOpOC, sdk.OptInOC, sdk.CloseOutOC, sdk.ClearStateOC, sdk.UpdateApplicationOC, sdk.DeleteApplicationOC} {
+			if string(onCompletionToTransactionOnCompletion(oc)) == *str {
+				return &oc, errorArr
+			}
+		}
+		return nil, append(errorArr, fmt.Sprintf("%s: '%s'", errUnknownOnCompletion, *str))
+	}
+	return nil, errorArr
+}
+
+// decodeBoxName decodes a box name in goal-arg form, or appends an error to errorArr
+func decodeBoxName(str *string, field string, errorArr []string) ([]byte, []string) {
+	if str != nil {
+		appCallBytes, err := apps.NewAppCallBytes(*str)
+		if err != nil {
+			return nil, append(errorArr, fmt.Sprintf("%s: '%s': %v", errUnableToParseBoxName, field, err))
+		}
+		name, err := appCallBytes.Raw()
+		if err != nil {
+			return nil, append(errorArr, fmt.Sprintf("%s: '%s': %v", errUnableToParseBoxName, field, err))
+		}
+		return name, errorArr
+	}
+	return nil, errorArr
+}
+
 ////////////////////////////////////////////////////
 // Helpers to convert to and from generated types //
 ////////////////////////////////////////////////////
@@ -239,6 +325,52 @@ func stateDeltaToStateDelta(d sdk.StateDelta) *generated.StateDelta {
 	return &delta
 }
 
+func tealValueToTealValue(tv *sdk.TealValue) *generated.TealValue {
+	if tv == nil {
+		return nil
+	}
+	value := generated.TealValue{Type: uint64(tv.Type)}
+	switch tv.Type {
+	case sdk.TealBytesType:
+		value.Bytes = base64.StdEncoding.EncodeToString([]byte(tv.Bytes))
+	case sdk.TealUintType:
+		value.Uint = tv.Uint
+	}
+	return &value
+}
+
+// globalStateHistoryRowToChange converts a global state history row. The new
+// value is derived from the delta, a deleted key has no new value.
+func globalStateHistoryRowToChange(row idb.AppGlobalStateHistoryRow) generated.GlobalStateChange {
+	var newValue *sdk.TealValue
+	switch row.Delta.Action {
+	case sdk.SetBytesAction:
+		newValue = &sdk.TealValue{Type: sdk.TealBytesType, Bytes: row.Delta.Bytes}
+	case sdk.SetUintAction:
+		newValue = &sdk.TealValue{Type: sdk.TealUintType, Uint: row.Delta.Uint}
+	}
+	return generated.GlobalStateChange{
+		Round:            row.Round,
+		Txid:             row.Txid,
+		IntraRoundOffset: uint64(row.Intra),
+		Action:           uint64(row.Delta.Action),
+		OldValue:         tealValueToTealValue(row.Previous),
+		NewValue:         tealValueToTealValue(newValue),
+	}
+}
+
+func boxHistoryRowToEntry(row idb.AppBoxHistoryRow) generated.BoxHistoryEntry {
+	entry := generated.BoxHistoryEntry{
+		Round:   row.Round,
+		Deleted: row.Value == nil,
+	}
+	if row.Value != nil {
+		value := row.Value
+		entry.Value = &value
+	}
+	return entry
+}
+
 // rowData is a subset of fields of idb.TxnRow
 type rowData struct {
 	Round            uint64
@@ -246,11 +378,13 @@ type rowData struct {
 	Intra            uint
 	AssetID          uint64
 	AssetCloseAmount uint64
+	// ContractEvents are used to decode ARC-4 method calls, it may be nil.
+	ContractEvents *ContractEvents
 }
 
 // txnRowToTransaction parses the idb.TxnRow and generates the appropriate generated.Transaction object.
 // If the TxnRow contains a RootTxn, the generated.Transaction object will be the root txn.
-func txnRowToTransaction(row idb.TxnRow) (generated.Transaction, error) {
+func txnRowToTransaction(row idb.TxnRow, contractEvents *ContractEvents) (generated.Transaction, error) {
 	if row.Error != nil {
 		return generated.Transaction{}, row.Error
 	}
@@ -273,6 +407,7 @@ func txnRowToTransaction(row idb.TxnRow) (generated.Transaction, error) {
 		Intra:            uint(row.Intra),
 		AssetID:          row.AssetID,
 		AssetCloseAmount: row.Extra.AssetCloseAmount,
+		ContractEvents:   contractEvents,
 	}
 
 	if row.Extra.RootIntra.Present {
@@ -299,9 +434,68 @@ func txnRowToTransaction(row idb.TxnRow) (generated.Transaction, error) {
 	txn.Id = &txid
 	txn.Signature = &sig
 
+	// Only a root transaction has the whole inner transaction tree.
+	if row.Txn == nil || !row.Extra.RootIntra.Present {
+		setInnerTxnIDs(txn.InnerTxns, txid, nil)
+	}
+
 	return txn, nil
 }
 
+const innerTxnIDSeparator = "/inner/"
+
+// innerTxnID returns the identifier of the inner transaction at the path,
+// the position in each inner transaction list.
+func innerTxnID(rootTxid string, path []int) string {
+	parts := make([]string, 0, len(path))
+	for _, i := range path {
+		parts = append(parts, strconv.Itoa(i))
+	}
+	return rootTxid + innerTxnIDSeparator + strings.Join(parts, "/")
+}
+
+// parseInnerTxnID splits an inner transaction identifier into the root txid
+// and path.
+func parseInnerTxnID(id string) (string, []int, error) {
+	rootTxid, rest, found := strings.Cut(id, innerTxnIDSeparator)
+	if !found {
+		return "", nil, fmt.Errorf("%s: '%s'", errInvalidInnerTxnID, id)
+	}
+	var path []int
+	for _, part := range strings.Split(rest, "/") {
+		i, err := strconv.Atoi(part)
+		if err != nil || i < 0 {
+			return "", nil, fmt.Errorf("%s: '%s'", errInvalidInnerTxnID, id)
+		}
+		path = append(path, i)
+	}
+	return rootTxid, path, nil
+}
+
+// setInnerTxnIDs sets the identifier of every inner transaction in the tree.
+func setInnerTxnIDs(inners *[]generated.Transaction, rootTxid string, path []int) {
+	if inners == nil {
+		return
+	}
+	for i := range *inners {
+		innerPath := append(slices.Clone(path), i)
+		(*inners)[i].InnerId = strPtr(innerTxnID(rootTxid, innerPath))
+		setInnerTxnIDs((*inners)[i].InnerTxns, rootTxid, innerPath)
+	}
+}
+
+// innerTxnAtPath returns the inner transaction at the path, or false if
+// there is none.
+func innerTxnAtPath(txn generated.Transaction, path []int) (generated.Transaction, bool) {
+	for _, i := range path {
+		if txn.InnerTxns == nil || i >= len(*txn.InnerTxns) {
+			return generated.Transaction{}, false
+		}
+		txn = (*txn.InnerTxns)[i]
+	}
+	return txn, true
+}
+
 func hdrRowToBlock(row idb.BlockRow) generated.Block {
 
 	rewards := generated.BlockRewards{
@@ -820,9 +1014,12 @@ func signedTxnWithAdToTransaction(stxn *sdk.SignedTxnWithAD, extra rowData) (gen
 	}
 
 	if stxn.Txn.Type == sdk.ApplicationCallTx {
+		appID := uint64(stxn.Txn.ApplicationID)
 		if txn.ApplicationTransaction != nil && txn.ApplicationTransaction.ApplicationId == 0 {
 			txn.CreatedApplicationIndex = uint64Ptr(extra.AssetID)
+			appID = extra.AssetID
 		}
+		txn.AbiMethod = extra.ContractEvents.decodeMethodCall(stxn, appID)
 	}
 
 	return txn, nil
@@ -905,6 +1102,38 @@ func (si *ServerImplementation) appParamsToApplicationQuery(params generated.Sea
 	}, nil
 }
 
+// subscribeParamsToSearchParams converts the subscription parameters, which are the same as the search parameters.
+func subscribeParamsToSearchParams(params generated.SubscribeTransactionsParams) generated.SearchForTransactionsParams {
+	return generated.SearchForTransactionsParams{
+		Limit:               params.Limit,
+		Next:                params.Next,
+		Order:               (*generated.SearchForTransactionsParamsOrder)(params.Order),
+		NotePrefix:          params.NotePrefix,
+		TxType:              (*generated.SearchForTransactionsParamsTxType)(params.TxType),
+		SigType:             (*generated.SearchForTransactionsParamsSigType)(params.SigType),
+		GroupId:             params.GroupId,
+		Txid:                params.Txid,
+		Round:               params.Round,
+		MinRound:            params.MinRound,
+		MaxRound:            params.MaxRound,
+		AssetId:             params.AssetId,
+		BeforeTime:          params.BeforeTime,
+		AfterTime:           params.AfterTime,
+		CurrencyGreaterThan: params.CurrencyGreaterThan,
+		CurrencyLessThan:    params.CurrencyLessThan,
+		Address:             params.Address,
+		AddressRole:         (*generated.SearchForTransactionsParamsAddressRole)(params.AddressRole),
+		ExcludeCloseTo:      params.ExcludeCloseTo,
+		RekeyTo:             params.RekeyTo,
+		ApplicationId:       params.ApplicationId,
+		MethodSelector:      params.MethodSelector,
+		OnCompletion:        (*generated.SearchForTransactionsParamsOnCompletion)(params.OnCompletion),
+		ForeignAppId:        params.ForeignAppId,
+		ForeignAssetId:      params.ForeignAssetId,
+		BoxReference:        params.BoxReference,
+	}
+}
+
 func (si *ServerImplementation) transactionParamsToTransactionFilter(params generated.SearchForTransactionsParams) (filter idb.TransactionFilter, err error) {
 	var errorArr = make([]string, 0)
 
@@ -918,6 +1147,7 @@ func (si *ServerImplementation) transactionParamsToTransactionFilter(params gene
 
 	// String
 	filter.AddressRole, errorArr = decodeAddressRole((*string)(params.AddressRole), params.ExcludeCloseTo, errorArr)
+	filter.Order, errorArr = decodeSortOrder((*string)(params.Order), errorArr)
 	filter.NextToken = strOrDefault(params.Next)
 
 	// Address
@@ -938,6 +1168,13 @@ func (si *ServerImplementation) transactionParamsToTransactionFilter(params gene
 	// Group ID
 	filter.GroupID, errorArr = decodeGroupID(params.GroupId, "group-id", errorArr)
 
+	// Application calls
+	filter.MethodSelector, errorArr = decodeMethodSelector(params.MethodSelector, "method-selector", errorArr)
+	filter.OnCompletion, errorArr = decodeOnCompletion((*string)(params.OnCompletion), errorArr)
+	filter.ForeignAppID = params.ForeignAppId
+	filter.ForeignAssetID = params.ForeignAssetId
+	filter.BoxReference, errorArr = decodeBoxName(params.BoxReference, "box-reference", errorArr)
+
 	// Time
 	if params.AfterTime != nil {
 		filter.AfterTime = *params.AfterTime
@@ -990,16 +1227,34 @@ func (si *ServerImplementation) blockParamsToBlockFilter(params generated.Search
 	filter.MinRound = params.MinRound
 
 	// String
+	order, orderErrs := decodeSortOrder((*string)(params.Order), nil)
+	for _, e := range orderErrs {
+		errs = append(errs, errors.New(e))
+	}
+	filter.Order = order
 	if params.Next != nil {
 		n, err := idb.DecodeBlockRowNext(*params.Next)
 		if err != nil {
 			errs = append(errs, fmt.Errorf("%s: %w", errUnableToParseNext, err))
 		}
-		// Set the MinRound
-		if filter.MinRound == nil {
-			filter.MinRound = uint64Ptr(n + 1)
+		if filter.Descending() {
+			// Set the MaxRound, or an empty round range after round 0.
+			switch {
+			case n == 0:
+				filter.MinRound = uint64Ptr(1)
+				filter.MaxRound = uint64Ptr(0)
+			case filter.MaxRound == nil:
+				filter.MaxRound = uint64Ptr(n - 1)
+			default:
+				filter.MaxRound = uint64Ptr(min(*filter.MaxRound, n-1))
+			}
 		} else {
-			filter.MinRound = uint64Ptr(max(*filter.MinRound, n+1))
+			// Set the MinRound
+			if filter.MinRound == nil {
+				filter.MinRound = uint64Ptr(n + 1)
+			} else {
+				filter.MinRound = uint64Ptr(max(*filter.MinRound, n+1))
+			}
 		}
 	}
 
@@ -1095,3 +1350,199 @@ func (si *ServerImplementation) maxAccountsErrorToAccountsErrorResponse(maxErr i
 		Data:    &extraData,
 	}
 }
+
+// historyCursor is the position of the last history entry returned.
+type historyCursor struct {
+	round uint64
+	intra uint64
+}
+
+// after returns true if the row comes strictly before the cursor position.
+func (c historyCursor) after(row idb.TxnRow) bool {
+	return row.Round < c.round || (row.Round == c.round && uint64(row.Intra) < c.intra)
+}
+
+// encode packs the cursor into an opaque next token.
+func (c historyCursor) encode() string {
+	var b [16]byte
+	binary.LittleEndian.PutUint64(b[:8], c.round)
+	binary.LittleEndian.PutUint64(b[8:], c.intra)
+	return base64.URLEncoding.EncodeToString(b[:])
+}
+
+// decodeHistoryNext unpacks a next token created by historyCursor.encode.
+func decodeHistoryNext(s string) (historyCursor, error) {
+	b, err := base64.URLEncoding.DecodeString(s)
+	if err != nil {
+		return historyCursor{}, fmt.Errorf("decodeHistoryNext() decode err: %w", err)
+	}
+	if len(b) != 16 {
+		return historyCursor{}, fmt.Errorf("decodeHistoryNext() bad next token b: %x", b)
+	}
+	return historyCursor{
+		round: binary.LittleEndian.Uint64(b[:8]),
+		intra: binary.LittleEndian.Uint64(b[8:]),
+	}, nil
+}
+
+// amountCursor is the position of the last result returned when ordering by
+// amount.
+type amountCursor struct {
+	amount uint64
+	addr   sdk.Address
+}
+
+// encode packs the cursor into an opaque next token.
+func (c amountCursor) encode() string {
+	var b [40]byte
+	binary.LittleEndian.PutUint64(b[:8], c.amount)
+	copy(b[8:], c.addr[:])
+	return base64.URLEncoding.EncodeToString(b[:])
+}
+
+// decodeAmountNext unpacks a next token created by amountCursor.encode.
+func decodeAmountNext(s string) (amountCursor, error) {
+	b, err := base64.URLEncoding.DecodeString(s)
+	if err != nil {
+		return amountCursor{}, fmt.Errorf("decodeAmountNext() decode err: %w", err)
+	}
+	if len(b) != 40 {
+		return amountCursor{}, fmt.Errorf("decodeAmountNext() bad next token b: %x", b)
+	}
+	c := amountCursor{amount: binary.LittleEndian.Uint64(b[:8])}
+	copy(c.addr[:], b[8:])
+	return c, nil
+}
+
+// txnRowTxid returns the ID of the transaction in the row, or of its root
+// transaction for an inner transaction. row.Txn must not be nil.
+func txnRowTxid(row idb.TxnRow) string {
+	if row.Extra.RootIntra.Present {
+		return row.Extra.RootTxid
+	}
+	return crypto.TransactionIDString(row.Txn.Txn)
+}
+
+// txnRowToRegistration converts a keyreg transaction row.
+func txnRowToRegistration(row idb.TxnRow) generated.ParticipationRegistration {
+	state := accounting.TxnKeyreg(&row.Txn.Txn)
+	return generated.ParticipationRegistration{
+		Round:             row.Round,
+		Timestamp:         uint64(row.RoundTime.Unix()),
+		IntraRoundOffset:  uint64(row.Intra),
+		Txid:              txnRowTxid(row),
+		Status:            generated.ParticipationRegistrationStatus(state.Status),
+		Participation:     state.Participation,
+		IncentiveEligible: state.IncentiveEligible,
+	}
+}
+
+var txnStatsIntervalMap = map[generated.SearchForTransactionStatsParamsInterval]idb.TxnStatsInterval{
+	generated.Hour: idb.TxnStatsHour,
+	generated.Day:  idb.TxnStatsDay,
+}
+
+// encodeTxnStatsNext packs the start of the last bucket returned into an opaque
+// next token.
+func encodeTxnStatsNext(bucket time.Time) string {
+	var b [8]byte
+	binary.LittleEndian.PutUint64(b[:], uint64(bucket.Unix()))
+	return base64.URLEncoding.EncodeToString(b[:])
+}
+
+// decodeTxnStatsNext unpacks a next token created by encodeTxnStatsNext.
+func decodeTxnStatsNext(s string) (time.Time, error) {
+	b, err := base64.URLEncoding.DecodeString(s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("decodeTxnStatsNext() decode err: %w", err)
+	}
+	if len(b) != 8 {
+		return time.Time{}, fmt.Errorf("decodeTxnStatsNext() bad next token b: %x", b)
+	}
+	return time.Unix(int64(binary.LittleEndian.Uint64(b)), 0).UTC(), nil
+}
+
+func txnStatsRowToStats(row idb.TxnStatsRow) generated.TransactionStats {
+	return generated.TransactionStats{
+		Bucket:   uint64(row.Bucket.Unix()),
+		MinRound: row.MinRound,
+		MaxRound: row.MaxRound,
+		TxnCounts: generated.TransactionTypeCounts{
+			Pay:    row.Txns[idb.TypeEnumPay],
+			Keyreg: row.Txns[idb.TypeEnumKeyreg],
+			Acfg:   row.Txns[idb.TypeEnumAssetConfig],
+			Axfer:  row.Txns[idb.TypeEnumAssetTransfer],
+			Afrz:   row.Txns[idb.TypeEnumAssetFreeze],
+			Appl:   row.Txns[idb.TypeEnumApplication],
+			Stpf:   row.Txns[idb.TypeEnumStateProof],
+			Hb:     row.Txns[idb.TypeEnumHeartbeat],
+		},
+		InnerTxns:     row.InnerTxns,
+		TotalFees:     row.Fees,
+		ActiveSenders: row.Senders,
+	}
+}
+
+// minTxnFee returns the minimum fee of a transaction in microalgos for a
+// consensus version.
+func minTxnFee(proto string) (uint64, error) {
+	params, ok := config.Consensus[protocol.ConsensusVersion(proto)]
+	if !ok {
+		return 0, fmt.Errorf("consensus protocol %s not found", proto)
+	}
+	return params.MinTxnFee, nil
+}
+
+// feeHistogram counts the transactions paying each fee, and the ones paying
+// more than the minimum fee of their round.
+type feeHistogram struct {
+	counts   map[uint64]uint64
+	aboveMin uint64
+}
+
+func makeFeeHistogram() feeHistogram {
+	return feeHistogram{counts: make(map[uint64]uint64)}
+}
+
+func (h *feeHistogram) add(fees, counts []uint64, minFee uint64) {
+	for i, fee := range fees {
+		h.counts[fee] += counts[i]
+		if fee > minFee {
+			h.aboveMin += counts[i]
+		}
+	}
+}
+
+// distribution summarizes the histogram, percentiles use the nearest rank.
+func (h *feeHistogram) distribution() generated.FeeDistribution {
+	fees := make([]uint64, 0, len(h.counts))
+	var total uint64
+	for fee, count := range h.counts {
+		fees = append(fees, fee)
+		total += count
+	}
+	if total == 0 {
+		return generated.FeeDistribution{}
+	}
+	slices.Sort(fees)
+
+	percentile := func(p uint64) uint64 {
+		rank := max((p*total+99)/100, 1)
+		var seen uint64
+		for _, fee := range fees {
+			seen += h.counts[fee]
+			if seen >= rank {
+				return fee
+			}
+		}
+		return fees[len(fees)-1]
+	}
+	return generated.FeeDistribution{
+		TxnCount:         total,
+		MinFee:           fees[0],
+		MedianFee:        percentile(50),
+		P95Fee:           percentile(95),
+		MaxFee:           fees[len(fees)-1],
+		AboveMinFeeShare: float64(h.aboveMin) / float64(total),
+	}
+}
@@ -11,27 +11,53 @@ import (
 const (
 	errInvalidRoundAndMinMax           = "cannot specify round and min-round/max-round"
 	errInvalidRoundMinMax              = "min-round must be less than max-round"
+	errProposerStatsRange              = "the round range of proposer statistics is limited to"
 	errInvalidTimeMinMax               = "after-time must be less than before-time"
 	errUnableToParseAddress            = "unable to parse address"
 	errInvalidCreatorAddress           = "found an invalid creator address"
 	errUnableToParseBase64             = "unable to parse base64 data"
 	errBadGroupIDLen                   = "bad length for group ID"
+	errBadMethodSelectorLen            = "bad length for method selector"
+	errUnknownOnCompletion             = "unknown on-completion"
+	errUnableToParseBoxName            = "unable to parse box name"
 	errUnableToParseDigest             = "unable to parse base32 digest data"
 	errUnableToParseNext               = "unable to parse next token"
+	errUnknownStatsInterval            = "unknown statistics interval"
+	errUnknownSortOrder                = "unknown order [valid orders: asc, desc]"
+	errSubscribeDescending             = "subscriptions are sent oldest first, the order must be asc"
 	errUnableToDecodeTransaction       = "unable to decode transaction bytes"
 	errFailedSearchingAccount          = "failed while searching for account"
 	errFailedSearchingAsset            = "failed while searching for asset"
 	errFailedSearchingAssetBalances    = "failed while searching for asset balances"
+	errFailedSearchingAssetSupply      = "failed while searching for asset supply"
 	errFailedSearchingApplication      = "failed while searching for application"
 	errFailedSearchingBoxes            = "failed while searching for application boxes"
+	errFailedSearchingBalanceHistory   = "failed while searching for balance history"
+	errBalanceHistoryRewind            = "too many transactions to unwind before the first balance history entry"
+	errFailedSearchingAuthHistory      = "failed while searching for auth address history"
+	errFailedSearchingKeyregHistory    = "failed while searching for participation history"
+	errFailedSearchingProposers        = "failed while searching for proposer statistics"
+	errFailedSearchingTxnStats         = "failed while searching for transaction statistics"
+	errTxnStatsNotEnabled              = "transaction statistics were never enabled in the writer"
+	errFailedSearchingFeeStats         = "failed while searching for fee statistics"
+	errFeeStatsNotEnabled              = "fee statistics were never enabled in the writer"
+	errFeeStatsRange                   = "the round range of fee statistics is limited to"
+	errFailedSearchingStateHistory     = "failed while searching for global state history"
+	errFailedSearchingBoxHistory       = "failed while searching for application box history"
+	errBoxHistoryNotEnabled            = "box history was never enabled in the writer"
+	errWaitingForRound                 = "failed while waiting for round"
 	errFailedLookingUpHealth           = "failed while getting indexer health"
 	errNoApplicationsFound             = "no application found for application-id"
+	errNoContractEvents                = "no events registered for application-id"
+	errUnknownEventName                = "no event with this name registered for application-id"
 	ErrNoBoxesFound                    = "no application boxes found"
 	ErrWrongAppidFound                 = "the wrong application-id was found, please contact us, this shouldn't happen"
 	ErrWrongBoxFound                   = "a box with an unexpected name was found, please contact us, this shouldn't happen"
 	ErrNoAccountsFound                 = "no accounts found for address"
 	errNoAssetsFound                   = "no assets found for asset-id"
 	errNoTransactionFound              = "no transaction found for transaction id"
+	errNoTransactionGroupFound         = "no transactions found for group id"
+	errInvalidInnerTxnID               = "invalid inner transaction id"
 	errMultipleTransactions            = "multiple transactions found for this txid, please contact us, this shouldn't happen"
 	errMultipleAccounts                = "multiple accounts found for this address, please contact us, this shouldn't happen"
 	errMultipleAssets                  = "multiple assets found for this id, please contact us, this shouldn't happen"
@@ -41,8 +67,10 @@ const (
 	errMultiAcctRewind                 = "multiple accounts rewind is not supported by this server"
 	errOnlineOnlyRewind                = "simultaneously rewinding and searching for online accounts is not supported"
 	errOnlineOnlyDeleted               = "simultaneously searching for online and deleted accounts is not supported"
+	errBalanceOrderRewind              = "ordering accounts by balance is not supported when rewinding"
 	errRewindingAccount                = "error while rewinding account"
 	errLookingUpBlockForRound          = "error while looking up block for round"
+	errLookingUpRoundsAtTime           = "error while looking up rounds at time"
 	errBlockHeaderSearch               = "error while searching for block headers"
 	errTransactionSearch               = "error while searching for transaction"
 	errZeroAddressCloseRemainderToRole = "searching transactions by zero address with close address role is not supported"
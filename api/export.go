@@ -0,0 +1,376 @@
+package api
+
+import (
+	"encoding/base64"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/algorand/indexer/v3/api/generated/v2"
+	"github.com/algorand/indexer/v3/idb"
+)
+
+// Media types of the streamed export formats. See docs/Exports.md.
+const (
+	mimeNDJSON = "application/x-ndjson"
+	mimeCSV    = "text/csv"
+)
+
+// exportFlushRows is the number of rows written between flushes of an export.
+const exportFlushRows = 100
+
+// exportFormat is the format requested by the Accept header of a search.
+type exportFormat int
+
+const (
+	// exportNone means the regular paginated JSON response.
+	exportNone exportFormat = iota
+	exportNDJSON
+	exportCSV
+)
+
+// exportFormatFromAccept returns the first export format acceptable to the
+// client, or exportNone if JSON (or anything) is preferred to the exports.
+func exportFormatFromAccept(accept string) exportFormat {
+	for _, part := range strings.Split(accept, ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil {
+			continue
+		}
+		if q, ok := params["q"]; ok {
+			if v, err := strconv.ParseFloat(q, 64); err == nil && v == 0 {
+				continue
+			}
+		}
+		switch mediaType {
+		case mimeNDJSON:
+			return exportNDJSON
+		case mimeCSV:
+			return exportCSV
+		case echo.MIMEApplicationJSON, "application/*", "*/*":
+			return exportNone
+		}
+	}
+	return exportNone
+}
+
+// exportWriter streams rows of type T to the response in an export format.
+// The response header is written with the first row, so errors occurring
+// before it can still be returned as a regular error response.
+type exportWriter[T any] struct {
+	res     *echo.Response
+	format  exportFormat
+	columns []string
+	record  func(T) []string
+	csv     *csv.Writer
+	rows    int
+}
+
+func newExportWriter[T any](res *echo.Response, format exportFormat, columns []string, record func(T) []string) *exportWriter[T] {
+	return &exportWriter[T]{
+		res:     res,
+		format:  format,
+		columns: columns,
+		record:  record,
+	}
+}
+
+// started returns true once the response header has been written.
+func (ew *exportWriter[T]) started() bool {
+	return ew.res.Committed
+}
+
+func (ew *exportWriter[T]) start() error {
+	// An export may take much longer than a single page, so it must not be
+	// cut off by the server write timeout.
+	err := http.NewResponseController(ew.res).SetWriteDeadline(time.Time{})
+	if err != nil && !errors.Is(err, http.ErrNotSupported) {
+		return err
+	}
+
+	switch ew.format {
+	case exportNDJSON:
+		ew.res.Header().Set(echo.HeaderContentType, mimeNDJSON)
+	case exportCSV:
+		ew.res.Header().Set(echo.HeaderContentType, mimeCSV+"; charset=utf-8")
+	}
+	ew.res.WriteHeader(http.StatusOK)
+
+	if ew.format == exportCSV {
+		ew.csv = csv.NewWriter(ew.res)
+		return ew.csv.Write(ew.columns)
+	}
+	return nil
+}
+
+// write writes a single row.
+func (ew *exportWriter[T]) write(row T) error {
+	if !ew.started() {
+		if err := ew.start(); err != nil {
+			return err
+		}
+	}
+
+	var err error
+	switch ew.format {
+	case exportNDJSON:
+		// Encode appends the newline terminating the row.
+		err = json.NewEncoder(ew.res).Encode(row)
+	case exportCSV:
+		err = ew.csv.Write(ew.record(row))
+	}
+	if err != nil {
+		return err
+	}
+
+	ew.rows++
+	if ew.rows%exportFlushRows == 0 {
+		return ew.flush()
+	}
+	return nil
+}
+
+// close writes the response header if there were no rows and flushes the
+// remaining rows.
+func (ew *exportWriter[T]) close() error {
+	if !ew.started() {
+		if err := ew.start(); err != nil {
+			return err
+		}
+	}
+	return ew.flush()
+}
+
+func (ew *exportWriter[T]) flush() error {
+	if ew.csv != nil {
+		ew.csv.Flush()
+		if err := ew.csv.Error(); err != nil {
+			return err
+		}
+	}
+	ew.res.Flush()
+	return nil
+}
+
+// exportError reports an export failure. Before the response is started it is
+// a regular error response. Afterwards the status code is already sent, so the
+// connection is closed without terminating the response to prevent clients
+// from mistaking a partial export for a complete one.
+func (si *ServerImplementation) exportError(ctx echo.Context, started bool, err error) error {
+	if !started {
+		return indexerError(ctx, err)
+	}
+	if ctx.Request().Context().Err() == nil {
+		si.log.WithError(err).Error("export aborted")
+	}
+	panic(http.ErrAbortHandler)
+}
+
+// exportTransactions streams the transactions matching the filter.
+func (si *ServerImplementation) exportTransactions(ctx echo.Context, format exportFormat, filter idb.TransactionFilter) error {
+	ew := newExportWriter(ctx.Response(), format, transactionCSVColumns, transactionCSVRecord)
+	_, err := si.eachTransaction(ctx.Request().Context(), filter, func(_ idb.TxnRow, tx generated.Transaction) error {
+		return ew.write(tx)
+	})
+	if err == nil {
+		err = ew.close()
+	}
+	if err != nil {
+		return si.exportError(ctx, ew.started(), fmt.Errorf("%s: %w", errTransactionSearch, err))
+	}
+	return nil
+}
+
+// exportAccounts streams the accounts matching the options.
+func (si *ServerImplementation) exportAccounts(ctx echo.Context, format exportFormat, options idb.AccountQueryOptions, atRound *uint64) error {
+	ew := newExportWriter(ctx.Response(), format, accountCSVColumns, accountCSVRecord)
+	_, err := si.eachAccount(ctx.Request().Context(), options, atRound, ew.write)
+	if err == nil {
+		err = ew.close()
+	}
+	if err != nil {
+		var maxErr idb.MaxAPIResourcesPerAccountError
+		if !ew.started() && errors.As(err, &maxErr) {
+			return ctx.JSON(http.StatusBadRequest, si.maxAccountsErrorToAccountsErrorResponse(maxErr))
+		}
+		return si.exportError(ctx, ew.started(), fmt.Errorf("%s: %w", errFailedSearchingAccount, err))
+	}
+	return nil
+}
+
+// exportAssetBalances streams the asset balances matching the query.
+func (si *ServerImplementation) exportAssetBalances(ctx echo.Context, format exportFormat, query idb.AssetBalanceQuery) error {
+	ew := newExportWriter(ctx.Response(), format, assetBalanceCSVColumns, assetBalanceCSVRecord)
+	_, err := si.eachAssetBalance(ctx.Request().Context(), query, ew.write)
+	if err == nil {
+		err = ew.close()
+	}
+	if err != nil {
+		return si.exportError(ctx, ew.started(), fmt.Errorf("%s: %w", errFailedSearchingAssetBalances, err))
+	}
+	return nil
+}
+
+// exportLimit returns the number of rows to export for the limit parameter.
+// Zero means unlimited.
+func (si *ServerImplementation) exportLimit(limit *uint64) uint64 {
+	if si.opts.MaxExportLimit == 0 {
+		return uintOrDefault(limit)
+	}
+	return min(uintOrDefaultValue(limit, si.opts.MaxExportLimit), si.opts.MaxExportLimit)
+}
+
+/////////////////
+// CSV columns //
+/////////////////
+
+var transactionCSVColumns = []string{
+	"id",
+	"confirmed-round",
+	"round-time",
+	"intra-round-offset",
+	"tx-type",
+	"sender",
+	"receiver",
+	"amount",
+	"asset-id",
+	"application-id",
+	"close-to",
+	"close-amount",
+	"fee",
+	"group",
+	"note",
+}
+
+// transactionCSVRecord flattens a transaction into the transactionCSVColumns.
+func transactionCSVRecord(txn generated.Transaction) []string {
+	var receiver, amount, assetID, appID, closeTo, closeAmount string
+	switch {
+	case txn.PaymentTransaction != nil:
+		receiver = txn.PaymentTransaction.Receiver
+		amount = formatUint(txn.PaymentTransaction.Amount)
+		closeTo = strOrDefault(txn.PaymentTransaction.CloseRemainderTo)
+		if closeTo != "" {
+			closeAmount = uintPtrOrEmpty(txn.PaymentTransaction.CloseAmount)
+		}
+	case txn.AssetTransferTransaction != nil:
+		receiver = txn.AssetTransferTransaction.Receiver
+		amount = formatUint(txn.AssetTransferTransaction.Amount)
+		assetID = formatUint(txn.AssetTransferTransaction.AssetId)
+		closeTo = strOrDefault(txn.AssetTransferTransaction.CloseTo)
+		if closeTo != "" {
+			closeAmount = uintPtrOrEmpty(txn.AssetTransferTransaction.CloseAmount)
+		}
+	case txn.AssetConfigTransaction != nil:
+		assetID = uintPtrOrEmpty(txn.AssetConfigTransaction.AssetId)
+		if assetID == "" || assetID == "0" {
+			assetID = uintPtrOrEmpty(txn.CreatedAssetIndex)
+		}
+	case txn.AssetFreezeTransaction != nil:
+		assetID = formatUint(txn.AssetFreezeTransaction.AssetId)
+	case txn.ApplicationTransaction != nil:
+		appID = formatUint(txn.ApplicationTransaction.ApplicationId)
+		if appID == "0" {
+			appID = uintPtrOrEmpty(txn.CreatedApplicationIndex)
+		}
+	}
+
+	return []string{
+		strOrDefault(txn.Id),
+		uintPtrOrEmpty(txn.ConfirmedRound),
+		uintPtrOrEmpty(txn.RoundTime),
+		uintPtrOrEmpty(txn.IntraRoundOffset),
+		string(txn.TxType),
+		txn.Sender,
+		receiver,
+		amount,
+		assetID,
+		appID,
+		closeTo,
+		closeAmount,
+		formatUint(txn.Fee),
+		bytesPtrOrEmpty(txn.Group),
+		bytesPtrOrEmpty(txn.Note),
+	}
+}
+
+var accountCSVColumns = []string{
+	"address",
+	"amount",
+	"amount-without-pending-rewards",
+	"min-balance",
+	"pending-rewards",
+	"rewards",
+	"status",
+	"total-assets-opted-in",
+	"total-apps-opted-in",
+	"created-at-round",
+	"closed-at-round",
+	"deleted",
+}
+
+// accountCSVRecord flattens an account into the accountCSVColumns.
+func accountCSVRecord(account generated.Account) []string {
+	return []string{
+		account.Address,
+		formatUint(account.Amount),
+		formatUint(account.AmountWithoutPendingRewards),
+		formatUint(account.MinBalance),
+		formatUint(account.PendingRewards),
+		formatUint(account.Rewards),
+		account.Status,
+		formatUint(account.TotalAssetsOptedIn),
+		formatUint(account.TotalAppsOptedIn),
+		uintPtrOrEmpty(account.CreatedAtRound),
+		uintPtrOrEmpty(account.ClosedAtRound),
+		strconv.FormatBool(boolOrDefault(account.Deleted)),
+	}
+}
+
+var assetBalanceCSVColumns = []string{
+	"address",
+	"amount",
+	"is-frozen",
+	"opted-in-at-round",
+	"opted-out-at-round",
+	"deleted",
+}
+
+// assetBalanceCSVRecord flattens an asset holding into the assetBalanceCSVColumns.
+func assetBalanceCSVRecord(balance generated.MiniAssetHolding) []string {
+	return []string{
+		balance.Address,
+		formatUint(balance.Amount),
+		strconv.FormatBool(balance.IsFrozen),
+		uintPtrOrEmpty(balance.OptedInAtRound),
+		uintPtrOrEmpty(balance.OptedOutAtRound),
+		strconv.FormatBool(boolOrDefault(balance.Deleted)),
+	}
+}
+
+func formatUint(v uint64) string {
+	return strconv.FormatUint(v, 10)
+}
+
+func uintPtrOrEmpty(v *uint64) string {
+	if v == nil {
+		return ""
+	}
+	return formatUint(*v)
+}
+
+// bytesPtrOrEmpty encodes bytes with standard base64, like the JSON responses.
+func bytesPtrOrEmpty(b *[]byte) string {
+	if b == nil {
+		return ""
+	}
+	return base64.StdEncoding.EncodeToString(*b)
+}
@@ -72,211 +72,268 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+y9/5PbNpIo/q+g9Lmq2Dlxxp6sU7eu2rpy4s0nrji7Lo+Te+88eReIbEnYoQAuAI6k",
-	"5Pl/f4VugARJUKJmxmNvVX6yR8SXBrrR6G70l99nudpUSoK0Zvb891nFNd+ABY1/8YUBad3/CjC5FpUV",
-	"Ss6ez17kuaqlNWzD9TUUjBtGTZmQzK6BLUqVX7M18AL0F4ZVXFuRi4q7/qyuCm7BnLF3a4HfaEbG8xwq",
-	"axhnudpsODPgvlkoWCmMZWrJeFFoMAbM2Ww+g11VqgJmz5e8NDCfCQfZP2vQ+9l8JvkGZs/DAuYzk69h",
-	"w91KhIUNLs7uK9fEWC3kajaf7TJerpTmssiWSm+4dQulCWcf5qE515rv3d/G7kv3g2vr/ua0J5kohvvl",
-	"v7FmLoS14nYdgdr2n880/LMWGorZc6triMHvQv3BTexhHMz6d1numZB5WRfArObS8Nx9Mmwr7JpZt/u+",
-	"s8ObkuD22KEvasyWAsoCNzy5wX7ycRCPbuyRz36GTCu33f01fqs2CyEhrAiaBbVkZRUrYImN1twyB11E",
-	"S+6zAa7zNVsqfWSZBES8VpD1Zvb8/cyALEAj5nIQN/jfpQb4DTLL9Qrs7Jd5CndLCzqzYpNY2iuPOQ2m",
-	"Lt2xWOJq1sBW4gYkc73O2I+1sWwBjEv29rtv2VdfffVnRtvoDg5NNbqqdvZ4TQ0W3DENn6cg9e133+L8",
-	"l36BU1vxqipFjswheXxetN/Zq5dji+kOkiBIIS2sQNPGGwPps/rCfTkwTeh4bILarjNHNuOI5YGL5kou",
-	"xarWUDhqrA3Q2TQVyELIFbuG/SgKm2k+3glcwFJpmEil1PheyTSe/5PS6ULtMoJpQDRsoXbMfXOcdKV4",
-	"mXG9whWyL0DmyuHx+Q0va/jijH2nNBPSmrnHNfiGQtrnTy+++pNvovmWLfYWBu0WX//p+Yu//MU3q7SQ",
-	"li9K8Ns4aG6sfr6GslS+Q3OL9hu6D8//1//+77Ozsy/GkIH/nHZB5bXWIPN9ttLAkeOsuRzu4VtPQWat",
-	"6rJga36D5MI3eHX6vsz1peOBu3nGfhS5Vi/KlTKMe8IrYMnr0rIwMatl6Vi9G80fX+YkD61uRAHF3OFs",
-	"uxb5muXcbwi2Y1tRlo5qawPF2IakV3eEOzSdHFy32g9c0Oe7Ge26juwE7JB/DJf/153nkkUh3E+8ZCi6",
-	"MVPna5Q4Eaq1Kgsi+ugCYKXKeckKbjkzVjnGulTaSzzEdee+fyvwshwRWLDFvt9SFp3Rj/eZKp+G1ScF",
-	"1CBb8LKc+RvLCVp+yqz5gVeVyXDFmbHcQtymqlwLqSQkBJDjQq2HL8tLZSCz6ogAFmQq3LBIZIp37CRx",
-	"jL1bA8PJ3QcSRZGypePSZbln1iPAEQQLwteciSXbq5pt8eiU4hr7+9U4mt4wh3zbVUCsYo6bjRH3YDMS",
-	"pL1QqgQuPWlXxCInqE++7eemP4UlPIQCtdKqrpIi2Wulruuqq8Is9gw7sFcv/UYgdbCNFzQW3MDXf8rw",
-	"7nVcDUnSybtbrgsz999Zvuaa50SYjhwdbf309nVWS8OXwB6JMzhjf5mz8zn798fN4K6FH3mEVprFnCqW",
-	"EVxjMkn7lWgjU7LcDzfse/zI3Ee2LPnqjP3XGvxN4QRLR/pE63OmwdZaOhaGNFcoMEwq64RSyz05xjs/",
-	"suAYniPnwqukmeNr48JxGfg9NXdyMB68opGb56yAEvDwt8wZfzVWqz0eLMci50xVjhmq2g4vDVn4Yelz",
-	"/w5Bhjqq/cYrObLoUmxEwpLyI9+JTb1hst4sHMaWjSBtlUcNMkENLEdetujciBVfgWHg5GxBqjvO45Ds",
-	"cKiB5+vx25pgOnJBb/gu06qWxQQN1TKlYw3AVJCLpYCCNaOMwdJOcwweIU+Dp9WbI3DCIKPgNLMcAUfC",
-	"LoFWd225L4igCKtn7CcvU+FXq65BNqIXCRHAKg03QtWm6TQmirupD4veUlnIKg1LsRsCeem3w90Y1MYL",
-	"foHNeRbQXktuOOKzozBFE34s1qdkKSSMsL5jjI6YYqN6b9dOtujer+7M19ifxFlb7hnNObbqGKIjfKDS",
-	"qlLG21ePigWh9ecmF7SreAjJQMM17JPSZ//EE/02Ns+1+0J9D5NtM8MR7E1kPKRsxAznILOZxGiwUUb3",
-	"REJZdF/9LZK2L3f6T1Dg47nJupndydJMYwRSG9uK3kwfz6hlxCqjEQdsUazeOaVkKUqUC//huGHAbG2c",
-	"INLFbVBhjFhJbmsNz6/kl+4vlrFLy2XBdeF+2dBPP9alFZdi5X4q6afXaiXyS7Ea25QAa9LyjN029I8b",
-	"L21ptrtmuakpwufUDBV3Da9hr8HNwfMl/rNbIiHxpf6NlFCUgWy1nM1n68UYFIfk+3ZX884TxGLvpPyR",
-	"zcEhD92CyEBMpaQBJF3PZt/639xP7qLzD12RBHj+D6PQLtOO7fgeaCtoJH+LuP/+m4bl7Pns/ztvn9PO",
-	"qZs59xPOGruPHRNg6BRz6/kY8S/P2UgE3FS1JYEuxSKaM/2+ga0/Z4sWtfgH5JY2qAvGI9hUdv/YARzu",
-	"pPvbLdO5KSbuW/+G+Ij7SCJdhqLZcOSfjLclVXwlJC58zrZO5tjwa8cauFR2DZo5XICxQbgjHkjyXvNW",
-	"5SVEf0+fzVInJoFTc2ektlh77ZScS1Ry7gPFPUvUCbhOgfQH5hvMDzb2PklgdU+4P/iId3X1nleVKHZX",
-	"V7909GwhC9il8fFRkV2qVVZwy29Ho6uXrmuCQD9nGuo+kN4XAd0v8ZyAhYe9Ue9ru+75sN2Kx/7BWROn",
-	"4u5M1Riw3/CSy/xertOFH2oyhn8UUiAQ35OB8w80BzQ3W3kfKPa7ey8HmR7xJh/hP5CbOsPN0+idUXtf",
-	"KJ2EyAfWCHHK+9ikT0X4f1D8/VL8N6XKr+mt8F6uKzfcdJTi7H+gtLmhaPfuA6W3wuUEVB2eWe3uf161",
-	"S836jdoxIcmq64XZb9QOPlctduFgm34s1O6ln1Lpz1vBHH0PeefOJB5TB0s4p8LEWGPCMA0l3HBylzp2",
-	"e/W1V9rVKcfjG++aafCpS8Zoc2v4q9ZK3wPpBFtCD575bAPG8BWkX2jjNYaGUxYVAMYdBrcEfNb4Hnhp",
-	"19+u4SNwgWjsI7zgXWu8v4eN/aj3QfTOcGz90aqOGAe6w57IwqNpzOe+e58TL+o4KU3lth2c9nntdByb",
-	"05D8IbxXxQ9Sow4BHa7JLePes57elK/klXwJSyHRJ+j5lXR86HzBjcjNeW1Ae4PE2Uqx58wP+ZJbfiVn",
-	"8/7tN/bAi17AHpqqXpQiZ9ewT2GB3JMTIyjLy8jNKfJU9r4N7YvVkM5o1MyRg6pt5gMjMg3ozTeczTSu",
-	"LTgyuUwfmnXO/NjkgeMDL/z4adofuN0Ow74OeiQL2XUZdoj8m7LebYFvGRESqw0Y9uuGV++FtL+w7Kp+",
-	"8uQrYC+qqn0p+bX1b3aA4lvpvT674GIRhxnsrOYZep6lCcXUG7xpy5Jh267vtFYrzTfec63vlX1gp2ny",
-	"aTdVtCxc0SX1+jCP1M4eqvB3toZy6Mt9KmIiG82t8XLEznMguuhdFATHV1xIE3i7ESvpqNoHFCyA5e4u",
-	"h+KMvVoy5E3zTgydjwb0fK9hAMJQDEDsdptzibEB6IKEtM3lvv+Ib8Da4D7xFq5h/y5yyznRvcM7bfIj",
-	"F1tRu+FiETSsYssN2yh07cjJx4uGTJBgGphaSEsOaR1v+wEgke+7OxWRvXkseiByeeVVxValWnje0dDi",
-	"84YYQ59xNvHGAWDugUUklfVuNMKx1dMxG4uaOH11brw7HbKDa7o1cS2FNuhNDNyzeh4fhlvQmHd1Tjo8",
-	"ohSlNLr8dukodmEckHfj2IYu2SCtuIEMSrESi1Sobc47N2YItvA+ic0IhoklE9Ywb3JnqPYxzeUKnPRC",
-	"roO8pMDAJDQlNzZbA9d2AdweUi+bWKXOsl1/tnUsixwz525zYOfoWLid0CBhC4VbjdC+jff6HHkqdgB5",
-	"n8filvCE7q0fZ3qujZCZ37qEu3iQX5rdDQJqcB6OjxLCRd83gDF0amsw8qFgyod/DYKbaqeCpkHruJtO",
-	"9N550+njBjkmuyWlNbXsC2UD+SkJMjXO3JqHM9XGe9lybcNlF0YnvQehPmPo3+g3aVFiLFHkQezwzXXH",
-	"i5iCWcfAMWPicZi8u/b40K25CQcPQ/XCPTFJYv2I1ppDDpUYQdL3kUQRYhhqF1zPKRlBcKQM3pPBZdL9",
-	"6/hdXZaO29TyWqqtU2dOcYqcz+jIDwG+USimeD9wTxgexC9MhBoHx9+XS+QfGROycIcIfCiRj5xUuaAA",
-	"tZYnO16+cj+euQEcdbkBJo+QIls/JErYSpU0MPubis+fXJ0CpASB9woPY+MFE/0NaS0cxXSU2CnqRsg0",
-	"xeXhlDs9oSMVIWAY7roAkBS8w4ScM8fKbnjpWJlVJJo2g6RVrUcdLckL7ubxmAqWthDRilByOWlNJOvc",
-	"ZjWx+B+ATusmByBeqF2G4eNDWDEKvKqyhokpWe4p2LKvp+MIbj0qRwoJru3XsKc4T4w8xlOCFlnPPxZQ",
-	"KifpqwGFtYg6AvxdAb9HaA4L+ClqNkh6JHm3ZHcgWvjo1CPy9RjZPUIaugMAfft745HvLTxHjTJdUWZ4",
-	"8be34byNgCCOnGYjY0dxSPBdKkpicWR/h2a8xgf6TV/6SRrrOq0YNVl4O1SkC6VuP8eOciUNSFNj6I9V",
-	"uSrPBlY6AyWgGpF1BLLsGhLxUJehcWS3Y4/E0unnjyPtQMNKGAudUPkmaKUNwtpjeHnFrQXthv8/j/7z",
-	"+fsX2X/z7Lcn2Z///fyX3//04fGXgx8vPvzlL/+3+9NXH/7y+D//bTZyLYMTt9Uyvaa3SjUXHzZm2Liz",
-	"tAeH+kZZyFDvy254mXo7/A6VwqSk1Q3konwOYsTmjhNdwz4rRFmnafFvDRc09QI5tZAMuOOE3OZrlKY7",
-	"M7o2B2ZD/WdkVa/5vS1qAjlrh/ruwP8idN3jp4cOcYKYUmgfImd0Hw+wNZSMXkJJj5fjiZfooBWu4dmh",
-	"h4PBwSjC2Ie0xQiK8ZuHRkqupes/PL4KfKZHuUXYKEbSDFY01Qa0beJNYxF0yxsj10e39cSri+09fpS0",
-	"icV/vMPyhsNPXV4yQ940VwpE2CkmSxKABjSFZ8UPdoSeoneR4eXq1AjjFQ46IJFwSSlRZF/I7NFZk3xg",
-	"Gi6CrOBzIai6uQkPy7L3R3OQULZo7SnyY0utNnjYhrJmbIAcsUt0qK69Wnqz+sx9Q3px/BIVlKPvwMDL",
-	"H2D/s2uLWMVAcy9hTj0lrZkmaHlB47gTau725pWifD/iUcqnIJcxssccb/Q20XmhPvEElGplUjGhqzaO",
-	"OqaCBTilGHaQ17Y1e/aM6439/2FlwP5DQjrcNfI5oDyDhyUF3B8/1hGMvWnY48dEGK8qrW54mfm33CQ3",
-	"xxbhtfeBZa30gXr31xev33iI8QERuM4aXSO9EGzU6hif7VqcqKGOPAajISoYAPpXun/MFabzALzFxDM9",
-	"1dUJT56KaGPaR/zomPoH4WUQtU983vVOBrTEQ84GrcGHfA26/gX8hosymOwDjOmrgpbUunKcfFvEA9zZ",
-	"TyHyK7nzWDegTVIw7u6fTx/ChndW2FQzyU2zyxvSB+0IH4sXcCBtzYaSJxmmfHqalhacrotvDkj1G753",
-	"xEhW3yFDk/UGDUeZKUXq1a1rDWXYakRddkO5m/vQIO67mWBy64EVDZ7cvhB2MrZbC+V96Wop/lkDEwVI",
-	"6z5pPNK9U+4OdUgReWvlKPFATqkkH1A9wglPUYx88rA7La4Z5TbqkVN/Eo+VhDW/ngZ3d1GTWgvxUExE",
-	"IA7rSLGP0gDcl43lM1BR84DBZeeV+gTnxXjGgVQy4ngYnTsp/DPKLbByPGF00MN8crk0fzhJzYpz1d1J",
-	"uTLZUqvfUk662+G00YTUKz3oZOWod05GlCTRS+h6CxQ1Wf7uClKjVN8ZqP7t2DydtFnEW+SMHrIxsT5+",
-	"4ul6vI4wcjxvGMLC9dXVL6S3hmdkLumAfYvZyDsaVfqYxv7P5zR+e0w9zENzB98ueH6dWEzrdNh56LaK",
-	"hU5N3sYuds5Y5L/YtPUpECvQG2G77L5V2G4rONO0k0XmVkJGaoplY5+ZtDQqMUwtt1zakMjSMzDfOy63",
-	"sVXaWMzXnFxlAbnY8HLk9bBlkIVYCco8WRuI8ib6/qxSQloimkKYquR78uZsd+TVkj2ZR8zLI6EQN8KI",
-	"RQnY4im1WHCDskhrwApd3KpA2rXB5hcTmq9rWWgo7Nqn9DSKNToN2n/avLBgtwCSPcF2T//MHqETjRE3",
-	"8NhtnpcpZ8+f/hkfMOmPJ2lejpm1R3lrYOlpqkWXIerqLkU/WJrXUiWJk84MdZlyYrClZ/jHT8yGS75K",
-	"ZaA7AAv1ad0GevsgC0oKjSITEzY9L1juuE625madSsCfq81G2I13pzBq46ilTdJGc4VRyGWA2HUDTviI",
-	"Ds4VS9vuHtaglK428De+ge4mzhk3zNQO1NYm5pnbGfPpCQvKfdkaK3FLqGgBObyRSXkZlRSo7TL7jyhL",
-	"8tkYlNni6z8NIf2Gsiz7XMk013TAH3y7NRjQN9MOWhCTfB/2SCqZbRx7KB57Tt09c6PeUmm23PdnOTzk",
-	"VBnJjZIdpioecdk70Zc8MOAdKa5Zxklkd/LKHpwAa52ghp/evvbywEZp6JpuFyFkqSNZaLBawA1GdqRx",
-	"48a8Iwp0OWnz7wL9p32iD8JhJECFE5sS1SlIfrgd3j2+WfaY0qvU9TVAJeTqnNzDUZimUfti9ELJesQg",
-	"WiknOwleMmzEKr53u9yIoAdcz5cAJstVWUKe1FF7wV2uOau4oGMTZ30NfpUH5lqBBCPMyHV+dfV+tXYa",
-	"ivvsbuLIykLxBuTSZx7+iAbAR7IDrEA6uF+9PAb1YOCu14a3xh6z4XTczX7yfTDNNOUQz3De8V127Ry8",
-	"b0LOcZ9hmpv1w29tAujs2dOLUcCfPb0YgX3uE/Vefv/CjfAplkIJskfOaEjrHa6i/kGZ+kwSBsrolI8F",
-	"7tqalyEKFg/qErSvNtYBB81JWA8KgBkhr49GMRzNGvLWtx0PP7i6eq9l4RD5rQ80JG+y7os/4XbL8QUH",
-	"ZNFCn6+5GPHeNQDpCd0HN+Ol0laQexPAJ3Z1tJrn10lb6jv3xTTujhSTEDk+mskhb/iw8sb1eRdmSz1b",
-	"iw0YyzdVcu+scTtH1xpekW77mi6O9xvIlSyMo6AcGFTKrI/lXjDpqXYSJwt56juXTK405XVGMdyqXlz8",
-	"1C05mAGgC2OmlbJjgDo4O6kblLKM13btbuMQcQFYkqC/EooTRBU8SvN/xn50AlPIiM3Lcj9nwn5B42jv",
-	"A8vZBvR1CcxqAF/OoAR+A20FMBztC8Pe7URhsL5XCTuRq5Xm1VrkTOkCNJWGc83RLECd/HxPzpiPf/YR",
-	"I+92EpfXFIuJ10nLDHE+zeNcvOI5SaP9n7Ewk4HyBosYbBUBYdosEMYJ8t0yQLWl6MpCLJeA3AOXg1YF",
-	"7Nd+iGDCWmYYlNEM69f08DxgQGGZWfOLZ1+PEdrFs69TtHb5/YuLZ187oZ5LxuudKAXX+7iZazVni1qU",
-	"1l+PnN1AbpWOjSdCGgu8GNAWGdb8LCiWLWuZe4e9pktcce7y+xfPnl78z8Wzr70lLpolxIv7UESQN0Ir",
-	"6T4F22dDIX7KZjbYCWPNZ4KnMfHE7qSXThJ4evb04gHw5GY5FU8Pv6k7maE9JSUquX3McQ938ltqxHzc",
-	"T/e5v3cvbMh+GbhpCcUK9LyVbtxl1aa8ccq/0pGGtASKUHTChpBWq6LOgRKtXHaYcQSWGIDU1PiJvL2Q",
-	"gYY6ii2cwdLeCIKMvUILyRNS2KXqrhAZF9yAppCydqBHdONGcBnLNbrJodecXyoUj9PyUl2tNC9gmhMM",
-	"SgA/UY8mb0gY4UadNsDPrn1fAe/oiB3NK63gRHFEgJXZWkEmdZEfYL2j+v3bsQDe76g2oYaSIi2xfBfV",
-	"mhto70uAzEnXSYp3WjUml/NVezq1uAHcBU7sExkk1ggOknATg08xoGkLJ8KU5bzM65JUzQNy+TbnJb4U",
-	"toRdwtIqR3txrdH2qUi4uRYYoEB1r2g+7QSDqAdmLbsBvfctyDIXyky5c6N7nmND/SMr4QbKJODANQpk",
-	"36st23C5b3DhpmjBmEeBmQ3kpFmgBxFh+ydvNIzAp3PmCfIwkA4VI5tbxHiuQAtViJwJ+Q/wBz3Wx5Bi",
-	"qF6dklbIGstfamjhJvmJYYR4Pwp8SAE66dHu4OIWHGBtEJGEbQfbRaR9DUpnXQOBHWLZvcg4FacajCjq",
-	"NGRLzfMuZKcRoz+8b7mFc92g1twTXfaYV3PIDx26Pi33yKaHreEujfKpDl+ewqx4E3DIPA9PxCr4BGih",
-	"5YhhRlmFl3aUOqgZ2/sipp+RYHdkbNeiMz6lhQuZNU6fJQv+imZ0vj2x45bmglJCeR+wv8/qkdrBkZx5",
-	"DQBmK2y+zlLumR4AauFgeNu3iwynJOkCTyEsl5DbKTBg0BiVbRyFgj47KF4CLzBhQRv0R+F+fVAe/U0x",
-	"N7SJRB5pBGpnrcSDozw+of5CQyHHiP9nNZH2fb4H9JSZcAyCjONxn9wy38YTz6sm6QJnezC4K01wQ3RG",
-	"MDFO2gUgTFpAyfeHpsQG3UkbmTc4P9Cdg/lj3IVCwRSjMfBhan/ODk3umvQX3BzP4amIK4MNMKkSTpAh",
-	"lWsTweeTYiZ8epNvnI6Y+QbJOBTsb0oBtwV9H/jR+X6ysqTDakPs02Ab8EvYB/yjvxGf+PUNEdhK9LSS",
-	"X9KEEiVMTpJM0XyPIvIpzMWtfyr19F42AwU9fKh5GqsJ8LDlGXujwfh0WNEb8K+UuuNXhhX1ooKh3cqp",
-	"nwMdjOD9LaBpL+WzH3/12Z85bslij4yj4SL9WIdXL52C5R/qmFXJ+KfDQcbdxz+gaWlATEX2G2jFhFOO",
-	"lqC1aDOT5Lwsp2Ql+ZzJcxgQEmo1ppD41xtejgSfv4WKyNZh7t1fX7z2nnxjIeh5Ovr76uo9t47RYT82",
-	"moDvw3w2ki3n6ur9Aq9xyoVDZyrpEpEM/HC3o3Dd3edB79v5EY8llo42NMQnDQH6IQTFsooL753axt8P",
-	"d9YnYhhnQ4eMPC2C+4vwmQ5G+fr33Ky/47lVej/Mao1vw+l0Y1dX7x2+T9nip1+nZRAHQnqSd1FOs66R",
-	"tXFaRofhIKSr5SC3GcPkZmhC/p+LZ1+HPy+efR0nMmu+z+azgXGqxcX3C3zVI+E2uSfrRaWXaBOhpmiB",
-	"7uRjc4zo+5B10T8y+yLT10CpYTUs9sys1RYfq9BG2VbG7mFokVVpCxdKiG/arB0hbiJMzXwe/Ie3RiPM",
-	"T41YpeF+ilzgstkytWR/l/BObKD57RLzrfx9uTRgX7189OaHOfuG23w9Z/TbY1ZjGWTvC8ve/HDxiZY5",
-	"4laBbxY/wB65goRthsW4md0qMlEwqNawAc3LlnY+1QpGEXUxFVGIG8TThUdUjKANN04cwswy/f4/g8b4",
-	"q8efZPFjKx+u+7M4WUneGlWTSLhzr/EzZahmoX7zkMuMFt0oFlkT/Jsq5j6f+aIZ43XrE94AwmQbsdJo",
-	"OUiPOl7sI9L0EoraWIxu8IkZN2n1rtXOwnsQt+BFipWfOXkFk4/zW3e99wFrvzXidXCJXuy7oi2XLDhQ",
-	"O4mbAuaOCdljpQiurt6j3TyMKMicYQx6iaB8TX4WeIz9CUjnXp7qZcXTobXhvDUBgGhAosV1gDotvR5O",
-	"lsLGK1nADnTrw/NjS2uJSlzZmip7Ze2jcVq2JWHpYXkY5bZyUxgLxYEnqOWJohy5uZfcDTxl/PJ248sM",
-	"bX8y24JYrdMb++ZWQ98oOwFpNw+PtBQTx+RIJskfmk8Ne4iT9hxjEVX1L8Ugqmpc0u1ZBpaUwjQF1h3t",
-	"AuMspaqSDOVH9Bp54S435CcjatayVcIOloeK9DV0Z7YjLsd2TcT7uSTp0QBZAdUIuLY48Rj/R/qoDGrz",
-	"JoQeIzZVSfFq/loeZAM+KfVeGxP/8VMs3Hec+kePOIdbB1Hdf6D5bWE5nqT3cHj53+W3alOVMG46q7gk",
-	"49lSSP+UtF1zy3hRoOM0L1lwS1J5XuvWWbMfQP4zL0WBRhODed2lUhUmcq+skO4/mNJO1Zb+D1y7/1Ac",
-	"RPd/RFWRlcQNNUO8YDrgMFBIPjObz6jzLFB20oaSjKUYbEo3wW/AJ8aNonuXBCgwhrqtr3POc0t+jj6+",
-	"TILdKn2duNQWBp84O6EgIdl1mptybeuKk4GSN57SvqhFkye7Ac1DZmpDXvQdP+mjvBJ2laO10wEs9OZm",
-	"IoTN5il5A9q74yifZZ8cb6hwxyCFLfPgnbKmFKt+C0bVOoekXBN9bCQbI+SqxMJ5+MkXjCA7H/nv0KNz",
-	"W3nJH/ZTRZpQuwjdvNsSILnSBaNE0mAw0D5Qmn/alSsnhqCng08b5jj4t+5wBHUi5DY7XfQ5VjB1IAWJ",
-	"wq/g/8eUWZSb3+do0sCLAfBX8lTw4+pho5lbugocgRTn1PhoIC3obftIGdj2devDfLZupYaDQlirsYcs",
-	"Yse6tDJ88jDcMj/wpNiL4WNFgue0+u4B67ZBItPxk1EUIDMMucz1vrLqHNtgk3NjdZ1bQ1GX7ZyDQ+q4",
-	"DkXsHF3eQL52YrEygvxNrco03AAfc6NCUx78s8YXTHSldI1ZM0CKy02VYPp7TGOntxYBieM/KOkJRZWV",
-	"+1BLg7s93/DqPc3yC8vYW4K4qbSIYWgbs6pOD1eioVKgG17abNR85lVldslLG8vUaN4lp/mOGTtd14aU",
-	"8eTo+aewnjiYbk+CbsFQHLJcbG9huRjlHThvIzWROtI9Ujfelj6dHIL13U3yoOt425zYIVeI1jdtFfGm",
-	"RKwh/doYvobj1L7ccVmwaH7D8GwkAuTw6IK0en+bLMRilZlSnbC8S7G6dB2ObGloNtjTUm1BZ27eAygu",
-	"gyso5cuglp1KU02pVxqPPNmhYG4x5nYbQQOftBO+y/G9aMfuBQ3wMlcy68z+sFyH+GWG1JU1WQqP7B7f",
-	"dHevCoamU7kWMom9kKt0YQjH6K9h/3mYRRNhtgN8ogvuuF0ate6/NQ7nkRPg1jv5khNnV9A5UmTyw3xG",
-	"apevpnvgXNnuuWrjPzYi14qjs3xbkQoG6py3fGAAX7MbhwIA0n4WpBtQ53f7CppI1GEl3g2vgvEBjVJO",
-	"Izz7mPZ39raJwR2G5+VKWi6w3m5S06UIVCgrZFStm8jZZ0W+P0c3cy8W4PD+5BskoMiHKw5adv8fbpnV",
-	"8AlcCa5hn5ViCVaMOAyXy+ASEZqd3ZtMMZbjuOP7hma4kgLh27TQTt3ELyv8EmefZsRHMcmZCX8ZVoAF",
-	"vXGkuFZbtqnzNcrufNUo3+i7hOHUvYk6o4eMkt3s4T6/j6l4TgNR2r6S6xVo5jPpNTaL4Au14QLPSRut",
-	"2c+vhYE8POWXdixt84+Uyi/iXehFGOVwTmSHDmBcw/6cnOTw91swkvFU0COAYV7ojwjSndJLxynPj9Dr",
-	"dce/kGqBd1xsG/Dv0c/QwedNCCf6GQ6TuU9dHq4Dj0NtYLjO6Sko4r1NqLjt2qY6yQ43d8S39ZhL60hF",
-	"V+/5iHycXMwRPvbr01/p9RKNuF9+icN/+eXcu+7+etH97Kjtyy/TQSfJk3N/LrRNoUA3hp8uSR1RepCh",
-	"cw5d8obSYZGN111oSuKbdln28nzIgmGyQBRPOKY9gFJVkGyNtczjGxQTuGtY1SWn/BZCStCdTlMy9ZL6",
-	"b3fSm7rwz3c7mWobi5PYOtqOK5nyjG+Mwba7cRNTsPSqzZPtNseMxLcdsc1p3I5I2VHvMuJ3lJK1GTGk",
-	"VbrLmO/8GDhqbdcZL4p0WgSzkmiWC8Y4EbL8oQBMGO5SUxP14T5iTgnvHtHkcIF/1ryMXCPoUl/w/Bpk",
-	"gRHnjstRmX3FQJpae5OggxXHc6D4YVR8mZu2yS2eGPA5b7xe+NXVe52T9ddHHPtkjpg5mro6MaNwyFGH",
-	"a6669k7FHEsm7yRb7ubyDUNSLYzlO6Z6IRnrzbg7Uq+YVBz5jxUTQv+R4dvypu2LTLqWQFsUonczU3m8",
-	"R69ePmZYSnGsqF2kaB1fdlxhdRpE3oeuD0u/dsQpUCwBxtIt9LK0sCWMmIIPVvd0Y6FWSGU+sVU/RPYo",
-	"lBOzIn7PDRbx9M3b9HGfYyrEDpDs1cuknNEpnnNyxcj5bKVVnfbBWml8GuoHRzklAAUsUuApzuL84tnX",
-	"rBArMPaM/Rcmx/eRc4Oy6V1sMtGWY+edDwhYE2hGYpBPFhPNufYIHWTEEj5pDA7zCVzNg+f4La+1Jpxk",
-	"tMbfkVpo8xkKOZndpVLEvRoIQKzy6Xqw0EjEvDoxzveRGE5Iqzlx8kxhbMcQPor5aB2OdGDwGoYkNIHF",
-	"X8New20FoR+wM3nHHmRjJbIxrOt7Oy5WAh8JEy93ibP41UXWHscz9tr1ZiCXSjsVfVPjsyHsMEu/f72L",
-	"RV7MZU+Jm5wETmns5W+gFVogJFPeZaR/YJvNxpQ6PEflwPiUUQ6GpspOY+V8dImi0ZyAfEwK7vDcslpa",
-	"QbKU28afo12s3C3mgP6vtSgTVFAp993EcMyZVEyh213ckhIDtiUYCGafWK1DSA/LM+LSZUXab8BRAjq0",
-	"vI5cglvzRr7mcgXTyz8OaXLSAR8WQE4c83R1SreAFS1gdS9wfloXWKlGcgG5DyjTaKByCY0p7oHT6fL9",
-	"BuRtb6E31JscHXIQN6APqxN6RJ0IvQ8rERquYZ9ZlR4b6JWKxPxGb0OjK3HbaI3zESWqSahCbo2xIEwn",
-	"yMkbyxpfh6N30GB09fph46l5DfvWdSYiVq8i3kJlo2sxbVJ/JzbQKjkkFabkKTHpSiRdNa0kU1ZhYtlf",
-	"HFhOM8xhqjAjVOEjCQ7SxORH44hso1fjQabgW5yCyKcJEy8eCJ/eV9DN4oIuv43Vr5PREA0QZ+xlk2YV",
-	"PXwpsVqbe5WMY30/YMpV2ZRMEjoY0bgOxm90FUY3Ujw1CUbgG5Bs5NoMpSTfhOdLbDBmVQrNdkvQbbuU",
-	"ZSe0XOrf2oZDo1JoVlXopjBiHvOtjK3wpWkE077VeoFp8ZNyeesQXfH9LIiLs/nMLdz94xbm/l3q3ygs",
-	"pJw5yqqWs/lsvRg6RafPuSedDCdLpD2bdTXljrzZHNiWAo9YXQ+W9vfJnHxMTXP5nmoSjY32VMKs/eFb",
-	"XpbvdtL7Hg6zTuRjHru89C67YAyrJVk3fg3M/Nc5+3WpNIiVzHhVdf925OR+cafj14XaZTq4gppffXxy",
-	"43SMAYZOBPbRRCT+Zr64k8UXQNemZf/4qdelaa7a5vQG1Qw2Wa6K3acTwsZBb3FeUbKO195LvLn73AXp",
-	"Qw6CcdHz3fjBjBYUAk56MtkXhvXL01I+xWGB2gMe5EfvvsF6o1PP9Wp03WhXHAr4Imdcr2pKjfwA6zuy",
-	"ghGdkVei8HUvQkDLQBgmhltrKJjSPs24WPoc8mMFM48XG6fdq7w0LvJW6G6TOY4wh7lTK6HyNe2UzPIm",
-	"GiZKgnRFUSRXszP2ilKvokc4Xl1aWEhVw+6sH2sMbaEs8dmHKDprsBuFRZ41PuVNyWWkbA3o5pIIVPzc",
-	"KpF3mdUIshY7ervAzEjBpOYV14bCExoTe+T2HDXhxqkPc7ejdezxZAbV963v0/uk0vC4ElOPkN3YbURC",
-	"d5fSPgGZfTusa4+FJaWy/0LENqlI/NXVe6iQO3RLpMYBbFXV1I0vwe37P2uMG3dEhsOOvAdE9/cIgSx5",
-	"uM1MH13JO63Lan1MSox4M7jqGm3tdjcBvvK1goCjuUzJcn8ovCBxZrqyyxiXbqp5mDbo0fhVRvVSpy2x",
-	"H3/jVjgMwLmn9d2iuv+dS/r3BuhwjWN9O5GdKKO7U30wUIJX+iYw5lJtwdgOGwvRE710lB2JheLbxGYD",
-	"heAWyj1bclGesSf9BxSpmvEoxUsbGleBdgcepiRZ7kkm/T06plpErgEHVQuqU1o6DBKj1ZAFaSawXllQ",
-	"CdO6jXi9ki8opp+MMs1Q7mS3++Fr3/lKOmeJTk01YTPo1p/yxCrNtPgD6s2h2LkdH8h8CNMdpL3bFa4/",
-	"iuPvRurnxjgO78u+cO4dy1/TjAc29kCo55IXnQQWYXM7wZ9NFU3abV9IGImFb0dq9x7E5vIgNg+M30kr",
-	"vQ1WECpUleYz3mpCCby3YcepRyozwOFcOXTwh1NPOfyNx80k0giWoLsSR5j1AHmM+59wTq7VL8jzxOnI",
-	"psnWSuOeMc9C0iXfDJTLwM0CP27SFkWU5q5YuqA3vLqFA+wdmEcE8bijDoy66bTJ2r2EkSh6RyO0DkFO",
-	"aA5P+OMZYaauPYyeRiF+7efo5nEp1PY61LDBBPOtxp/Ajq963gi4bRV68n1CV6U4TUccix5vNmOv3Mi8",
-	"3PK9CQ8SLWWNDxd2lWqmJozhcQUKekVJ743OKbYCclEJkLZxVIvx4oh83IyfHtg/BziuQ6nxxU1jQ/LR",
-	"KpzlJd8ueH7de2IOL8y+RDqPbui532ZedkUhGjjY3Fybb8PYYUUNSqML7XiCtya1R8T9mi09wvRaf4yD",
-	"DC/KGHgiq2s6Ertr5htndetFdugyXC94QVlLm3xOpnMdkhC6IxccrW7aiBuJe6zSlLJeZNewzwpR1qPJ",
-	"T9aLaz/3D7B/6VsSSjfc5usIqPZQhnT+UZdb8I/1IpsUKdfN++qTQ45VvVwvjF/PJUDRoU16hnM9G4mz",
-	"L91/YRia9en95hO5nK0XVK1CjK3wRvgl/qwsvHoZY8st6hDGqMcnTnceHYchkUZ00WK6sylHzr/3ATp8",
-	"+OnZ6NSTT73o2NM042deKtlNITPifCBdI4fOH7m+7px6f1n7AdyR16w3akfHiNJIGSipvlIvi81YWKmB",
-	"0j/ZRzmLMVKqeUD3YXIFe8tloTbsu5AM+tHPb797zDSYurThkgnl2tzl4yH5tIVnRxde6aVf+WUUYtos",
-	"X0jvObASxurEy9vDF0Zwp+CYl65rtDS2ddUlxyyqYTNIKSS8FJQWQ3HCo/eIa0U3SSuYGsw/jDZALDW2",
-	"QBallkMQzIGpj3jyuTYlLRXd+e660mkHBpfrT0xnlqp3fj43AjpiSghuRIe5p/dQOJV9+m7EP/1Mt9MP",
-	"ST1sYwuj6mYOn6F0dk/wv5OWFU1Bwc1O+0C/6Y6y1Y3D8PcwPr2FcIroWfdonEZ3vHSsRtCzcBIDdj50",
-	"5KYJUfr3d0urGWF/eoLhZRkpP8taFqa3hU2GjUN+Rgd1H6/6hDYHXZbGlIKpmkAn00QXEhTwfKRmm2TE",
-	"GJWL1tnMqI2Pyx0kO4s8ziKrrhPNfb65fpqPlch9QvNTPaNeh74f5rNNXVpxy3F+DH3JVSt9HYqVvwpl",
-	"wXXBoLh49uzpnz9dGvwPEzH8OtrgoRurX5Z/LuFW5F09tlndBCYWUHm2UkOWNer6oFftI2rj6pCqRjXd",
-	"YwEBGc8XE16MvCPkYt+phKGc2l5a0f6E2XnX3Kxb1kkviMEwwSVnnl/1vdsxKDdyu3hoZ+yVyLNwNLI7",
-	"uSHGh+T+RzTjDKk9fJ/DmYvZLtHZVFb7Y8ShuiukRxxHfCHtAW5wVYITFFuGOprILuCD5Icw0aVYDc5h",
-	"PF56q7EBehkqJ9ZQkSAnmbbiG1obW6huEVIz2JTLGK5Umua1BuMgSrusrnUy19eh0gptGvXEK+NJCL3s",
-	"7WkvNxju26i4XF1/ohRyh2jg88ijlPZePix/j2VDYlNC45t0kP00kOOieFTw4xDpjxZv6Crj03OKtSa/",
-	"jsPwmE+3qYJX97soeUOcI5O9IvJvQwFQKJaUMc5ngCaXGF8itbtfd0+K8wHD6paK8gtJy3PbFmOcvfAj",
-	"zeazWpez57O1tZV5fn6+3W7PwjRnudqcrzDOOLOqztfnYSDMat5JXuq7sBIKt2wuebm3IjfsxZtXKHEL",
-	"WwJGGSLqolopz2cXZ08oEzhIXonZ89lXZ0/OntIRWSNdnFMFG/ffFcUGOqpBsfpVgUlgriGugeMuFqpy",
-	"g90vnjwJ2+B1zsjX4fwfhhjaND+SeBrc5O5GPMLH+ce0Q0telwld7yd5LdVWsr9qrYhBmnqz4XqPOUhs",
-	"raVhF0+eMLH0lXso9RZ3Mt/7GeXEmP3i+p3fXJxHLsC9X85/D45rovhw5PM5ryqTRW41R9sH36SDrRJx",
-	"9NP7TJoh5jlR2/R80a/nv3f9XT5MbHZO+YanNoWp05/74LjQtr94/Pv89/BO9eHAp3OfGOpQ95F96xS7",
-	"6f1szn+nUCQyh0QQpMfq3Aa/250HGq3G+gbHf/97j/3Ajm+qEpDzzD780lB9w7g89X+YN7+USl3XVfyL",
-	"Aa7zNXbfZUqLlZCOqrd8tQKd9fjO/wsAAP//0c9lcbX/AAA=",
+	"H4sIAAAAAAAC/+x9/3PcNrLnv4Kae1Wx84aS42xSb1219cqx440vduKynezdi3JnDImZwYpDcAFQ0iTn",
+	"//2quwEQJEEOR5Jl79v8ZGuILw2g0Wg0uj/9+yJXu1pVorJm8ej3Rc013wkrNP7FV0ZUFv5XCJNrWVup",
+	"qsWjxeM8V01lDdtxfS4Kxg2jokxWzG4FW5UqP2dbwQuhPzOs5trKXNYc6rOmLrgV5oS93UrDQo+M57mo",
+	"rWGc5Wq348wI+GZFwUppLFNrxotCC2OEOVksF+KqLlUhFo/WvDRiuZBA2T8aofeL5aLiO7F45AewXJh8",
+	"K3YcRiKt2OHg7L6GIsZqWW0Wy8VVxsuN0rwqsrXSO25hoNTh4v3SF+da8z38bey+hB+gLPzNaU4yWQzn",
+	"y31joS+kteZ2G5Ha1l8utPhHI7UoFo+sbkRMfpfq99Cxo3HQ649VuWeyysumEMxqXhmewyfDLqXdMguz",
+	"7yrDuqlKwBzbbacwW0tRFubEE92fYNf5OIkHJ/bAZ9dDplUphmN8onYrWQk/IhEG1LKVVawQayy05ZYB",
+	"dREvwWcjuM63bK30CeN1XcocGTXzy7bjNt8KQ+171kdGwIbaGiznZWmWTFaV0JkWuZAXQnfqhx/Vmop1",
+	"V4ZXBWwbbVeC9zp29Kp1VCCue2CJaALjdRJVs1s8+mVhRFUIjVxHtC2Wi7UW4jeRWa43AvZPYlqwu3ic",
+	"i+UiULb4dZli1bUVOrNyl1jJ545RtTBNCfO7xsXbCraRF6JiUOuEvWyMZSvBeMVeP3vCvvzyyz8z4hqQ",
+	"E9TV6ES0vcfTEJgOpJL/PIeHXz97gv2/cQOcWyqey5S0eNx+Z8+fjg2m20hi/8nKio3QNPHGiLRoegxf",
+	"JrrxFQ910NhtBpw2vrBh5+SqWstNo0UBm68xgkSRqUVVyGrDzsV+dAlDNx9O4KzEWmkxk0up8K2yadz/",
+	"R+XTlbrKKp6ahcdspa4YfGOyYhvFy4zrDY6QfSaqXME6PrrgZSM+O2HPlGaysmbp1lq4grKyj754+OWf",
+	"XBHNL9lqb8Wg3OrrPz16/Je/uGK1lpXlq1K4aRwUN1Y/2oqyVK5CUBr6BeHDo//1v//r5OTks7HFwH+O",
+	"O49h2rRYCy2qPDF3L5Q6b+rhqcF8HdgCHCe4PaaBDNCXRDTx5r/73HcncnrS80ZDsX220YKjmN/yajj5",
+	"r922NVvVlAXb8gvco3yH57yry6AuzTtO4wl7KXOtHpcbBcc+DaMQa96UlvmOWVOVwhhszclMWKJaqwtZ",
+	"iAJ0Ana5lfmW5dzNBJZjl7IsQVQ0RhRjM5Ee3QGRHCoBXdeaDxzQpzsZ7bgOzIS4QqE9HP63V+5oKgoJ",
+	"P/GS4fWAmSbf4q0GqdqqsiBuj3dtqXJesoJbzoxVcJqtlXZaNR11S1e/vVSxHBewYKt9v2RVdFo/XGfu",
+	"HciPPnkJ8jogL8uFUxPMYrlwXWbhB17XJsMRZ8ZyK+IydQ0lKlWJhNZ3+OLk6MvyUhmRWXVAyfd6ME5Y",
+	"pNrGM3acyg9iFTuHD3TdQc6u4Ggsyz2zbgGAIYICv2RyzfaqYZe4dUp5jvXdaICndwwW33YvuVYxOELG",
+	"mHswGQnWXilVCl451q7pXJpxRXdlP7U7uh/CXVzSQbOSmwp4NqkNzzqcaRNGRWhCpWauefg4eh3rkXBA",
+	"dIXSowr8ESRDGwli4efD5M68CGy0aibntnPdXe0ZVmDPnzpWw/3Hdk5/XnEjvv5ThmoNnBu46eEad8l1",
+	"YZbuO8u3XPOctj5seNi9P71+kTWV4WvB7skTccL+smSnS/bv90PjUMK1PDL4MJhjbxtE1+L9oa+0+zJV",
+	"lfvhhH2HHxl8ZOuSb07Y37bCncXSkHAhabJkWthGV6Jwu7pQwrBKWZarynK34eOZHxlwTM8ByeMMSxmc",
+	"HON3vtKfqFQceBFFWxGug0tWiFKgeG1ZGH81Vqs9/I4MumSqhuNGNXZ4LFeFa5Y+909pPLJGWTweyYFB",
+	"l3InE/bQl/xK7podq5rdikw7/n5olVsaPGa0YDmeFquOzlHzjTBMwPVRkgEO+2GS1lALnm/H9SGi6cC2",
+	"3PGrTKumKmYYXixTOr7Ymlrkci1FwUIrY7S03RyiR9itKjIjSpFbpY8Qa6s9e/z6SfYnRk0w38SSbhdS",
+	"my4DcL1pdqKyM+TL6Kh6xH4oabCT1XGL1NrIojXyjYyOJvRyYI0qcZXgddCW4AtybcTqJ+wnp8rjV6vO",
+	"RRU0ftJdBau1uJCqMaHSCI3Y9fSNr1JWZLUWa3k1JPKNmw7DOKMy7r7hF97JxVYbguaIOUZpijr8UByg",
+	"qgzeY0pB4zhmU/xYPQk1GUn5sZF0e0mZhCul6sVyoWoroQDKVtVY/K/gsANIQVwsFyS90/ZeVZWyEiPH",
+	"26HDjA6+YDW83CojeloqyPUG69Ol0JZ7Rn2OD72l6ICsV7oQCcH0RmnYewXJeTLpO1vgnuG+gmtfjpdB",
+	"VcIp5oSS0nCm0YdKXIYPdAHxV+hC1KIqDFPElqIqaiVBev0QdhXdTnB2Lngpi/bxo0fWPxroxG7Fnl0K",
+	"LSIlYdTASoNOsQQ3Oa62ydNrXWtVK+MeDg/eRXzpT+0y0o7iLq4jWpyLffLK25f3JL3CYx4uL9WdFlqh",
+	"hwPMPvPYWav+cTN51Mw6ZrBQRqpTwkIFX51ilX447dSfYaqN+6anr+xGT6jUhme1sano9fThni+M3GTU",
+	"4kByyc1bsISsZYlXpb/DWehXtjF0T4zX1ttNjNxU3DZaPDqrPoe/WMbeWF4VXBfwy45+etmUVr6RG/ip",
+	"pJ9eqI3M38jN2KR4WpPPklhtR/9Ae2m5Y6/CcFNd2KvxHmoOBc/FXgvog+dr/OdqjYzE1/o39/IJtW29",
+	"XiwX29UYFVNX3nZW887b+moPF9+RycEmp3QgFCCmVpURyLpOzL52v8FPuaqs8+CIlIbTvxvSLtq2Qe4J",
+	"bSW15F94H/2++Dct1otHi/9x2vqJnFI1c+o6XARjsx1TX2kXc+vkWHxqXtKtaFc3ljTwlIgIe/qXRfv6",
+	"3O2zXRa1+rvILU1Ql4x7Ylfb/X0g2J9JtzdbpnNSzJy3/gnxAeeRFPoMVYhhyz8ZZ8Cu+UZWOPAluwQV",
+	"bcfP8UmqUnYrdFArnGpPMhAbbfUQdz9w5/TJIrVjEmtqbryo7ap9eyFuaXUPvNafnf3C61oWV2dnv/as",
+	"goW4Si/EB11lcSGOYsbenKW48tNlnL4XRHdmw2Rcn49egP3oDdqPboeZOs8o11qmlqQ/JEjECL2JvT1R",
+	"8kJt/iUFSak2GTxvXo9HN0+h6n8jYXJ9Brpd5jliFe5WM7ut6brlzXYtGfuHZE3sipsLVWOE/YaXvMpv",
+	"5ThduaZmr/BLWUkk4jt6O/pjmf0yh6m8jSV2s3srGxnaO2IL/7G4qT0c/HpuvLS3taSzFvKOLQvY5W1M",
+	"0pumrsv9LUzVB2VXg1TOWgga0IETP7R4nSn7WLLiDyFxy0KisdvvpLFK3wb/o7//lpqbv64tCd9WVu//",
+	"WOKwxPF03nChnRp3e2t9tDLXpeCPpf4g+tw38DBLnmi3orFDc0csMRT/Y1HDotLs3caSXmstZyzVdM/q",
+	"6hbPhg9hT9vyanOMCFJXH1f8JMOzzs5+gQ8wbh8uFFxlW4fX1v1oj448NbdWaKj/f+7956NfHmf/xbPf",
+	"HmR//vfTX3//0/v7nw9+fPj+L3/5f92fvnz/l/v/+W+LRBTAp7OnlgtjuR5dkGforIMfqVEtcqULUTDH",
+	"HCfsCf3H+0hKcjjeSQNv6EtmFBKC4TLeGQfWIRQ3ziOIGnRefNKyHd9j8Et1XqnLas6NZWCxdPw7fAhx",
+	"gWzx0OeIjW/UFfMKA23g2xcc6mqsZ1kRkzqD3DfqSnyqlvgV0HaM3HjqulT60zaSj/oGgdcafkJavPiS",
+	"Jl41Jg3TohQXvLJR27P5mWZ1LqMCj2OAPK/iZYMxfKu10rfAOv49pEfPcrETxvCNSPuqxmP0BecMyhOM",
+	"MyxgCOji80wIeP67la2w2Wix4VYc4thnQjyVMKRVcwcvC5/gBkh4h8EiSGNlboLPJZb0f4GH9LLr67lY",
+	"zhMQOHl+ndOuhDPPsFxdCN16YJtA89JTmzzG5uzWwDvD48ZN2fEHTqQt/rVUK/fQ/d9La4wGRqrEv/AN",
+	"54NrY7wquqEHHb1sUhFzbTCr1An7QVn0Ur8kt3UILVWl8MoRHXRE2m0qbkdume8EL+32yVZ8gAte1PYB",
+	"Kl7F3tu3uHVzKy9EpsVGGqtnvYV3KHkdV/yXOr+igc+XUpNz15VWB54Fuv0fydKvnEf+bWk8H3TV4XQ9",
+	"PLHxiA5OHjV5zUn75CesEzUyjy27s3ckK7b9HTmjOEzz2L6VO/GpTyrKnolb27qjHu52ElGJ0oBb/tiT",
+	"ayYt23JTfQYBdKKKau6FPUTICJwSzKaxfFfDefyuLf6OyYoZkauqMMzIKhdM1CrfpruZGGrJUyPtR7iO",
+	"DBg+wY9MdoCeoumboGfWiI8e7G26XL1tIxH+qlVTf+psPQ4tcHb2y0bXoLH/1aEJ9O2dJ3du8JycAllF",
+	"U4DjYpfcIaPpnbgmX7W6KYXTuSBXH9jT9sOLgsDX4Od8y2W1PGrDdZAE5gruiN2OFtsREEPnhhlg0GKC",
+	"rr8NPvUdEA3zqNk+MLtxs9efvH8KBe2f8uI7asI5Ya8nTDjt3XfV5OfCxvKgveRiqC0vD+izx+7w62ln",
+	"8YxcU/ONaPiDGY9gxjsX6TcR2X/j0j5TGmf/wy8y6ZFWhP3owtVa8Ah4FQB9Y0Rqe5Vv2PQ3dE5rgYQy",
+	"GyuHeDYTZa7f9BF9rJYYE3TUvL/3wagUbbqSLxEXJRHvX3UhWgCjghUCFTG21mqHY0uBtCBOpNdXYDU1",
+	"zy2LWjeMjAkoDwN/o22WGLtnMtKbI/wB/Yge66TvcPqxnaoQQmfa+GIbXR3bdwi5Hu0wlCDgmx4aDmJi",
+	"OOFDUy7tDP8wmK72rTjQMGST5aJD8ZAFwnp3OMGvM1Pao3wQquRg5UaAZ3390elOh74TET6eHbnPtZRs",
+	"BGkatvLUDQk/L/3mf/zNc/Y/3/z4A/PgqyfstUctbRkbD2YtjCovWtU7oJsWLby3ZrI4YT+6qyqcAHHE",
+	"eGjvZLB4OIrkSrXR3Elcjs6DLbeMu/sw3XDPqrPqqVjLSsL3R2cVCLvTFTcyN6eNEdq54Z1sFHvEXJMQ",
+	"+3VWDbfjGM5ChKzO6mZVyhxQoFNLQ9CkiRaU5WUEwBWhlLp1agPHhyKaWs1AoKjGZg6JOtMCceaGvZmA",
+	"L4QtY+3JXpfMtY0/uvaZaz99bAwgNwdUTKORyqoLFwoL+YOyDj2EXzLiENYYYdi7Ha9/kZX9lWVnzYMH",
+	"Xwr2uK7bQNN3LbYpEDr7GXN21CoOFtcwE1dW8wwx0dKMYpodPvKXJcOyXdxUrTaa7xymWh+RdWKmqfN5",
+	"TyHRsHBEb6jW+2Xkgt5bKvydbUU5xHE9dmGiEJdrr8uBMJkJOPe3US4CvuGyMl77hfMCuNqBCQPKFjwW",
+	"ieKEPV8z1CKW/VQGsZLjBYA0hP8bA7blvIIGCQkIeZtX+z6WhhHWeuXhNQDfvI3QcY5EWXFwgvyA6l80",
+	"0Fzs/eJHAXaWnTIWAWMRmYqaTLBgmphGVpZQwTpIuwNCItzbbjaKUeTgCIyR1zXb4HM0yo7Ai48CM/o6",
+	"42LiFRBgbkFEJJ/Bu0jEh0aPpUYRk48fHbR3o002OaZrM1eAORTciXoeb4Zr8JgD4UzCtOE9U2lWKdvj",
+	"oxh4bcDeAV8KwUJFha+3opQbuUqlcsl558T0QMvOlhlaMPQUYZjzcHdA+Jpe8K1DFuMlmfOT1MCjQNam",
+	"KpnwbIvstNGwoT67RDUW4eSWMDmALiZzCTOhBfj8FA5nl8o4rLqRSHsgiAgXxTXp8dVb+2+6r52sMjd1",
+	"ibuF11/C7HoN0xuf4q30dhu+o1K+0erSoOG9YMoBCw+AzRvwfkuT1kF9mwmi03mlxkYO6W5JbU2t+0rZ",
+	"QH9KkkyFMxjzsKfGOLA7rm2Lyket0+UMqT5hCDPmJgmyK1gV4x7CenPdwT6sNlPkmDH12HfeHXu86bbc",
+	"+I1XLKNzYpbG+gEdRadwzYD+AVQZqhBDmH2P/0nJrjyemQcx88hl8K/SrGrKEqSNc8heLI/CJiMDZpNY",
+	"jAuFagp9DhdSIvEzEy0N0PHjeo3yI2OyKmATCQdyjZWMUbkkcPpWJoMsB788uLx9zoC7oIHZLaTY1jWJ",
+	"GrZSJTUMb6WvYqY8hshKSDxXuG8bD5jo75H7ParpqLETHrSs0hyX+10O94SOVoSEYaoLfMfGZpislgxE",
+	"2QUvRWXD21hoJH3Vute5JTnF3dwfu4KlzYM0ItRcjhoT1rjWaGL13xOdvptMUAzpWTBnTOLxAtaxrrMg",
+	"xFRV7gnntH9PxxZgPCrnweCxFXD9pxwPaGzBXYLO4E5+rESp0DNvwGHtQh0g/qaE3yI10wp+ipsNuxc0",
+	"75btJjKFHOx6RL8eY7t7yEM3IKBvegzAmM7Cc9Ao01Vlhgd/exq2j8ZOIqfFyNhWHDJ8l4uSqzgyvxP2",
+	"uVd97SdprOuUcpbxlbNDRXeh1OnHZMVyVRlRmQYReK3KVTk0vZINWaoq6yhkGVjkhj7wvnBkt2P3JMRg",
+	"7O9Ht4PIbB9uU8GF5m49M9CaBuq2WqfH9FqpcPBhYYaFO0O7c6ovlBUZ3vsyRH2efjLuaVqdhWSUy0mO",
+	"vEpiR4AaXMiySfPiD0EKmmaFklpWTHCQhNzmW/jQ7RHKTPSG95+RUb3gtzaoGeysYem7Df+T8HVPnk5t",
+	"4gQzpZZ9uDij8zgh1lAzeipKy4ezHWe6pI1WQMGTqYeDwcYofNtTt8WIivGTh1pKjqULvzY+CnyJRL1F",
+	"2giq3AxGNNcGdBlQ8mMVFJ3FqIUPbuuJRxfbe1wraROL+3iD4Q2bnzu8ZAbmeZE8uGDHmCxJARrwFO4V",
+	"19gBfiLQ1bE39If/gXlhbPf5vBvfyEq1uenTd4+ekRdwWcHTDHneqfXaiAThP+LvTFb+jRPXOZFYmiS5",
+	"cA+d8BVH6rIcUfobXg2TJC9D0gioQ2T49geFYRuKcr10vVlRlsBqXNu2RzNaO3wxfAcjUfaAldWhZiKH",
+	"DafmlTL4iOpbpZUdZmeCBTXHBPx/ezH5Jj7fFZUogu3n1iVNxXjaHrgu/Am1ZNdWNz2Py8Xixu/49m71",
+	"qDRSe+TJxJ4/PYJd0faryCLdZxB2Oc3Jsx0yEvsuZrTgsRGuOW2mIhzuHBE0w40jCKPYeeKDum188/zW",
+	"nDZ83cPeG8+J0cllA7/Rrd8s23wi+C0kwUV+RylJH5zvefhum7oULmVlWwqbpr9HvDn8oA6sX/S0nohR",
+	"VloYZ7MiHSuyT1BGzapvp+ipKiGz2rzj3F83qR5TTRDP0+aQ21NbRMJeR2NPaTDePyplrojfsEZM2x3F",
+	"pb2d9HqFTKNJWQoqd2DdSWdLwcvvxf5nKIurCrW9kWKuotVa+r2h0ButbrQ0N3ObSClPrsWDnE8w02Ns",
+	"j2EP9LzdcXI6cgfAeZzK7rFpM+LEXLASYFcVVyJvbPty1hP8QS+74+Ovp9LNOQ4PHlM4P/POmldBw/6Q",
+	"C8ZrcIfmZebcgZIXAizhHYbuWBFJb6i33z5+8cpR/N7lWsuCuSo9ECzUmqk+2bFowUc1xpCYGd4yvA25",
+	"fyt0/kBO7/dVLjGrZs/6CQet4yKamNYPrJM6D7YqW/cC+eZ6CDk/NRrilL9a+2aAVXouavyCy9K/+noa",
+	"RwLgcEitN+DRp0XcwI1d3SLXxBu3dSG0SdpWuvPnEsGx4ZnlJ9XMwl7oyob0Rjsgx+IBTKSf3FFm2JDP",
+	"L+IFMJdCD8T1LhqHHg4TenWzw1tVZkqZctzoPqgxLDV2hWx2GZzcU43AdzPj1aZHVtR4cvpM0mLQztZK",
+	"OYf+ppL/aASThagsfNItjEe7y2FTc+dmcm37WsLHyiDMxx1a2LDDY2xrLjPyjQYXWrnG8EbsG27V3HjC",
+	"2t3E0tY+Mg7VRHf3nTKzxW6uiZuhfzzzXBTewHnVcXQ6wv897nGglYz4rkf7rpLuJf4aqzKeIx6piuwa",
+	"LnN2Wj4cdc2KE3Hf6HJlsrVWv6Ui4S6H3UYdUq10o7MvR719MnJJCttnfPoOLVFIYX5TksKl+sZE9U/H",
+	"8PreJvxvF2d0k42p9dFH1g2aGBHkuN8QhItrCOjHe6v3ROIVbbAnqlrLTedGld6mUQlzSu2329TRPDR3",
+	"8MsVz88Tg2n91ju+UlYxX8kvg+muzgmLXOBDWZffvRZ6YGxtL2zXVZyp29kqc6shQ8WObkxxxLw0KtFM",
+	"U11yDIWkeiTAXG0TmbcvFQJtdYMk40e8XO54OeKA0grIQm4kpdVvjIggS1x9hsmSiWkKaeqS7ykgoJ2R",
+	"52v2YBkJL7cIhbyQBhyTscQXVALseDikYMDyVWBUorJbg8Ufzii+bapCi8JuDc2nUSzcaQiSLCSlF/ZS",
+	"iIo9wHJf/JndQz9MIy/EfZg8p1MuHn3xZ/SBoT8epGU5ZpUela1epKe5Fq2UVBUORddYWtautRC/iaP2",
+	"DFWZs2OwpBP4h3fMjld8I/RRtFCd1vOsNw8VFnIqUzqUcrnYCctB6mRbbraJ3h0Czc555Bm1A25p0+1S",
+	"X74V8jojcR3I8R8xRqZmadvdHQM7Jy3+P8CDWWcSl4wbhglCZGsTc8INbO6YY7mgtOatsRKnBLrwAa1k",
+	"Ul6zWsvK4rW5sevsP1i+5ZrnVmhzMkZltvr6T4kQ7A5ODKuOI/zOp1sLI/TFvI3m1SRXh92rVJXtJIjr",
+	"+05Sd/fcqMNtWiz3XSKnm5yrI0Er2TRX8UjK3oi/qokGb8hxYRhHsd3RI7tzBmx0ght+ev3C6QM7pUXX",
+	"dLvyUa8dzUILq6W4EMXo2kCbN1wCXc6a/JtQ/3G9vLxyGClQfseOqupvQtqnnhkGf/fw2eHcgy1dRMjP",
+	"jO9UtUHZ4uY9kYRp8hZ6nQhIqfOmxBiDzIzQ/xal0U5WTRyVHfu1iyAJvTVJXDnWCw+71w3PVNP6RsRB",
+	"7cY9NiSVamZjZoTHO5L60XiHnc23YI0p5D/cWBkXo2cDmtVpSY4Z5nAZl4zQceyWV/HKX2MmSAGeRY6s",
+	"vLrsldpr9DfnhG/ZyZVeMnJNvQZbuRaOme/rT+aYNjGuSYhrKhKp7GYOa3koTJaRJO1ts45o7TNnnzsG",
+	"szkpjft5ygbTQtDWYR4au1Va/hajhaxjU2XacwPMTeM3v9i0hDZvctoYvll7FzoYoDUjBI1F/96e2+AY",
+	"ktZlFjALkggjfvNENFuF4YLtE76bhlaQxf2y5zYYUnzB1hJSdSak9WLF2brGpvRo5bc3qgjA9OhhRfxR",
+	"Kcs0YCuI67yZTpo8Zy32QQFzDbCto6Avk/4SSZfBE/ZM6aTj39I5BUIFqppyHzzsHtju7xEfwcG+GOOs",
+	"1newncAJh45U6r2Elo2FukLK7a4BXMG8l5JhfHo3eja9d1vwncNhzPMeWcbo+6ZLlcPmC7adEcmC4d8h",
+	"t4DXvjNZAI84XL/rPAj9s++2saeJOTh2Ls3dGF3RHW/shU+p83MhalltTglOAV8OqNU+v65U1Yx4f9TK",
+	"ispKXjIsxGq+B04M9vYJqIa1ECbLVVmKPPkg1wNDguKs5pJO79iLXVYH+9qIShhpRmyXALC8hecY+Mys",
+	"ip+UsVEXAmvu3h7hCR/DhRYV0P386SGqBw13o5yc68lRSRN+cnXi8xz7HZ9lKAf0vnLlHZ1Q/u6nNkF0",
+	"9tUXD0cJ/+qLhyO0e1jHN989hhY+xlAI939kj7qvwe7W3yjz1TZqKKNdPgZ0ZxteetQ43KhroXULCxjI",
+	"CViZayGYkdX5QdSPgxk6X7uy48fD2dkvuipgIZ900Ee77s20tggmXsOp2sMTH4sbEekO4QP0+EZpSyEy",
+	"8MvHDQ22mufnSceRt/DFhPBgwvCIAoXNbIgo9CJ7BXXe+t5SPrrjp+zZ2S/WwMwdddya7SxY92FXVxV2",
+	"VkpDNuqoAsuV1ojFW1DOpB6O5NwpmcQU7tKYaaXsGKFAZwcMWimL9yRR2YBQIpgPHotHQrhaMAoZwemf",
+	"sJdKC+/FAJi2e9DjPzPuvkox45zthD4vBbNaYI4oI1gp+IULGQmtfWbY2ytZGAxEKcWVzMHtsN7KnCld",
+	"CE2XByiOb6BUyfX3ALNUiBZh5e1VhcMrlKAbWjxOGqbHxQmeiPGIl2R67/8MP+yMKC+EOWFvLxURYVrc",
+	"XQyL69RYNZbQyAq5RmxTS8NBiyvWaz9ENF3KsiQQk9CsG9NHCBDrc1hmtvzhV1+PMdrDr75O8dqb7x4/",
+	"/OprF/rFmytZSq73cTEotWSrRpbWHY+cXRB6b/RSLCtjBS8GvEVeBK4XVMvWTeViHtsqZI7Fd3so+9UX",
+	"D//vw6++dm4HUS8eX9FBd4nqQmpVwSfv6BE4xHUZehNX0ljziazTmHpiryqnnSTW6asvHt7BOkEvx67T",
+	"R4iOrDICN9fpecxxDq+qJ1SIsGFMz7e5dy74vDtOmpai2Ai9bLUbOKxaEH0wySod3ZDWAqUEKhuysloV",
+	"TS4ImPhNRxhHZMkBSR4GP6KNBCjKnpVIZEIKiiBzVrIHdEOvVHeEKLjEhdD9xEj36MSN6MIMB6JwIUJu",
+	"qKK4n9aXmnqjeSHmefyjBvAT1Qg4u76FC3VcAz9D+f4FvHNH7Ny80hecOCBVDGxLg4N8QvSO3u9fjwHe",
+	"PZOiLBBTjpDJrPJGn+Xg9r4WIgPtOsnxcKsGnud5Lmrg9Ih/4Bva8kB8ooA0oAt7TThgVhJmWtqdA2nK",
+	"cl7Sm4Sqsgm9/DLnJbpFtoxdirWF/CAxol/0FBe/3Kq1n4NMcyviGrDZgIP3rgS5Iciq3TdT+a5co6W4",
+	"EGWScME1KmTfqUu249U+rAV00ZKxjIDMAuV0s8BwCVrtn5yHREQ+7TPHkNNEwlKMTG4Rr3MttFSFzJms",
+	"/i7cRo/vY8gxKNtzVVlZNSCDmBYt3aQ/MXwF6Jsbhxygk+G7QBe3mMq/fXetxGVnteNsTl3sGmP5uSCy",
+	"XT+M26PWVAsjiyZN2VrzvEvZcczoNu9rbsWpDktrbokve8IrbPKpTdfn5R7b9FZrOEujcqojl+cIKx4A",
+	"upiT4YnnPZfXw5ccMcwoq/DQjqC2Q9su8CrJmTC9021DiU778EOLRHt8L5kPzjKj/e2F6fKcv5QQTirW",
+	"Fz7l73AGR7LwBALMpbT5NlPVKAFUAmh43beLDLsk7QJ3oVivRW7n0IAgS/ReN0oFfQYqngpeIMBnC5JF",
+	"8Fh9Uu79oBg0bSKVpzISb2etxoOt3D8i96Dv5yDz/6xm8r7DR10jGujhbeA+ON5JT5kr45jneQAp5Wwv",
+	"DM5KeDCN9ggCSafftH2nhSj5fqpLLNDtNOi83tObzhx8OYIDhSLHRx+7fddun011DkX6Aw7bc7gromfG",
+	"4UqqRMTXN+qKXBe9p5jLwDQXGASYme+QjVeuqX7mxk8lceOxKMZpGLo0RsnZ2S/4xc8D/vGxU1j2tnsP",
+	"YmYcmOQbdfXUjU7pNMsU4XuEYEkx/TD+udzTc+P0HHT30IzpVU2QhyVP4IXEOPj4yOH1HX4179g/GlB4",
+	"QnAOcJURhOKrKVfSx+aDkXWf9gd463J6CQcmizMScu5TBvxE6PPBeES0qaqrEdS4SGbPx8GC5iKCjnwV",
+	"P2aXR+oxdTjY9iEjcZvddTjYj8gQfn38/I7wRsiJlRQJ4SvuYOOYY7XHQyWcMP2g/+dPgXPcIy6zKgkE",
+	"Mg3Y2H0Yprl1DSK0129CKybXlKpLyxblGWxOcxCeP2XRNURG8FhiqUX89oKXI0Cer0VNIg1WDpA/HHOP",
+	"wXnmaSRNiPu0sD2wHpvy+BtBHj87+2WFKh5+b5PLDWMDkggIoDlJqA6fB7Wv53g6llc3mlAP1DEk6HuP",
+	"DsVqLl2YZotlOpxZB2o7fkRNGQDbBe4PwqHGjp75z4R4Gl3uE7H2vau/M6JE/iqqZnjn7j1MDREd18K9",
+	"pcGVPLiqSp30n+vx3UpdCIiAytAasOWpC9Yb+DnhHgW0ogP7jvwonWO5C8AEslwlJKWpis9MBLftrsDL",
+	"fnRnR3gXqoHUOGGCycpHMY1XQPOQ2u/kZiuMxe5hLmHG2E7mWgGHXsfDbScKyat0by/x2212Jkd6eqEu",
+	"b3dY9Z+/Svf056/sltVCo622FAPuvHnX4VFlKpgivQHmuMUlmLplmM56tvPdzkdMXmpr/xUxg1DmkDt6",
+	"MrIVv6DdqgtN20FhOhf7+WfB0/gIYCge2bsv3jH0PkfpvnSHzLuH7ldOYjtkjWDvvnznlCTjQ3vTp8mN",
+	"PdTv1cpA+PieBNb9BHTojhci0vPGHdlnowHSmfF+uVBlcY1aR7qHzh7G4e1wEwTWfv/oJ5FA7o1Bgtv2",
+	"jvexpnJjDtbBFXXMU/o7brbPeA7XomHiafSoS0Ofwlvr2dmvx8zuF1+nLTdAQrqTt1HmpO7TdMC1QEwJ",
+	"b9pU60EGJYYplLbcvVj7P+HRLkqXFL4vlovBk16rpXy3Ql8oMgkm52S7qvUaX5KoKL7bd7I+wQn9nc/t",
+	"5lzzPqNkAeeCElBqAckit+rSZcKXxiVpG0qn7Sqr0++CaFd71eYG8NA6vmu2E8anOrtbcwTS/IWRmzTd",
+	"X6B+/CZMmVqzHyvxVu5E+O0NZnUggff86b1X3y/ZN9zm2yWj3yB6vBAhUQ979f3DjzTMEWdU9PT4XuxR",
+	"XwaZauy+FMxeKnrYYaLeip3QcDT5QX+sEYwu1MO5C4Vrg+v00C1UvEA7bqzQlL+iX/9noRGi6/5HGfzY",
+	"yIfj/iR2VlK2Cl7a7RPIc5vSi7b4mfLgMvKONAkpUzgM20HzxSoL+JBRgcimJbRWugv0fxDzVZpsJzca",
+	"31vSrboZTrYW1IaEeXsMxtF7Eo8/BPaNSvHAexS35EXmaNdz8gimWNzXYj0krP0WDE8eNWO17xp9AHzK",
+	"h9tVBUFIHTQ/jQXunZ39gt4GvkVJj0DGoG8tWp7IOxW38WSszlzfdJ5GX/T7LWDE4bMb/tEl6rgkXthZ",
+	"ajWeA2yf0K3n88uW13pBNeRJJHghtMlaV7u01YeUpbuVYZRBB7owVhQTjjvrI1U5UpRLbsW89svrtV9l",
+	"+GJaZZdCbrbpiX11rabhRfXwol3c/aKlhDji55ukfAifgniIcd0PiYi6/qcSEHU9run2bOZrSpSYIuuG",
+	"FvNxkVKn4/xeoq/tYzjcUJ6MXLPW7SVs6oYc39cwCMyOBGrZLTHvp4LjroXIClGPkGuLI7fxf6S3yktZ",
+	"yWlU1cfMyF1dEqSZO5YHOUePSvDVBtt+eBTe24Yy/eCgpOLaOFu3j0V6W1Adw1Sg0wikP1ZP1K4uxfij",
+	"Us0relZay8rZAi+3HKEOMNwMwvF8xqc8b3Qb4tLHGP2Zl7JAo4nB7NGVUjX8q2orK/gPxuSrxtL/Bdfw",
+	"H4oe7f6PuCqykkBTC1wXWSEoOTXk8ckXywVVXnjOTtpQOhGorzE7oR5JXPe98PkLqUQ82EPQInMy+scP",
+	"9J1+8NHHxzXu+HmABXJ85ZvEY6af//9jYYzcSnb6Dx+SP5Z0/E0q23jkfRAvEKb3dmnDs8FXttKq2Wxt",
+	"pyFnQOtmLB/UtEqdd6ut16FeIpv4qLDpLAYqW96/uRZ6xysU3CfR5qLRLJYLR91iuej3l9xO/1J4IolN",
+	"fcDu3SZUngMbkoyOH6bJ666tW36EPcWAnUqIwjCr0IMVLCuiOOW5pcg1B1xUCXup9HnqCdig02rcR0j3",
+	"ndb0uLZNzcmtgIfYV2J4T55pSXOUmcZQXHQn8vWgHieualiN4wks9O5iJoVh8lR1IbQLsHA70XEsPWMP",
+	"kvgyR94xY0qpka9chDsIpRFZJY2VeZBXzsk7+K52MfrnX6t8xwnAqrnXJCLFe98WU2+6Paqvh/JB2GhQ",
+	"ioVSns3b+aCebvpSTQaNOYcUlqRup8Z32MQxs7+S30Z3lJN9DJyBZrr2LELFbjino7ptn40GjNCjNiVP",
+	"XwujGp2LpOki+hiMF/A6Vgqm3ScHPURPeW5Z0RvfbFUDcH8YH38dq4VHDMP4d4/VpEWuNIIakc0AVbwA",
+	"4Yg+79WGPXYhIC55FFOaPQH911sMfYar460b3uwwhhUzMHTIwo0gcoLwmXq04MWA+LPqWPIjQTCOnNq1",
+	"0RJJcWaFD0bSSl0d0nQ7rp3wrtMaBibtLK1R3ueSOlSlNdMlzxQUF8+EGDlTnglC62jPFd7GjqUMz6DR",
+	"JI6mjmJHMjiGu0m464YC2B08tLNKsXWHnvGj4dCs9F3vZpwoz5JnCQlXOFK1R/CgZDS8EHpJZj0HIjhx",
+	"JbsVbDLye/sU0f9QIturahIXd4i9FU1Z2RSELNL3WFmyQmh54e1MbSVagbgscyH6t8hsw2hJkzqS5kGv",
+	"XTPn/yx8mKHTbEKLbl+XJnxJDMp7HbsuRyA+Q1i4XO9rq06xDBY5NVY3uTWEDNf2ORAooEcTqtDB4Q2s",
+	"2WB5cHnDTWZVpsWF4GOhnvhwDqCDDoSQCrPQQEpvn723enNMbaenFgmJMWrIF4uQr8q9S3PHOMz5jte/",
+	"UC+/soy9JoqlRx6FCmxnNvXxkErUVIp0w0ubjT5Wu4cp9oaXNrZgA0EO2KPjNDIUE0Zu3NNXsvX8Y7xV",
+	"Ak3XZ0EYsCim3gkvr/FO+H5MdmC/wQ5Axv/ulrpwnivz2cH7ukAndzqO12HHDqVCNL55o4gnJRINad8+",
+	"/9Vvp8C2aDGL+jc+L/kAxAu3rqis3l/HFik3mSnVEcN7IzdvoMKBKfXFBnNaqkuhwbFoilVLH66Oxzmj",
+	"krDDI0QqN2PUHukjomAwGHO9iaCGj5oJV+XwXLRtd2djzctcVVmn97uVOiQvM+SuLKSNPDB7fNedvdo/",
+	"6x4rtVBIQMxG5uJihoL+XOw/DSeEBBTgYD0RJmDcCwTfuH4IoBhRoPKlAyKgQPOuonP45YMMiRkpvxP7",
+	"ynb3VYtR09pPOmkE+gZK984In9rZmAIpSXs1Y11Gld/uaxHQ8gTT/JLRlLPGYHre2j/14RPwSITA7Xm7",
+	"sNcBJ3AIIZarynJZwRwkbbe4hFtR1iioWqfsk0+KfX+OTuYu+x6Yn3yHDBTFEsbAivD/4ZRZLT6C4+65",
+	"2GelXIu0iQBOmLV3QPbFTm5NpxhLOt2JwcRH75LAOts83Uxp+rLBL3E6cEZyFLPOGf+XYYWwQu+AFbcA",
+	"3dTkW9Td+SbYwTBSQFbeM6rtqNO6T/HZTefuEi6ZmufU0NLZevVG6BBZ582HPvJgxyXukxZRrp/wDH7D",
+	"HHpH59F+SbkVI9mF0axRUu1Eum5PxrnYn1LgEf5+DUEynpt7hDAo/CFJulG+7zgH/QF+Pe/EuSI/dbil",
+	"Jf8W412jaKgj412H2fXnDg/HgduhMWI4zvkwufHcJq647djmBmsn7KDpGOtDodXpU9nHGaEcx7pRUB/6",
+	"CuKz5OefY/Offx5H98Wfgds+/zwNjJPcObcXyk3z4dpw3SW5o1WoEq7wdMgbguyn5xY40NA7An/sYhFX",
+	"BcOMQqiecIRmFaWqRbK0RXUnWmDMqK/Fpik5YfAO7Y5zUifT9d9eVc7UhX++vapSZaM/qHQ0HWcVeO83",
+	"ZAXKxJVLa+vAB8L7TNREyESdY87n5CdKJJv85NHVex/PxV6LfmM134NO0fu1BwkefQkBKZ3ffx16HMhs",
+	"J+xWFQedhlbyJRXsvVfZLkPNhM+OLK2L9xPTeESLbfLtxfuJ2T+yxWfYQttictGObPOtawNb9Zlu0mbg",
+	"TYXmSm+klD4dJV4MiPO7uywY2+Ej4gE7J+2Avy3+AWbL1kGblB1I+i2qAtFCQfpjj1YxUZlGO1Mp0Irt",
+	"ASmuGRUrOaYtcp0cgpgxSI+hpoLpNierOJago8mnOKeqoH4VsDgq4QcbSWMoD1fvsVw5oPFz6MsV9AkR",
+	"4Gw8eCVFNta78aAIekgKK9WJI+aGhfojzVMq9azzaOytF73bZshq39NYsDy79/zpfcrN1/mINFAn0QX0",
+	"8LA9XfRUPIciF8nTp4Vekq9HRRJGgaByewjbbC1GTOTkaXIB3rTptvC2/AxKMSzVhzc8SOXMjDbg8A96",
+	"iSvepv74FNPYdIjsplKNmoouXlnhX+EOWh0d8stysdGqSUeCbDQ+mfXBi+ByhIonGTYo2vsUosELuRHG",
+	"nrC/wT50SgkwYwBC5Hawmpj0imtnJYk/IGEBCIrUQ+f1GPW5dQs6gG+RDvAbm/kIAa9JdWH+sRaC2qGx",
+	"wwgKKRJQ+Uuy2PNCVBbNNs7xe6AnxpkC+56lFBFUgrEcjXvvTrH66buwWOElYrgwsC6I7vyOyIPX9Xcu",
+	"nMhYrtEpiFv2gPxfxRUHX3/27qx58ODLHEjJwOEU/xSu4y9OH7zzxJKn2mA8nhJ6+x8Zb5wWwSpWKnXe",
+	"1FgtUd6/xaOIOoTm0l+UtE/B834v6EyI+Pcwz/GJ0gENvY1MK9f3qKeEEYN9PePcTejl8wfxPVYOXoXj",
+	"Z0uJZ8sLfu2jpRR8BHe1vEoIyC8fZq2MPGEvoDYT1VrpXBhG1yHmLkOOMWOmYey5S0uF10Xg60pV4I+D",
+	"5rKKKe+K1JOiYbLRP5zneJM1LgcD0CD9rg8m+XtvUF9dEpH3yRqT2LNNZSUpuDCNP0ezWHNMo83Y37ay",
+	"THBBreC7ielYskr5fM1RScq043JGS+Nodluyw0h3K8gjO2d7vA45AR0hX0TRoq0tjqBrTJujNdrHlBmC",
+	"drNflyFPztrgzguze7r3t3mpNumLQLmhAWxuhc6PGx1ZqRFwffiAiqYWaJXbBbvx3RKcsj3Ml3yvqDZ5",
+	"5eRCXgg9fcfTI3c8X3v6Zof5fzOr0m0LelKlu1e4TOMLAUnbTuBK+mYbEMop4i2+ndAOAr1i3aArQ/Ro",
+	"718I3KXdVcKd1/p5Rcx6/SAAOhbT7z+AsBKBtaKqnlJy5awjkQwIyak2lKaPRPZnE8MJzUxzhRnhCqo7",
+	"zROzPRwito1cHMbtbEc01zrgYWDVBLLWvhZdWHSMBg0m6k6KIFgpc8KehrxlUMwl/WmTmZEltx8iSsmf",
+	"vD4kpHblGNf+pQajSDGKB3dNQhC4AqQbQZmhluSK8HyNBcZMfb7Y1VrotlzK3OZLrvVvbcGhpc8Xq2v0",
+	"qRmxWbpSxtb4LDqy0q7UFpA8WPqy1Ibz1XwfzLiL5QIGDv/AwODftf5tQSZUtODW4JK5XS1+nbfPHetk",
+	"2FnCM3bRNV909M2wYVsOPPBEEJtpx7IjOLgFX+5o+31Ul2zyUadPeFm+vaqopwQ8Yz4W6cFLF+ohjGFN",
+	"RSand16Yv1uyd2ulhdxUYEbr/g3sZN7R7ni3UleZ9iEE5p2DrgrBKog9AyowkeLU3wzTIuL5YahMK/7x",
+	"U69KKK7a4vRgGhqbrVfFYTcJZWMyWI/XhHD8wgXp+cJ4QLpodG/xdXI3ft2lAcU+4tHSfmaYz5+S1RTW",
+	"gTOM4eNZ2HY+3GMkgO/g2TcYb7Trud6MjhuNvUMFX+aM601DuQbvYHwHRjByZ+S1LFwiaR9CPVCGSeA2",
+	"WhRMaeI4Jtcu5qDajET99EY0Nnu108Zl3irdbXakEeGwhGulqF18gKqyPAAlRFkFzghg4GwRbB4YSYRH",
+	"l5ZWdFFcE5eBJeOGXQpwMAvgGFlY3Qgx5yTEIjE3XNqGWqBPViL6/Q7V8DTjwwO5i5FyEVGRsBpZrNUV",
+	"PSghnLy3c7qLa+DwxI2J3YM5x5tw8EDFZKhosrw/W0D1Y7L6/J7YMCMjMc0I242dRqR0dzntI7AZxre1",
+	"Xo7EaTmvKmX/iZhNXFnN/QplNd+McJyoUTqY8PCDExdhm9S1nwVWCpj3fzQIKQZMhs2OPNJE5/cIg6y5",
+	"P81Mf7mSZ1pX1LpYxnjhzeCoC7e1650E+PTaKgLAcxkkXpmKhUnsma7uMialQ3ps0+LhGDfKkNBl7hD7",
+	"cZswwmHg5i2Nr/NqZIKP4cFnI+eO2LOLXauBjtQ4VLcD+oM6OuzqyageXusLL5hLwpePxZir2s/v1NFY",
+	"KC5a7hBU3Ypyz9ZclifsQf9Vq1KhPUL/bEOqa6HXauzCP0zJEWsm/Tk6dLWI/DUmrxZQDryJlBe0WmRe",
+	"m3G/APMVgiLePBjSWfWY4kLJKBOagp3dzge17iMvTxKVXOJKkNH9av0uD110oJK74rSDn7jeTMVcX/GB",
+	"zoc03UDbo1EeNNy2sd1pR+ARD5rJNfaP/nSLHwBbHTmx1OPExE5ABKx50cE27GESkbQkYqVxs03wXwR7",
+	"yC87e6fV6ydXcz25mhPt9+D5nRVkDGcpsppQRsxLP+NUIwVfNQ2jSht/2PWczR/coGaxhrcE3ZQ5fK8T",
+	"7DHuFMQ5xQE83jmEKE+cCvSdMCdCqJnwu/b2ynLtpZmXxwHRNuI0OGLpgN7x+hre2jcQHhHF495TYtR3",
+	"qo01dxpGbwagO2qh9dJivPWruDnMmG89vYT4tZ/00mUepmloj0MtdqoT9Z5aHTp/WgU3mFQZOaTBnHYQ",
+	"HGMMk3iyIZU7aI/lJd8b/yDRctZ4c35WteBWpYzhcUpnekVJz43OKRBI5LKWorLBezBel7XQE2b8dMPu",
+	"OeDt1uealRfBhuRCqzjLS34Jfoi9J2b/wizJf5FHJ/TSTTMvu6oQNextblDmiW/bjygsaXSgzci44VEf",
+	"I+kXpvSA0GudZCYFXgQmf6SoCxVJ3IX+xkXddpVNHYbbFS8ooYU/Dp3fit+2pIRekV+UVhdteFiFc6zS",
+	"nLJdQdBjVsiyGcXF3K7OXd/fi/1TV5KWdMdtvo2Iajelz48bVbmG/Niu6AXgIE5MJyUIVTRCFCPjMW48",
+	"b4QoOrxJz3BQM2icfe3+M0O+QvR+85H8ALcrSv8sx0Z4Id0QIZ3y86fxasGgplaManzkdJHRdhgyacQX",
+	"7Up3JuXA/nc+QNObn56Njt35VIu2PXUzvufhTWGAF5pwPqigECznS667wJjusG7BMBGruNNqtUnpknBG",
+	"lAJp7pIwGgNtROme7KN0NujyFh7QXUxnwV7zqlA79sznCbr38+tn95kWpimtP2QoPNMKwQIld7+P4kfG",
+	"0YHXeu1G/iaKhw7Dl4R5OAaRa+5+VLgLDrlOQ6G1sa3/NDlmUVL4AaKjdFpQWg3FDg+eI1CKTpJWMTWY",
+	"msYE784ViqgBdCuUmej6gCcflClpqC/4LYx03obB4bod0+ml7u2fT42BDpgSvBvRtPR0HgrHik9XjeSn",
+	"6+l690O6HraBsC9lrtVjRBeA9ayKgMF6a7esqAuKxBcaVWvbvWx1g2PcOYxPbz7GJXrWPRg8020vORfh",
+	"noWdGGGXQ+966hA6dz1GNyOsT08wEEvYXn7WTVWY3hQGOJgpP6PJu4+7+vgyky5LY5eCuTeBDixKlxJU",
+	"8Gg3Rog4xqhcts5mRu1cEPkAJDNUii+ZqJoXqQTmJbyeuVxXx3pGvfB1AUulKa28ZjsvfV1y1Uofh3Lj",
+	"jsKq4Lpgonj41Vdf/PnjZUh7P3OFX0QTPBhV6Yblnku4lXn3HhtGN0OI+aU82aihyBp1fdCb9hE1uDoM",
+	"8rwf5bGAhIyDG7nBekdICBWIWF3Btb20sv0JE7dA4EwrOrfCb06KCOHMyau+dztGkEduF3ftjL2Reea3",
+	"RnYjN8R4k9x+i2ZcILWb71PYc7HYJT6bK2pfRhKqO0J6xAHm8xgdOMF1KUBRbAXqKOqiXw/SH3xHb+Rm",
+	"sA/j9tJT3azcbAMtxmVWV+tYfUNrY0vVNUJqBpPyJqYrsaXtVgsDFCWJtludBKabyrrXZthKvDIetaBv",
+	"enPanXGat1F1uT7/SHiHUzzwaYB+pb2Xp/XvMeguNuP8arFL+5il46p4lAtyivVH8/p1L+PzAfBak1/H",
+	"YXjMp9vU3qv7bYQ0EgO6sufE/m0oACrFFcEbuuRA5BKjlVW5KrvzdRsITr0FN9NRnGaQBwEvuORYgxDG",
+	"bNXk50mIdjT/Z3TXmEQrLqCHKrfuXmKOS0a/XBAFSRi29rGJCh2FtTwVF9lSn8JRtluQ4FowXhrVCUHB",
+	"qxr52672hJaS7Buy548AC0R2BllFY7uGDXwnq7FeYsPNTbsh3D6PJT6aXKKT52A5CVV9raTvVUYzf8QZ",
+	"Cjv5CVVKpWWP+DuwYYdv4oWMp7szJx3aDmg6EUFz8b/Vmuxfntn623S9Ofi8yzquODO2JMY/HGx16Ahi",
+	"Rl2Uy8nmIieqvI9JNNLkVdIpoU9iyiFhpMXtaqq55HufmQpCnmrtoFEv5fizn2oxYVQbaQijWSZaGlEP",
+	"5oC++yiaTvSMj6nZruKAG4q/QVqGW+Y9Su+1IszHyvIcj4eK76DUYyclFstFo8vFo8XW2to8Oj29vLw8",
+	"8SLkJFe70w1inGRWNfn21Df0ftkbum+PlaKA051XvNzjmfn41XMctbSlwGB61FCibNGPFg9PHlAuRFHx",
+	"Wi4eLb48eXDyxYISf+IOPaUc3vDfDR1zsH9x2Z8XCMx3LuIs4MsFASgb2uAPHzzw0+BMq9FuOf27Ib19",
+	"nrtk3M3794OJuIc+aPdphta8KROH8k/VeaUuK/at1ooYwDS7Hdd7xIWzja4Me/jgAZNrl7uc4FA5mDZ+",
+	"WRBO2eJXqHd68fA0inTp/XL6u/tfJov3Bz5DdJLJIu/Rg+W9C+50KUB92krjsr5OlnUQk3OLJ+CBzOw6",
+	"s4jvPkTMJCve7lHZNJHRr6e/d91M388sdkrpYeYWTQzjUBUxl+JTcSG6jDhZuuPsfCRZLmDel+0vJ/59",
+	"+rv3XXk/8cmz3VT1U9PUdbmfKpFe9k729N7P5vR3CmCmR5SIRgw0Mae/479d8n1eLJP46fR3Zyt8j1em",
+	"qAj2Y0659cHS7ncsduqU0s5v6eGQz+PpJZcW1EtS5EaHkW6jexVuViAYV2Lk++8AKoNN4pO6vsCR//J7",
+	"79BycDR4Xi3e/xpkZTjunMx8vwy/EIpM/IsRXOdbrH6VKS03sgLuvOSbjdBZ77T6/wMAxhUdnbNdAQA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
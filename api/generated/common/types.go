@@ -30,6 +30,13 @@ const (
 	Update   OnCompletion = "update"
 )
 
+// Defines values for ParticipationRegistrationStatus.
+const (
+	NotParticipating ParticipationRegistrationStatus = "NotParticipating"
+	Offline          ParticipationRegistrationStatus = "Offline"
+	Online           ParticipationRegistrationStatus = "Online"
+)
+
 // Defines values for TransactionTxType.
 const (
 	TransactionTxTypeAcfg   TransactionTxType = "acfg"
@@ -44,9 +51,18 @@ const (
 
 // Defines values for AddressRole.
 const (
-	FreezeTarget AddressRole = "freeze-target"
-	Receiver     AddressRole = "receiver"
-	Sender       AddressRole = "sender"
+	ApplicationAccount AddressRole = "application-account"
+	FreezeTarget       AddressRole = "freeze-target"
+	Heartbeat          AddressRole = "heartbeat"
+	InnerReceiver      AddressRole = "inner-receiver"
+	Receiver           AddressRole = "receiver"
+	Sender             AddressRole = "sender"
+)
+
+// Defines values for Order.
+const (
+	Asc  Order = "asc"
+	Desc Order = "desc"
 )
 
 // Defines values for SigType.
@@ -68,6 +84,32 @@ const (
 	TxTypeStpf   TxType = "stpf"
 )
 
+// AbiMethod An ARC-4 method call decoded from the application arguments, using the contract descriptions registered with the indexer.
+type AbiMethod struct {
+	Args []AbiMethodArg `json:"args"`
+
+	// Name Method name.
+	Name string `json:"name"`
+
+	// Return A decoded ARC-4 method argument or return value.
+	Return *AbiMethodArg `json:"return,omitempty"`
+
+	// Signature Method signature, the method selector is computed from it.
+	Signature string `json:"signature"`
+}
+
+// AbiMethodArg A decoded ARC-4 method argument or return value.
+type AbiMethodArg struct {
+	// Name Argument name.
+	Name *string `json:"name,omitempty"`
+
+	// Type ARC-4 type of the argument.
+	Type string `json:"type"`
+
+	// Value Decoded value, in the ABI JSON encoding. Reference arguments are resolved to the referenced address or id. Omitted for transaction arguments.
+	Value *interface{} `json:"value,omitempty"`
+}
+
 // Account Account information at a given round.
 //
 // Definition:
@@ -235,6 +277,41 @@ type Application struct {
 	Params ApplicationParams `json:"params"`
 }
 
+// ApplicationEvent An ARC-28 event decoded from an application log.
+type ApplicationEvent struct {
+	Args []ApplicationEventArg `json:"args"`
+
+	// IntraRoundOffset Offset into the round of the transaction which emitted the event. When it is an inner transaction, this is the offset of the inner transaction itself, which tells apart the events of the inner transactions of the same root transaction.
+	IntraRoundOffset uint64 `json:"intra-round-offset"`
+
+	// LogIndex Position of the event in the transaction logs.
+	LogIndex uint64 `json:"log-index"`
+
+	// Name Event name.
+	Name string `json:"name"`
+
+	// Round Round in which the event was emitted.
+	Round uint64 `json:"round"`
+
+	// Selector The 4-byte event selector, the prefix of the log.
+	Selector []byte `json:"selector"`
+
+	// Txid Transaction ID of the transaction which emitted the event, or of its root transaction when it is an inner transaction.
+	Txid string `json:"txid"`
+}
+
+// ApplicationEventArg A decoded ARC-28 event argument.
+type ApplicationEventArg struct {
+	// Name Argument name.
+	Name *string `json:"name,omitempty"`
+
+	// Type ABI type of the argument.
+	Type string `json:"type"`
+
+	// Value Decoded argument value, in the ABI JSON encoding. Integers are JSON numbers, addresses are strings, byte arrays are base64 strings, tuples and arrays are JSON arrays.
+	Value interface{} `json:"value"`
+}
+
 // ApplicationLocalState Stores local state associated with an application.
 type ApplicationLocalState struct {
 	// ClosedOutAtRound Round when account closed out of the application.
@@ -401,6 +478,78 @@ type AssetParams struct {
 	UrlB64 *[]byte `json:"url-b64,omitempty"`
 }
 
+// AssetSupply Supply of an asset and its distribution among holders.
+type AssetSupply struct {
+	// AssetId Asset ID.
+	AssetId uint64 `json:"asset-id"`
+
+	// CirculatingSupply Total minus the amounts held by the reserve and the excluded addresses.
+	CirculatingSupply uint64 `json:"circulating-supply"`
+
+	// Creator Address of the asset creator.
+	Creator string `json:"creator"`
+
+	// CreatorAmount Amount held by the creator.
+	CreatorAmount uint64 `json:"creator-amount"`
+
+	// Decimals Number of digits to use after the decimal point when displaying the asset.
+	Decimals uint64 `json:"decimals"`
+
+	// ExcludedAmount Amount held by the excluded addresses, other than the reserve.
+	ExcludedAmount uint64 `json:"excluded-amount"`
+
+	// FrozenAmount Amount held in frozen holdings.
+	FrozenAmount uint64 `json:"frozen-amount"`
+
+	// Reserve Address of the asset reserve, if any.
+	Reserve *string `json:"reserve,omitempty"`
+
+	// ReserveAmount Amount held by the reserve.
+	ReserveAmount uint64 `json:"reserve-amount"`
+
+	// Total Total number of units of the asset.
+	Total uint64 `json:"total"`
+}
+
+// AuthHistoryEntry Change of the authorized address of an account.
+type AuthHistoryEntry struct {
+	// CloseOut Whether the account was closed by the transaction, which resets the authorized address.
+	CloseOut bool `json:"close-out"`
+
+	// IntraRoundOffset Offset into the round of the transaction.
+	IntraRoundOffset uint64 `json:"intra-round-offset"`
+
+	// NewAuthAddress Address authorized to sign for the account after the transaction. It is the account address when the authorization was reset.
+	NewAuthAddress string `json:"new-auth-address"`
+
+	// PreviousAuthAddress Address authorized to sign for the account before the transaction. It is the account address when the account was not rekeyed.
+	PreviousAuthAddress string `json:"previous-auth-address"`
+
+	// Round Round of the transaction.
+	Round uint64 `json:"round"`
+
+	// Timestamp Block creation timestamp in seconds since epoch.
+	Timestamp uint64 `json:"timestamp"`
+
+	// Txid ID of the transaction. For an inner transaction, it is the ID of its root transaction.
+	Txid string `json:"txid"`
+}
+
+// BalanceHistoryEntry Balance of an account after a transaction.
+type BalanceHistoryEntry struct {
+	// Amount MicroAlgo balance of the account after the transaction, without pending rewards.
+	Amount uint64 `json:"amount"`
+
+	// AssetAmount Balance of the requested asset after the transaction. Only set when an asset-id is provided.
+	AssetAmount *uint64 `json:"asset-amount,omitempty"`
+
+	// Round Round of the transaction.
+	Round uint64 `json:"round"`
+
+	// Timestamp Block creation timestamp in seconds since epoch.
+	Timestamp uint64 `json:"timestamp"`
+}
+
 // Block Block information.
 //
 // Definition:
@@ -544,6 +693,18 @@ type BoxDescriptor struct {
 	Value *[]byte `json:"value,omitempty"`
 }
 
+// BoxHistoryEntry The content of a box after it changed.
+type BoxHistoryEntry struct {
+	// Deleted Whether the box was deleted.
+	Deleted bool `json:"deleted"`
+
+	// Round Round in which the box changed.
+	Round uint64 `json:"round"`
+
+	// Value \[value\] box value after the change, base64 encoded. Not set when the box was deleted.
+	Value *[]byte `json:"value,omitempty"`
+}
+
 // BoxReference BoxReference names a box by its name and the application ID it belongs to.
 type BoxReference struct {
 	// App Application ID to which the box belongs, or zero if referring to the called application.
@@ -573,6 +734,48 @@ type EvalDeltaKeyValue struct {
 	Value EvalDelta `json:"value"`
 }
 
+// FeeDistribution Distribution of the fees paid by top level transactions, inner transaction fees are pooled by their root transaction.
+type FeeDistribution struct {
+	// AboveMinFeeShare Share of the transactions paying more than the minimum fee of their round's consensus version, between 0 and 1.
+	AboveMinFeeShare float64 `json:"above-min-fee-share"`
+
+	// MaxFee Highest fee paid, in microalgos.
+	MaxFee uint64 `json:"max-fee"`
+
+	// MedianFee Median fee paid, in microalgos.
+	MedianFee uint64 `json:"median-fee"`
+
+	// MinFee Lowest fee paid, in microalgos.
+	MinFee uint64 `json:"min-fee"`
+
+	// P95Fee 95th percentile of the fees paid, in microalgos.
+	P95Fee uint64 `json:"p95-fee"`
+
+	// TxnCount Number of top level transactions.
+	TxnCount uint64 `json:"txn-count"`
+}
+
+// GlobalStateChange A change to an application global state key.
+type GlobalStateChange struct {
+	// Action Delta action. Value `1` sets bytes, value `2` sets a uint and value `3` deletes the key.
+	Action uint64 `json:"action"`
+
+	// IntraRoundOffset Offset into the round of the (possibly inner) transaction which made the change.
+	IntraRoundOffset uint64 `json:"intra-round-offset"`
+
+	// NewValue Represents a TEAL value.
+	NewValue *TealValue `json:"new-value,omitempty"`
+
+	// OldValue Represents a TEAL value.
+	OldValue *TealValue `json:"old-value,omitempty"`
+
+	// Round Round of the transaction which made the change.
+	Round uint64 `json:"round"`
+
+	// Txid Transaction ID of the transaction which made the change. For inner transactions this is the ID of the root transaction.
+	Txid string `json:"txid"`
+}
+
 // HashFactory defines model for HashFactory.
 type HashFactory struct {
 	// HashType \[t\]
@@ -690,6 +893,39 @@ type MiniAssetHolding struct {
 // * delete
 type OnCompletion string
 
+// ParticipationRegistration Key registration transaction of an account.
+type ParticipationRegistration struct {
+	// IncentiveEligible Whether the registration paid the fee making the account eligible for block incentives.
+	IncentiveEligible bool `json:"incentive-eligible"`
+
+	// IntraRoundOffset Offset into the round of the transaction.
+	IntraRoundOffset uint64 `json:"intra-round-offset"`
+
+	// Participation AccountParticipation describes the parameters used by this account in consensus protocol.
+	Participation *AccountParticipation `json:"participation,omitempty"`
+
+	// Round Round of the transaction.
+	Round uint64 `json:"round"`
+
+	// Status Status of the account after the registration.
+	// * Online - the registration brought the account online.
+	// * Offline - the registration took the account offline.
+	// * NotParticipating - the account opted out of participation and rewards permanently.
+	Status ParticipationRegistrationStatus `json:"status"`
+
+	// Timestamp Block creation timestamp in seconds since epoch.
+	Timestamp uint64 `json:"timestamp"`
+
+	// Txid ID of the transaction. For an inner transaction, it is the ID of its root transaction.
+	Txid string `json:"txid"`
+}
+
+// ParticipationRegistrationStatus Status of the account after the registration.
+// * Online - the registration brought the account online.
+// * Offline - the registration took the account offline.
+// * NotParticipating - the account opted out of participation and rewards permanently.
+type ParticipationRegistrationStatus string
+
 // ParticipationUpdates Participation account data that needs to be checked/acted on by the network.
 type ParticipationUpdates struct {
 	// AbsentParticipationAccounts \[partupabs\] a list of online accounts that need to be suspended.
@@ -699,6 +935,27 @@ type ParticipationUpdates struct {
 	ExpiredParticipationAccounts *[]string `json:"expired-participation-accounts,omitempty"`
 }
 
+// ProposerStats Statistics of the blocks proposed by an account.
+type ProposerStats struct {
+	// Address Proposer address.
+	Address string `json:"address"`
+
+	// BlocksProposed Number of blocks proposed.
+	BlocksProposed uint64 `json:"blocks-proposed"`
+
+	// FeesCollected Total fees collected by the proposed blocks in microalgos.
+	FeesCollected uint64 `json:"fees-collected"`
+
+	// FirstRound Round of the first block proposed.
+	FirstRound *uint64 `json:"first-round,omitempty"`
+
+	// LastRound Round of the last block proposed.
+	LastRound *uint64 `json:"last-round,omitempty"`
+
+	// TotalPayout Total proposer payout in microalgos.
+	TotalPayout uint64 `json:"total-payout"`
+}
+
 // ResourceRef ResourceRef names a single resource. Only one of the fields should be set.
 type ResourceRef struct {
 	// Address \[d\] Account whose balance record is accessible by the executing ApprovalProgram or ClearStateProgram.
@@ -722,6 +979,27 @@ type ResourceRef struct {
 	Local *LocalsRef `json:"local,omitempty"`
 }
 
+// RoundFeeStats Fee statistics of a round.
+type RoundFeeStats struct {
+	// BlockTime Seconds since the previous block. Not set when the previous round has no fee statistics.
+	BlockTime *uint64 `json:"block-time,omitempty"`
+
+	// Fees Distribution of the fees paid by top level transactions, inner transaction fees are pooled by their root transaction.
+	Fees FeeDistribution `json:"fees"`
+
+	// FeesCollected Fees collected by the block according to its header, zero before block incentives.
+	FeesCollected uint64 `json:"fees-collected"`
+
+	// Round Round number.
+	Round uint64 `json:"round"`
+
+	// Timestamp Block creation timestamp in seconds since epoch.
+	Timestamp uint64 `json:"timestamp"`
+
+	// TotalTxns Number of transactions in the block including inner transactions, derived from the block header transaction counter. Not set when the previous round has no fee statistics.
+	TotalTxns *uint64 `json:"total-txns,omitempty"`
+}
+
 // StateDelta Application state delta.
 type StateDelta = []EvalDeltaKeyValue
 
@@ -845,6 +1123,9 @@ type TealValue struct {
 // data/transactions/signedtxn.go : SignedTxn
 // data/transactions/transaction.go : Transaction
 type Transaction struct {
+	// AbiMethod An ARC-4 method call decoded from the application arguments, using the contract descriptions registered with the indexer.
+	AbiMethod *AbiMethod `json:"abi-method,omitempty"`
+
 	// ApplicationTransaction Fields for application transactions.
 	//
 	// Definition:
@@ -918,6 +1199,9 @@ type Transaction struct {
 	// Id Transaction ID
 	Id *string `json:"id,omitempty"`
 
+	// InnerId Identifier of an inner transaction. It is the root transaction ID followed by `/inner/` and the position of the transaction in each `inner-txns` list, starting at 0. For example `<root-txid>/inner/1/0` is the first inner transaction of the second inner transaction. It can be used to lookup the inner transaction. Not set for top level transactions.
+	InnerId *string `json:"inner-id,omitempty"`
+
 	// InnerTxns Inner transactions produced by application execution.
 	InnerTxns *[]Transaction `json:"inner-txns,omitempty"`
 
@@ -1270,6 +1554,57 @@ type TransactionStateProof struct {
 	StateProofType *uint64 `json:"state-proof-type,omitempty"`
 }
 
+// TransactionStats Transaction statistics of the rounds in a time bucket.
+type TransactionStats struct {
+	// ActiveSenders Number of distinct senders of top level transactions.
+	ActiveSenders uint64 `json:"active-senders"`
+
+	// Bucket Start of the bucket in seconds since epoch.
+	Bucket uint64 `json:"bucket"`
+
+	// InnerTxns Number of inner transactions, they are also included in the counts by type.
+	InnerTxns uint64 `json:"inner-txns"`
+
+	// MaxRound Last round in the bucket.
+	MaxRound uint64 `json:"max-round"`
+
+	// MinRound First round in the bucket.
+	MinRound uint64 `json:"min-round"`
+
+	// TotalFees Total fees in microalgos, including inner transactions.
+	TotalFees uint64 `json:"total-fees"`
+
+	// TxnCounts Number of transactions of each type.
+	TxnCounts TransactionTypeCounts `json:"txn-counts"`
+}
+
+// TransactionTypeCounts Number of transactions of each type.
+type TransactionTypeCounts struct {
+	// Acfg Number of asset configuration transactions.
+	Acfg uint64 `json:"acfg"`
+
+	// Afrz Number of asset freeze transactions.
+	Afrz uint64 `json:"afrz"`
+
+	// Appl Number of application call transactions.
+	Appl uint64 `json:"appl"`
+
+	// Axfer Number of asset transfer transactions.
+	Axfer uint64 `json:"axfer"`
+
+	// Hb Number of heartbeat transactions.
+	Hb uint64 `json:"hb"`
+
+	// Keyreg Number of key registration transactions.
+	Keyreg uint64 `json:"keyreg"`
+
+	// Pay Number of payment transactions.
+	Pay uint64 `json:"pay"`
+
+	// Stpf Number of state proof transactions.
+	Stpf uint64 `json:"stpf"`
+}
+
 // Absent defines model for absent.
 type Absent = []string
 
@@ -1315,6 +1650,12 @@ type ExcludeCloseTo = bool
 // Expired defines model for expired.
 type Expired = []string
 
+// ForeignAppId defines model for foreign-app-id.
+type ForeignAppId uint64
+
+// ForeignAssetId defines model for foreign-asset-id.
+type ForeignAssetId uint64
+
 // GroupId defines model for group-id.
 type GroupId = string
 
@@ -1330,6 +1671,9 @@ type Limit uint64
 // MaxRound defines model for max-round.
 type MaxRound uint64
 
+// MethodSelector defines model for method-selector.
+type MethodSelector = string
+
 // MinRound defines model for min-round.
 type MinRound uint64
 
@@ -1342,6 +1686,9 @@ type NotePrefix = string
 // OnlineOnly defines model for online-only.
 type OnlineOnly = bool
 
+// Order defines model for order.
+type Order string
+
 // Proposers defines model for proposers.
 type Proposers = []string
 
@@ -1389,6 +1736,19 @@ type AccountsResponse struct {
 	NextToken *string `json:"next-token,omitempty"`
 }
 
+// ApplicationEventsResponse defines model for ApplicationEventsResponse.
+type ApplicationEventsResponse struct {
+	// ApplicationId \[appidx\] application index.
+	ApplicationId uint64 `json:"application-id"`
+
+	// CurrentRound Round at which the results were computed.
+	CurrentRound uint64             `json:"current-round"`
+	Events       []ApplicationEvent `json:"events"`
+
+	// NextToken Used for pagination, when making another request provide this token with the next parameter.
+	NextToken *string `json:"next-token,omitempty"`
+}
+
 // ApplicationLocalStatesResponse defines model for ApplicationLocalStatesResponse.
 type ApplicationLocalStatesResponse struct {
 	AppsLocalStates []ApplicationLocalState `json:"apps-local-states"`
@@ -1464,6 +1824,15 @@ type AssetResponse struct {
 	CurrentRound uint64 `json:"current-round"`
 }
 
+// AssetSupplyResponse defines model for AssetSupplyResponse.
+type AssetSupplyResponse struct {
+	// CurrentRound Round at which the results were computed.
+	CurrentRound uint64 `json:"current-round"`
+
+	// Supply Supply of an asset and its distribution among holders.
+	Supply AssetSupply `json:"supply"`
+}
+
 // AssetsResponse defines model for AssetsResponse.
 type AssetsResponse struct {
 	Assets []Asset `json:"assets"`
@@ -1475,6 +1844,28 @@ type AssetsResponse struct {
 	NextToken *string `json:"next-token,omitempty"`
 }
 
+// AuthHistoryResponse defines model for AuthHistoryResponse.
+type AuthHistoryResponse struct {
+	AuthHistory []AuthHistoryEntry `json:"auth-history"`
+
+	// CurrentRound Round at which the results were computed.
+	CurrentRound uint64 `json:"current-round"`
+
+	// NextToken Used for pagination, when making another request provide this token with the next parameter.
+	NextToken *string `json:"next-token,omitempty"`
+}
+
+// BalanceHistoryResponse defines model for BalanceHistoryResponse.
+type BalanceHistoryResponse struct {
+	Balances []BalanceHistoryEntry `json:"balances"`
+
+	// CurrentRound Round at which the results were computed.
+	CurrentRound uint64 `json:"current-round"`
+
+	// NextToken Used for pagination, when making another request provide this token with the next parameter.
+	NextToken *string `json:"next-token,omitempty"`
+}
+
 // BlockHeadersResponse defines model for BlockHeadersResponse.
 type BlockHeadersResponse struct {
 	Blocks []Block `json:"blocks"`
@@ -1492,6 +1883,25 @@ type BlockHeadersResponse struct {
 // data/bookkeeping/block.go : Block
 type BlockResponse = Block
 
+// BoxHistoryResponse defines model for BoxHistoryResponse.
+type BoxHistoryResponse struct {
+	// ApplicationId \[appidx\] application index.
+	ApplicationId uint64            `json:"application-id"`
+	Changes       []BoxHistoryEntry `json:"changes"`
+
+	// CurrentRound Round at which the results were computed.
+	CurrentRound uint64 `json:"current-round"`
+
+	// Name \[name\] box name, base64 encoded
+	Name []byte `json:"name"`
+
+	// NextToken Used for pagination, when making another request provide this token with the next parameter.
+	NextToken *string `json:"next-token,omitempty"`
+
+	// StartRound First round with recorded changes. Changes before it are missing, so the value of the box before its first change after it may be unknown.
+	StartRound uint64 `json:"start-round"`
+}
+
 // BoxResponse Box name and its content.
 type BoxResponse = Box
 
@@ -1514,9 +1924,106 @@ type ErrorResponse struct {
 	Message string                  `json:"message"`
 }
 
+// FeeStatsResponse defines model for FeeStatsResponse.
+type FeeStatsResponse struct {
+	// Aggregate Distribution of the fees paid by top level transactions, inner transaction fees are pooled by their root transaction.
+	Aggregate FeeDistribution `json:"aggregate"`
+
+	// CurrentRound Round at which the results were computed.
+	CurrentRound uint64 `json:"current-round"`
+
+	// NextToken Used for pagination, when making another request provide this token with the next parameter.
+	NextToken *string `json:"next-token,omitempty"`
+
+	// Rounds Statistics of the rounds of the page, newest first.
+	Rounds []RoundFeeStats `json:"rounds"`
+
+	// StartRound First round covered by the statistics, rounds before it are missing.
+	StartRound uint64 `json:"start-round"`
+}
+
+// GlobalStateHistoryResponse defines model for GlobalStateHistoryResponse.
+type GlobalStateHistoryResponse struct {
+	// ApplicationId \[appidx\] application index.
+	ApplicationId uint64              `json:"application-id"`
+	Changes       []GlobalStateChange `json:"changes"`
+
+	// CurrentRound Round at which the results were computed.
+	CurrentRound uint64 `json:"current-round"`
+
+	// NextToken Used for pagination, when making another request provide this token with the next parameter.
+	NextToken *string `json:"next-token,omitempty"`
+
+	// StartRound First round with recorded changes. Changes before it are missing, and the previous value of the first change after it may be missing too. Not set when the whole history is recorded.
+	StartRound *uint64 `json:"start-round,omitempty"`
+}
+
 // HealthCheckResponse A health check response.
 type HealthCheckResponse = HealthCheck
 
+// ParticipationHistoryResponse defines model for ParticipationHistoryResponse.
+type ParticipationHistoryResponse struct {
+	// ActiveRegistration Key registration transaction of an account.
+	ActiveRegistration *ParticipationRegistration `json:"active-registration,omitempty"`
+
+	// CurrentRound Round at which the results were computed.
+	CurrentRound uint64 `json:"current-round"`
+
+	// NextToken Used for pagination, when making another request provide this token with the next parameter.
+	NextToken     *string                     `json:"next-token,omitempty"`
+	Registrations []ParticipationRegistration `json:"registrations"`
+}
+
+// ProposerStatsResponse defines model for ProposerStatsResponse.
+type ProposerStatsResponse struct {
+	// CurrentRound Round at which the results were computed.
+	CurrentRound uint64 `json:"current-round"`
+
+	// Stats Statistics of the blocks proposed by an account.
+	Stats ProposerStats `json:"stats"`
+}
+
+// ProposersResponse defines model for ProposersResponse.
+type ProposersResponse struct {
+	// CurrentRound Round at which the results were computed.
+	CurrentRound uint64          `json:"current-round"`
+	Proposers    []ProposerStats `json:"proposers"`
+}
+
+// RoundsAtTimeResponse defines model for RoundsAtTimeResponse.
+type RoundsAtTimeResponse struct {
+	// CurrentRound Round at which the results were computed.
+	CurrentRound uint64 `json:"current-round"`
+
+	// NextRound The first round committed after the given time. Not set if it hasn't been committed yet.
+	NextRound *uint64 `json:"next-round,omitempty"`
+
+	// NextRoundTime Timestamp of `next-round` in seconds since epoch.
+	NextRoundTime *uint64 `json:"next-round-time,omitempty"`
+
+	// Round The last round committed at or before the given time. Not set if the time is before the first round.
+	Round *uint64 `json:"round,omitempty"`
+
+	// RoundTime Timestamp of `round` in seconds since epoch.
+	RoundTime *uint64 `json:"round-time,omitempty"`
+}
+
+// TransactionGroupResponse defines model for TransactionGroupResponse.
+type TransactionGroupResponse struct {
+	// CurrentRound Round at which the results were computed.
+	CurrentRound uint64 `json:"current-round"`
+
+	// GroupId \[grp\] Group ID, base64 encoded.
+	GroupId []byte `json:"group-id"`
+
+	// Round Round in which the group was confirmed.
+	Round uint64 `json:"round"`
+
+	// RoundTime Time when the block containing the group was added to the chain, in seconds since epoch.
+	RoundTime    uint64        `json:"round-time"`
+	Transactions []Transaction `json:"transactions"`
+}
+
 // TransactionResponse defines model for TransactionResponse.
 type TransactionResponse struct {
 	// CurrentRound Round at which the results were computed.
@@ -1530,6 +2037,19 @@ type TransactionResponse struct {
 	Transaction Transaction `json:"transaction"`
 }
 
+// TransactionStatsResponse defines model for TransactionStatsResponse.
+type TransactionStatsResponse struct {
+	// CurrentRound Round at which the results were computed.
+	CurrentRound uint64 `json:"current-round"`
+
+	// NextToken Used for pagination, when making another request provide this token with the next parameter.
+	NextToken *string `json:"next-token,omitempty"`
+
+	// StartRound First round covered by the statistics. Rounds before it are missing, and the bucket containing it may be partial.
+	StartRound uint64             `json:"start-round"`
+	Stats      []TransactionStats `json:"stats"`
+}
+
 // TransactionsResponse defines model for TransactionsResponse.
 type TransactionsResponse struct {
 	// CurrentRound Round at which the results were computed.
@@ -1539,3 +2059,12 @@ type TransactionsResponse struct {
 	NextToken    *string       `json:"next-token,omitempty"`
 	Transactions []Transaction `json:"transactions"`
 }
+
+// WaitForRoundResponse defines model for WaitForRoundResponse.
+type WaitForRoundResponse struct {
+	// CurrentRound The latest round accounted in the database.
+	CurrentRound uint64 `json:"current-round"`
+
+	// Timestamp Block creation timestamp of the current round in seconds since epoch
+	Timestamp uint64 `json:"timestamp"`
+}
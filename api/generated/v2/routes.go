@@ -33,12 +33,21 @@ type ServerInterface interface {
 	// (GET /v2/accounts/{account-id}/assets)
 	LookupAccountAssets(ctx echo.Context, accountId string, params LookupAccountAssetsParams) error
 
+	// (GET /v2/accounts/{account-id}/auth-history)
+	LookupAccountAuthHistory(ctx echo.Context, accountId string, params LookupAccountAuthHistoryParams) error
+
+	// (GET /v2/accounts/{account-id}/balance-history)
+	LookupAccountBalanceHistory(ctx echo.Context, accountId string, params LookupAccountBalanceHistoryParams) error
+
 	// (GET /v2/accounts/{account-id}/created-applications)
 	LookupAccountCreatedApplications(ctx echo.Context, accountId string, params LookupAccountCreatedApplicationsParams) error
 
 	// (GET /v2/accounts/{account-id}/created-assets)
 	LookupAccountCreatedAssets(ctx echo.Context, accountId string, params LookupAccountCreatedAssetsParams) error
 
+	// (GET /v2/accounts/{account-id}/participation-history)
+	LookupAccountParticipationHistory(ctx echo.Context, accountId string, params LookupAccountParticipationHistoryParams) error
+
 	// (GET /v2/accounts/{account-id}/transactions)
 	LookupAccountTransactions(ctx echo.Context, accountId string, params LookupAccountTransactionsParams) error
 
@@ -50,10 +59,19 @@ type ServerInterface interface {
 	// Get box information for a given application.
 	// (GET /v2/applications/{application-id}/box)
 	LookupApplicationBoxByIDAndName(ctx echo.Context, applicationId uint64, params LookupApplicationBoxByIDAndNameParams) error
+
+	// (GET /v2/applications/{application-id}/box-history)
+	LookupApplicationBoxHistory(ctx echo.Context, applicationId uint64, params LookupApplicationBoxHistoryParams) error
 	// Get box names for a given application.
 	// (GET /v2/applications/{application-id}/boxes)
 	SearchForApplicationBoxes(ctx echo.Context, applicationId uint64, params SearchForApplicationBoxesParams) error
 
+	// (GET /v2/applications/{application-id}/events)
+	LookupApplicationEvents(ctx echo.Context, applicationId uint64, params LookupApplicationEventsParams) error
+
+	// (GET /v2/applications/{application-id}/global-state-history)
+	LookupApplicationGlobalStateHistory(ctx echo.Context, applicationId uint64, params LookupApplicationGlobalStateHistoryParams) error
+
 	// (GET /v2/applications/{application-id}/logs)
 	LookupApplicationLogsByID(ctx echo.Context, applicationId uint64, params LookupApplicationLogsByIDParams) error
 
@@ -66,6 +84,9 @@ type ServerInterface interface {
 	// (GET /v2/assets/{asset-id}/balances)
 	LookupAssetBalances(ctx echo.Context, assetId uint64, params LookupAssetBalancesParams) error
 
+	// (GET /v2/assets/{asset-id}/supply)
+	LookupAssetSupply(ctx echo.Context, assetId uint64, params LookupAssetSupplyParams) error
+
 	// (GET /v2/assets/{asset-id}/transactions)
 	LookupAssetTransactions(ctx echo.Context, assetId uint64, params LookupAssetTransactionsParams) error
 
@@ -75,9 +96,33 @@ type ServerInterface interface {
 	// (GET /v2/blocks/{round-number})
 	LookupBlock(ctx echo.Context, roundNumber uint64, params LookupBlockParams) error
 
+	// (GET /v2/groups/{group-id})
+	LookupTransactionGroup(ctx echo.Context, groupId string, params LookupTransactionGroupParams) error
+
+	// (GET /v2/proposers)
+	SearchForProposers(ctx echo.Context, params SearchForProposersParams) error
+
+	// (GET /v2/proposers/{address}/stats)
+	LookupProposerStats(ctx echo.Context, address string, params LookupProposerStatsParams) error
+
+	// (GET /v2/rounds/at-time)
+	LookupRoundsAtTime(ctx echo.Context, params LookupRoundsAtTimeParams) error
+
+	// (GET /v2/stats/fees)
+	SearchForFeeStats(ctx echo.Context, params SearchForFeeStatsParams) error
+
+	// (GET /v2/stats/transactions)
+	SearchForTransactionStats(ctx echo.Context, params SearchForTransactionStatsParams) error
+
+	// (GET /v2/status/wait-for-round/{round-number})
+	WaitForRound(ctx echo.Context, roundNumber uint64) error
+
 	// (GET /v2/transactions)
 	SearchForTransactions(ctx echo.Context, params SearchForTransactionsParams) error
 
+	// (GET /v2/transactions/subscribe)
+	SubscribeTransactions(ctx echo.Context, params SubscribeTransactionsParams) error
+
 	// (GET /v2/transactions/{txid})
 	LookupTransaction(ctx echo.Context, txid string) error
 }
@@ -170,6 +215,13 @@ func (w *ServerInterfaceWrapper) SearchForAccounts(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter online-only: %s", err))
 	}
 
+	// ------------- Optional query parameter "order" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order", ctx.QueryParams(), &params.Order)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter order: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshalled arguments
 	err = w.Handler.SearchForAccounts(ctx, params)
 	return err
@@ -306,6 +358,105 @@ func (w *ServerInterfaceWrapper) LookupAccountAssets(ctx echo.Context) error {
 	return err
 }
 
+// LookupAccountAuthHistory converts echo context to params.
+func (w *ServerInterfaceWrapper) LookupAccountAuthHistory(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "account-id" -------------
+	var accountId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "account-id", runtime.ParamLocationPath, ctx.Param("account-id"), &accountId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter account-id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params LookupAccountAuthHistoryParams
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "next" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "next", ctx.QueryParams(), &params.Next)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter next: %s", err))
+	}
+
+	// ------------- Optional query parameter "min-round" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "min-round", ctx.QueryParams(), &params.MinRound)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter min-round: %s", err))
+	}
+
+	// ------------- Optional query parameter "max-round" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "max-round", ctx.QueryParams(), &params.MaxRound)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter max-round: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.LookupAccountAuthHistory(ctx, accountId, params)
+	return err
+}
+
+// LookupAccountBalanceHistory converts echo context to params.
+func (w *ServerInterfaceWrapper) LookupAccountBalanceHistory(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "account-id" -------------
+	var accountId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "account-id", runtime.ParamLocationPath, ctx.Param("account-id"), &accountId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter account-id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params LookupAccountBalanceHistoryParams
+	// ------------- Optional query parameter "asset-id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "asset-id", ctx.QueryParams(), &params.AssetId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter asset-id: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "next" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "next", ctx.QueryParams(), &params.Next)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter next: %s", err))
+	}
+
+	// ------------- Optional query parameter "min-round" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "min-round", ctx.QueryParams(), &params.MinRound)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter min-round: %s", err))
+	}
+
+	// ------------- Optional query parameter "max-round" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "max-round", ctx.QueryParams(), &params.MaxRound)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter max-round: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.LookupAccountBalanceHistory(ctx, accountId, params)
+	return err
+}
+
 // LookupAccountCreatedApplications converts echo context to params.
 func (w *ServerInterfaceWrapper) LookupAccountCreatedApplications(ctx echo.Context) error {
 	var err error
@@ -398,6 +549,59 @@ func (w *ServerInterfaceWrapper) LookupAccountCreatedAssets(ctx echo.Context) er
 	return err
 }
 
+// LookupAccountParticipationHistory converts echo context to params.
+func (w *ServerInterfaceWrapper) LookupAccountParticipationHistory(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "account-id" -------------
+	var accountId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "account-id", runtime.ParamLocationPath, ctx.Param("account-id"), &accountId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter account-id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params LookupAccountParticipationHistoryParams
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "next" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "next", ctx.QueryParams(), &params.Next)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter next: %s", err))
+	}
+
+	// ------------- Optional query parameter "min-round" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "min-round", ctx.QueryParams(), &params.MinRound)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter min-round: %s", err))
+	}
+
+	// ------------- Optional query parameter "max-round" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "max-round", ctx.QueryParams(), &params.MaxRound)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter max-round: %s", err))
+	}
+
+	// ------------- Optional query parameter "round" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "round", ctx.QueryParams(), &params.Round)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter round: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.LookupAccountParticipationHistory(ctx, accountId, params)
+	return err
+}
+
 // LookupAccountTransactions converts echo context to params.
 func (w *ServerInterfaceWrapper) LookupAccountTransactions(ctx echo.Context) error {
 	var err error
@@ -425,6 +629,13 @@ func (w *ServerInterfaceWrapper) LookupAccountTransactions(ctx echo.Context) err
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter next: %s", err))
 	}
 
+	// ------------- Optional query parameter "order" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order", ctx.QueryParams(), &params.Order)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter order: %s", err))
+	}
+
 	// ------------- Optional query parameter "note-prefix" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "note-prefix", ctx.QueryParams(), &params.NotePrefix)
@@ -617,6 +828,59 @@ func (w *ServerInterfaceWrapper) LookupApplicationBoxByIDAndName(ctx echo.Contex
 	return err
 }
 
+// LookupApplicationBoxHistory converts echo context to params.
+func (w *ServerInterfaceWrapper) LookupApplicationBoxHistory(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "application-id" -------------
+	var applicationId uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "application-id", runtime.ParamLocationPath, ctx.Param("application-id"), &applicationId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter application-id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params LookupApplicationBoxHistoryParams
+	// ------------- Required query parameter "name" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "name", ctx.QueryParams(), &params.Name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "next" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "next", ctx.QueryParams(), &params.Next)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter next: %s", err))
+	}
+
+	// ------------- Optional query parameter "min-round" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "min-round", ctx.QueryParams(), &params.MinRound)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter min-round: %s", err))
+	}
+
+	// ------------- Optional query parameter "max-round" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "max-round", ctx.QueryParams(), &params.MaxRound)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter max-round: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.LookupApplicationBoxHistory(ctx, applicationId, params)
+	return err
+}
+
 // SearchForApplicationBoxes converts echo context to params.
 func (w *ServerInterfaceWrapper) SearchForApplicationBoxes(ctx echo.Context) error {
 	var err error
@@ -656,8 +920,8 @@ func (w *ServerInterfaceWrapper) SearchForApplicationBoxes(ctx echo.Context) err
 	return err
 }
 
-// LookupApplicationLogsByID converts echo context to params.
-func (w *ServerInterfaceWrapper) LookupApplicationLogsByID(ctx echo.Context) error {
+// LookupApplicationEvents converts echo context to params.
+func (w *ServerInterfaceWrapper) LookupApplicationEvents(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "application-id" -------------
 	var applicationId uint64
@@ -668,7 +932,14 @@ func (w *ServerInterfaceWrapper) LookupApplicationLogsByID(ctx echo.Context) err
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params LookupApplicationLogsByIDParams
+	var params LookupApplicationEventsParams
+	// ------------- Optional query parameter "name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "name", ctx.QueryParams(), &params.Name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
 	// ------------- Optional query parameter "limit" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
@@ -683,13 +954,6 @@ func (w *ServerInterfaceWrapper) LookupApplicationLogsByID(ctx echo.Context) err
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter next: %s", err))
 	}
 
-	// ------------- Optional query parameter "txid" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "txid", ctx.QueryParams(), &params.Txid)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter txid: %s", err))
-	}
-
 	// ------------- Optional query parameter "min-round" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "min-round", ctx.QueryParams(), &params.MinRound)
@@ -704,29 +968,29 @@ func (w *ServerInterfaceWrapper) LookupApplicationLogsByID(ctx echo.Context) err
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter max-round: %s", err))
 	}
 
-	// ------------- Optional query parameter "sender-address" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "sender-address", ctx.QueryParams(), &params.SenderAddress)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sender-address: %s", err))
-	}
-
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.LookupApplicationLogsByID(ctx, applicationId, params)
+	err = w.Handler.LookupApplicationEvents(ctx, applicationId, params)
 	return err
 }
 
-// SearchForAssets converts echo context to params.
-func (w *ServerInterfaceWrapper) SearchForAssets(ctx echo.Context) error {
+// LookupApplicationGlobalStateHistory converts echo context to params.
+func (w *ServerInterfaceWrapper) LookupApplicationGlobalStateHistory(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "application-id" -------------
+	var applicationId uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "application-id", runtime.ParamLocationPath, ctx.Param("application-id"), &applicationId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter application-id: %s", err))
+	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params SearchForAssetsParams
-	// ------------- Optional query parameter "include-all" -------------
+	var params LookupApplicationGlobalStateHistoryParams
+	// ------------- Required query parameter "key" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "include-all", ctx.QueryParams(), &params.IncludeAll)
+	err = runtime.BindQueryParameter("form", true, true, "key", ctx.QueryParams(), &params.Key)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter include-all: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter key: %s", err))
 	}
 
 	// ------------- Optional query parameter "limit" -------------
@@ -743,11 +1007,124 @@ func (w *ServerInterfaceWrapper) SearchForAssets(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter next: %s", err))
 	}
 
-	// ------------- Optional query parameter "creator" -------------
+	// ------------- Optional query parameter "min-round" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "creator", ctx.QueryParams(), &params.Creator)
+	err = runtime.BindQueryParameter("form", true, false, "min-round", ctx.QueryParams(), &params.MinRound)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter creator: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter min-round: %s", err))
+	}
+
+	// ------------- Optional query parameter "max-round" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "max-round", ctx.QueryParams(), &params.MaxRound)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter max-round: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.LookupApplicationGlobalStateHistory(ctx, applicationId, params)
+	return err
+}
+
+// LookupApplicationLogsByID converts echo context to params.
+func (w *ServerInterfaceWrapper) LookupApplicationLogsByID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "application-id" -------------
+	var applicationId uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "application-id", runtime.ParamLocationPath, ctx.Param("application-id"), &applicationId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter application-id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params LookupApplicationLogsByIDParams
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "next" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "next", ctx.QueryParams(), &params.Next)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter next: %s", err))
+	}
+
+	// ------------- Optional query parameter "order" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order", ctx.QueryParams(), &params.Order)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter order: %s", err))
+	}
+
+	// ------------- Optional query parameter "txid" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "txid", ctx.QueryParams(), &params.Txid)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter txid: %s", err))
+	}
+
+	// ------------- Optional query parameter "min-round" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "min-round", ctx.QueryParams(), &params.MinRound)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter min-round: %s", err))
+	}
+
+	// ------------- Optional query parameter "max-round" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "max-round", ctx.QueryParams(), &params.MaxRound)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter max-round: %s", err))
+	}
+
+	// ------------- Optional query parameter "sender-address" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sender-address", ctx.QueryParams(), &params.SenderAddress)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sender-address: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.LookupApplicationLogsByID(ctx, applicationId, params)
+	return err
+}
+
+// SearchForAssets converts echo context to params.
+func (w *ServerInterfaceWrapper) SearchForAssets(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SearchForAssetsParams
+	// ------------- Optional query parameter "include-all" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include-all", ctx.QueryParams(), &params.IncludeAll)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter include-all: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "next" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "next", ctx.QueryParams(), &params.Next)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter next: %s", err))
+	}
+
+	// ------------- Optional query parameter "creator" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "creator", ctx.QueryParams(), &params.Creator)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter creator: %s", err))
 	}
 
 	// ------------- Optional query parameter "name" -------------
@@ -849,11 +1226,43 @@ func (w *ServerInterfaceWrapper) LookupAssetBalances(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter currency-less-than: %s", err))
 	}
 
+	// ------------- Optional query parameter "order" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order", ctx.QueryParams(), &params.Order)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter order: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshalled arguments
 	err = w.Handler.LookupAssetBalances(ctx, assetId, params)
 	return err
 }
 
+// LookupAssetSupply converts echo context to params.
+func (w *ServerInterfaceWrapper) LookupAssetSupply(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "asset-id" -------------
+	var assetId uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "asset-id", runtime.ParamLocationPath, ctx.Param("asset-id"), &assetId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter asset-id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params LookupAssetSupplyParams
+	// ------------- Optional query parameter "exclude-address" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "exclude-address", ctx.QueryParams(), &params.ExcludeAddress)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter exclude-address: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.LookupAssetSupply(ctx, assetId, params)
+	return err
+}
+
 // LookupAssetTransactions converts echo context to params.
 func (w *ServerInterfaceWrapper) LookupAssetTransactions(ctx echo.Context) error {
 	var err error
@@ -881,6 +1290,13 @@ func (w *ServerInterfaceWrapper) LookupAssetTransactions(ctx echo.Context) error
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter next: %s", err))
 	}
 
+	// ------------- Optional query parameter "order" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order", ctx.QueryParams(), &params.Order)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter order: %s", err))
+	}
+
 	// ------------- Optional query parameter "note-prefix" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "note-prefix", ctx.QueryParams(), &params.NotePrefix)
@@ -1011,6 +1427,13 @@ func (w *ServerInterfaceWrapper) SearchForBlockHeaders(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter next: %s", err))
 	}
 
+	// ------------- Optional query parameter "order" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order", ctx.QueryParams(), &params.Order)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter order: %s", err))
+	}
+
 	// ------------- Optional query parameter "min-round" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "min-round", ctx.QueryParams(), &params.MinRound)
@@ -1090,6 +1513,214 @@ func (w *ServerInterfaceWrapper) LookupBlock(ctx echo.Context) error {
 	return err
 }
 
+// LookupTransactionGroup converts echo context to params.
+func (w *ServerInterfaceWrapper) LookupTransactionGroup(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "group-id" -------------
+	var groupId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "group-id", runtime.ParamLocationPath, ctx.Param("group-id"), &groupId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter group-id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params LookupTransactionGroupParams
+	// ------------- Optional query parameter "txid" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "txid", ctx.QueryParams(), &params.Txid)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter txid: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.LookupTransactionGroup(ctx, groupId, params)
+	return err
+}
+
+// SearchForProposers converts echo context to params.
+func (w *ServerInterfaceWrapper) SearchForProposers(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SearchForProposersParams
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "min-round" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "min-round", ctx.QueryParams(), &params.MinRound)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter min-round: %s", err))
+	}
+
+	// ------------- Optional query parameter "max-round" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "max-round", ctx.QueryParams(), &params.MaxRound)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter max-round: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.SearchForProposers(ctx, params)
+	return err
+}
+
+// LookupProposerStats converts echo context to params.
+func (w *ServerInterfaceWrapper) LookupProposerStats(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "address" -------------
+	var address string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "address", runtime.ParamLocationPath, ctx.Param("address"), &address)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter address: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params LookupProposerStatsParams
+	// ------------- Optional query parameter "min-round" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "min-round", ctx.QueryParams(), &params.MinRound)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter min-round: %s", err))
+	}
+
+	// ------------- Optional query parameter "max-round" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "max-round", ctx.QueryParams(), &params.MaxRound)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter max-round: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.LookupProposerStats(ctx, address, params)
+	return err
+}
+
+// LookupRoundsAtTime converts echo context to params.
+func (w *ServerInterfaceWrapper) LookupRoundsAtTime(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params LookupRoundsAtTimeParams
+	// ------------- Required query parameter "time" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "time", ctx.QueryParams(), &params.Time)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter time: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.LookupRoundsAtTime(ctx, params)
+	return err
+}
+
+// SearchForFeeStats converts echo context to params.
+func (w *ServerInterfaceWrapper) SearchForFeeStats(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SearchForFeeStatsParams
+	// ------------- Optional query parameter "min-round" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "min-round", ctx.QueryParams(), &params.MinRound)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter min-round: %s", err))
+	}
+
+	// ------------- Optional query parameter "max-round" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "max-round", ctx.QueryParams(), &params.MaxRound)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter max-round: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "next" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "next", ctx.QueryParams(), &params.Next)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter next: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.SearchForFeeStats(ctx, params)
+	return err
+}
+
+// SearchForTransactionStats converts echo context to params.
+func (w *ServerInterfaceWrapper) SearchForTransactionStats(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SearchForTransactionStatsParams
+	// ------------- Required query parameter "interval" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "interval", ctx.QueryParams(), &params.Interval)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter interval: %s", err))
+	}
+
+	// ------------- Optional query parameter "min-round" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "min-round", ctx.QueryParams(), &params.MinRound)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter min-round: %s", err))
+	}
+
+	// ------------- Optional query parameter "max-round" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "max-round", ctx.QueryParams(), &params.MaxRound)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter max-round: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "next" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "next", ctx.QueryParams(), &params.Next)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter next: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.SearchForTransactionStats(ctx, params)
+	return err
+}
+
+// WaitForRound converts echo context to params.
+func (w *ServerInterfaceWrapper) WaitForRound(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "round-number" -------------
+	var roundNumber uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "round-number", runtime.ParamLocationPath, ctx.Param("round-number"), &roundNumber)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter round-number: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.WaitForRound(ctx, roundNumber)
+	return err
+}
+
 // SearchForTransactions converts echo context to params.
 func (w *ServerInterfaceWrapper) SearchForTransactions(ctx echo.Context) error {
 	var err error
@@ -1110,6 +1741,13 @@ func (w *ServerInterfaceWrapper) SearchForTransactions(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter next: %s", err))
 	}
 
+	// ------------- Optional query parameter "order" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order", ctx.QueryParams(), &params.Order)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter order: %s", err))
+	}
+
 	// ------------- Optional query parameter "note-prefix" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "note-prefix", ctx.QueryParams(), &params.NotePrefix)
@@ -1236,11 +1874,239 @@ func (w *ServerInterfaceWrapper) SearchForTransactions(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter application-id: %s", err))
 	}
 
+	// ------------- Optional query parameter "method-selector" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "method-selector", ctx.QueryParams(), &params.MethodSelector)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter method-selector: %s", err))
+	}
+
+	// ------------- Optional query parameter "on-completion" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "on-completion", ctx.QueryParams(), &params.OnCompletion)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter on-completion: %s", err))
+	}
+
+	// ------------- Optional query parameter "foreign-app-id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "foreign-app-id", ctx.QueryParams(), &params.ForeignAppId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter foreign-app-id: %s", err))
+	}
+
+	// ------------- Optional query parameter "foreign-asset-id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "foreign-asset-id", ctx.QueryParams(), &params.ForeignAssetId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter foreign-asset-id: %s", err))
+	}
+
+	// ------------- Optional query parameter "box-reference" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "box-reference", ctx.QueryParams(), &params.BoxReference)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter box-reference: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshalled arguments
 	err = w.Handler.SearchForTransactions(ctx, params)
 	return err
 }
 
+// SubscribeTransactions converts echo context to params.
+func (w *ServerInterfaceWrapper) SubscribeTransactions(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SubscribeTransactionsParams
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "next" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "next", ctx.QueryParams(), &params.Next)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter next: %s", err))
+	}
+
+	// ------------- Optional query parameter "order" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order", ctx.QueryParams(), &params.Order)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter order: %s", err))
+	}
+
+	// ------------- Optional query parameter "note-prefix" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "note-prefix", ctx.QueryParams(), &params.NotePrefix)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter note-prefix: %s", err))
+	}
+
+	// ------------- Optional query parameter "tx-type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tx-type", ctx.QueryParams(), &params.TxType)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tx-type: %s", err))
+	}
+
+	// ------------- Optional query parameter "sig-type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sig-type", ctx.QueryParams(), &params.SigType)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sig-type: %s", err))
+	}
+
+	// ------------- Optional query parameter "group-id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "group-id", ctx.QueryParams(), &params.GroupId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter group-id: %s", err))
+	}
+
+	// ------------- Optional query parameter "txid" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "txid", ctx.QueryParams(), &params.Txid)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter txid: %s", err))
+	}
+
+	// ------------- Optional query parameter "round" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "round", ctx.QueryParams(), &params.Round)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter round: %s", err))
+	}
+
+	// ------------- Optional query parameter "min-round" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "min-round", ctx.QueryParams(), &params.MinRound)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter min-round: %s", err))
+	}
+
+	// ------------- Optional query parameter "max-round" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "max-round", ctx.QueryParams(), &params.MaxRound)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter max-round: %s", err))
+	}
+
+	// ------------- Optional query parameter "asset-id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "asset-id", ctx.QueryParams(), &params.AssetId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter asset-id: %s", err))
+	}
+
+	// ------------- Optional query parameter "before-time" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "before-time", ctx.QueryParams(), &params.BeforeTime)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter before-time: %s", err))
+	}
+
+	// ------------- Optional query parameter "after-time" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "after-time", ctx.QueryParams(), &params.AfterTime)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter after-time: %s", err))
+	}
+
+	// ------------- Optional query parameter "currency-greater-than" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "currency-greater-than", ctx.QueryParams(), &params.CurrencyGreaterThan)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter currency-greater-than: %s", err))
+	}
+
+	// ------------- Optional query parameter "currency-less-than" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "currency-less-than", ctx.QueryParams(), &params.CurrencyLessThan)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter currency-less-than: %s", err))
+	}
+
+	// ------------- Optional query parameter "address" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "address", ctx.QueryParams(), &params.Address)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter address: %s", err))
+	}
+
+	// ------------- Optional query parameter "address-role" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "address-role", ctx.QueryParams(), &params.AddressRole)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter address-role: %s", err))
+	}
+
+	// ------------- Optional query parameter "exclude-close-to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "exclude-close-to", ctx.QueryParams(), &params.ExcludeCloseTo)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter exclude-close-to: %s", err))
+	}
+
+	// ------------- Optional query parameter "rekey-to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "rekey-to", ctx.QueryParams(), &params.RekeyTo)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter rekey-to: %s", err))
+	}
+
+	// ------------- Optional query parameter "application-id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "application-id", ctx.QueryParams(), &params.ApplicationId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter application-id: %s", err))
+	}
+
+	// ------------- Optional query parameter "method-selector" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "method-selector", ctx.QueryParams(), &params.MethodSelector)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter method-selector: %s", err))
+	}
+
+	// ------------- Optional query parameter "on-completion" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "on-completion", ctx.QueryParams(), &params.OnCompletion)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter on-completion: %s", err))
+	}
+
+	// ------------- Optional query parameter "foreign-app-id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "foreign-app-id", ctx.QueryParams(), &params.ForeignAppId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter foreign-app-id: %s", err))
+	}
+
+	// ------------- Optional query parameter "foreign-asset-id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "foreign-asset-id", ctx.QueryParams(), &params.ForeignAssetId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter foreign-asset-id: %s", err))
+	}
+
+	// ------------- Optional query parameter "box-reference" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "box-reference", ctx.QueryParams(), &params.BoxReference)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter box-reference: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.SubscribeTransactions(ctx, params)
+	return err
+}
+
 // LookupTransaction converts echo context to params.
 func (w *ServerInterfaceWrapper) LookupTransaction(ctx echo.Context) error {
 	var err error
@@ -1289,21 +2155,36 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.GET(baseURL+"/v2/accounts/:account-id", wrapper.LookupAccountByID, m...)
 	router.GET(baseURL+"/v2/accounts/:account-id/apps-local-state", wrapper.LookupAccountAppLocalStates, m...)
 	router.GET(baseURL+"/v2/accounts/:account-id/assets", wrapper.LookupAccountAssets, m...)
+	router.GET(baseURL+"/v2/accounts/:account-id/auth-history", wrapper.LookupAccountAuthHistory, m...)
+	router.GET(baseURL+"/v2/accounts/:account-id/balance-history", wrapper.LookupAccountBalanceHistory, m...)
 	router.GET(baseURL+"/v2/accounts/:account-id/created-applications", wrapper.LookupAccountCreatedApplications, m...)
 	router.GET(baseURL+"/v2/accounts/:account-id/created-assets", wrapper.LookupAccountCreatedAssets, m...)
+	router.GET(baseURL+"/v2/accounts/:account-id/participation-history", wrapper.LookupAccountParticipationHistory, m...)
 	router.GET(baseURL+"/v2/accounts/:account-id/transactions", wrapper.LookupAccountTransactions, m...)
 	router.GET(baseURL+"/v2/applications", wrapper.SearchForApplications, m...)
 	router.GET(baseURL+"/v2/applications/:application-id", wrapper.LookupApplicationByID, m...)
 	router.GET(baseURL+"/v2/applications/:application-id/box", wrapper.LookupApplicationBoxByIDAndName, m...)
+	router.GET(baseURL+"/v2/applications/:application-id/box-history", wrapper.LookupApplicationBoxHistory, m...)
 	router.GET(baseURL+"/v2/applications/:application-id/boxes", wrapper.SearchForApplicationBoxes, m...)
+	router.GET(baseURL+"/v2/applications/:application-id/events", wrapper.LookupApplicationEvents, m...)
+	router.GET(baseURL+"/v2/applications/:application-id/global-state-history", wrapper.LookupApplicationGlobalStateHistory, m...)
 	router.GET(baseURL+"/v2/applications/:application-id/logs", wrapper.LookupApplicationLogsByID, m...)
 	router.GET(baseURL+"/v2/assets", wrapper.SearchForAssets, m...)
 	router.GET(baseURL+"/v2/assets/:asset-id", wrapper.LookupAssetByID, m...)
 	router.GET(baseURL+"/v2/assets/:asset-id/balances", wrapper.LookupAssetBalances, m...)
+	router.GET(baseURL+"/v2/assets/:asset-id/supply", wrapper.LookupAssetSupply, m...)
 	router.GET(baseURL+"/v2/assets/:asset-id/transactions", wrapper.LookupAssetTransactions, m...)
 	router.GET(baseURL+"/v2/block-headers", wrapper.SearchForBlockHeaders, m...)
 	router.GET(baseURL+"/v2/blocks/:round-number", wrapper.LookupBlock, m...)
+	router.GET(baseURL+"/v2/groups/:group-id", wrapper.LookupTransactionGroup, m...)
+	router.GET(baseURL+"/v2/proposers", wrapper.SearchForProposers, m...)
+	router.GET(baseURL+"/v2/proposers/:address/stats", wrapper.LookupProposerStats, m...)
+	router.GET(baseURL+"/v2/rounds/at-time", wrapper.LookupRoundsAtTime, m...)
+	router.GET(baseURL+"/v2/stats/fees", wrapper.SearchForFeeStats, m...)
+	router.GET(baseURL+"/v2/stats/transactions", wrapper.SearchForTransactionStats, m...)
+	router.GET(baseURL+"/v2/status/wait-for-round/:round-number", wrapper.WaitForRound, m...)
 	router.GET(baseURL+"/v2/transactions", wrapper.SearchForTransactions, m...)
+	router.GET(baseURL+"/v2/transactions/subscribe", wrapper.SubscribeTransactions, m...)
 	router.GET(baseURL+"/v2/transactions/:txid", wrapper.LookupTransaction, m...)
 
 }
@@ -1311,259 +2192,371 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+y9a5PbNpYA+ldQultlOyuqbedRO12V2nLs8cYVJ5OynczuunM3EAlJmKYADgB2S8n1",
-	"f7+FcwAQJEGJ6ncm+mS3iMcBcHBeOI/fJ7lcV1IwYfTk9PdJRRVdM8MU/EXnmglj/1cwnSteGS7F5HTy",
-	"Is9lLYwma6rOWUGoJtiUcEHMipF5KfNzsmK0YOqRJhVVhue8orY/qauCGqZn5MOKwzeckdA8Z5XRhJJc",
-	"rteUaGa/GVaQkmtD5ILQolBMa6Znk+mEbapSFmxyuqClZtMJt5D9s2ZqO5lOBF2zyalfwHSi8xVbU7sS",
-	"btgaFme2lW2ijeJiOZlONhktl1JRUWQLqdbU2IXihJNPU9+cKkW39m9ttqX9wba1f1Pck4wX/f1y30iY",
-	"C2CtqFlFoDb9pxPF/llzxYrJqVE1i8FvQ/3JTuxg7M36N1FuCRd5WReMGEWFprn9pMklNyti7O67zvbc",
-	"pGB2j+3xRY3JgrOygA1PbrCbfBjEvRu757ObIVPSbnd3jS/les4F8ytiYUENWhlJCraARitqiIUuwiX7",
-	"WTOq8hVZSLVnmQhEvFYm6vXk9ONEM1EwBSeXM34B/10oxn5jmaFqyczkl2nq7BaGqczwdWJpb9zJKabr",
-	"0l6LBaxmxciSXzBBbK8Z+b7WhswZoYK8e/2SfP75538huI324uBUg6tqZo/XFE7BXlP/ecyhvnv9EuZ/",
-	"7xY4thWtqpLnQByS1+dF8528eTW0mPYgCYTkwrAlU7jxWrP0XX1hv+yYxnfcN0FtVplFm+GDpZ6K5lIs",
-	"+LJWrLDYWGuGd1NXTBRcLMk52w4eYZjm9m7gnC2kYiOxFBvfKJrG898rns7lJkOYekhD5nJD7DdLSZeS",
-	"lhlVS1ghecRELu05nl7QsmaPZuS1VIQLo6furJlryIU5ffb88y9cE0UvyXxrWK/d/KsvTl98/bVrViku",
-	"DJ2XzG1jr7k26nTFylK6DoGLdhvaD6f//T//O5vNHg0dBvxzGIPKa6WYyLfZUjEKFGdFRX8P3zkM0itZ",
-	"lwVZ0QtAF7oG1un6EtsXrwfs5ox8z3MlX5RLqQl1iFewBa1LQ/zEpBalJfV2NHd9iZU8lLzgBSum9swu",
-	"VzxfkZy6DYF25JKXpcXaWrNiaEPSq9tDHUInC9eV9gMW9HA3o1nXnp1gG6Af/eX/deOoZFFw+xMtCYhu",
-	"RNf5CiROgGolywKRPmIApJQ5LUlBDSXaSEtYF1I5iQep7tT1bwReksMBFmS+7bYURWv0/X3Gyqd+9UkB",
-	"1csWtCwnjmNZQctNmYUfaFXpDFacaUMNi9tUlW0hpGAJAWS/UOvgy/JSapYZuUcA8zIVbFgkMsU7dpA4",
-	"Rj6sGIHJ7QcURQGzhaXSZbklxh2ARQjiha8p4QuylTW5hKtT8nPo71ZjcXpN7OGbtgJiJLHUbAi5e5uR",
-	"QO25lCWjwqF2hSRyhPrk2j40/ckv4S4UqKWSdZUUyd5KeV5XbRVmviXQgbx55TYCsIOsnaAxp5p99UUG",
-	"vNdSNUBJK+9eUlXoqftO8hVVNEfEtOhoceund2+zWmi6YOQxn7EZ+XpKTqbk35+EwW0LN/IAroTFHCqW",
-	"IVxDMknzFXEjk6Lc9jfsW/hI7EeyKOlyRv6+Yo5TWMHSoj7i+pQoZmolLAkDnCsk00RIY4VSQx06xjs/",
-	"sOAYnj33wqmkmaVrw8Jx6ek9NrdyMFy8IsjNU1KwksHlb4gz/KqNklu4WJZETomsLDGUtekzDVG4YfFz",
-	"l4cAQR3UfuOV7Fl0ydc8YUn5nm74ul4TUa/n9sQWQZA20h0NEEHFSA60bN7iiBVdMk2YlbM5qu4wjz1k",
-	"e4aK0Xw1zK0Rpj0Mek03mZK1KEZoqIZIFWsAumI5X3BWkDDKECzNNPvg4eIweBq9OQLHDzIITphlDziC",
-	"bRLHatmW/QIHFJ3qjPzkZCr4auQ5E0H0QiGCkUqxCy5rHToNieJ26t2it5CGZZViC77pA/nebYflGNjG",
-	"CX6ezDkS0LAlOxzS2UGYoglvi/RJUXLBBkjfPkKHRDGo3pcrK1u0+au98zX0R3HWlFuCcw6tOoZoDx2o",
-	"lKykdvbVvWKBb/3Q5IJmFXchGSh2zrZJ6bN74xF/g81zZb9g391oG2bYc3ojCQ8qGzHB2UlsRhEaaJQh",
-	"n0goi/ar4yJp+3Kr/wgFPp4brZvZtSzNOIZHtaGt6Mx0e0YtzZcZjtgji3z5wSolC16CXPgPSw39ydba",
-	"CiLts/UqjOZLQU2t2OmZ+Mz+RTLy3lBRUFXYX9b40/d1afh7vrQ/lfjTW7nk+Xu+HNoUD2vS8gzd1viP",
-	"HS9taTabsNzUFP5zaoaK2obnbKuYnYPmC/hnswBEogv1GyqhIAOZajGZTlbzISh2yffNruatJ4j51kr5",
-	"A5sDQ+7igkBAdCWFZoC6jsy+c7/Znyyjcw9dkQR48g8twS7TjG3pHlOG40iOi9j//ptii8np5P85aZ7T",
-	"TrCbPnETToLdxwwJMHiLqXF0DOmXo2woAq6r2qBAlyIR4U5/DLB152yORc7/wXKDG9QG4zFbV2b7xALs",
-	"edLN7ZZucYqR+9blELe4jyjSZSCa9Uf+STtbUkWXXMDCp+TSyhxrem5JAxXSrJgi9iyYNl64QxqI8l54",
-	"q3ISouPTs0nqxiTOVF/7UJtTe2uVnPeg5NzEEXcsUQecdQqk48mHk+9t7E2iwPKGzn7nI97Z2UdaVbzY",
-	"nJ390tKzuSjYJn0et3rYpVxmBTX0aji6fGW7JhD0IeNQ+4H0phDoZpHngFO4W456U9t1w5ftSjT2SFkT",
-	"t+L6RFVrZr6hJRX5jbDTuRtq9Al/zwUHIL5FA+fxmP0xh628iSN2u3sjFxkf8UZf4ePhpu5weBq99tHe",
-	"1JGOOsg71ghhypvYpPtC/CPG3yzGf1PK/BzfCm+EXdnhxh8pzH480sChcPdu4kivdJYjjmr3zHJz8/PK",
-	"TWrWb+SGcIFWXSfMfiM37KFqsXML2/hrITev3JRSPWwFc/A95IO9k3BNLSz+nnIdnxrhmihWsguK7lL7",
-	"uFdXe8VdHXM9vnGumRqeukR8bHYNf1VKqhtAHW9L6MAznayZ1nTJ0i+08Rp9wzGL8gDDDjO7BHjW+JbR",
-	"0qxertgtUIFo7D204ENjvL+Bjb1VfhC9M+xbf7SqPcaB9rAHkvBoGv3Qd+8h0aKWk9JYats60y6tHX/G",
-	"+rBD/uTfq+IHqUGHgBbVpIZQ51mPb8pn4ky8YgsuwCfo9ExYOnQyp5rn+qTWTDmDxGwpySlxQ76ihp6J",
-	"ybTL/YYeeMEL2EFT1fOS5+ScbVOngO7JiRGkoWXk5hR5KjvfhubFqo9nOGpm0UHWJnOBEZli4M3Xn00H",
-	"1xYYGV2md806JW5s9MBxgRdu/DTu99xu+2FfOz2SuWi7DNuD/EEa57ZALwkiEqk10+TXNa0+cmF+IdlZ",
-	"/fTp54y8qKrmpeTXxr/ZAgpvpTf67AKLhTPM2MYomoHnWRpRdL0GTluWBNq2faeVXCq6dp5rXa/sHTuN",
-	"k4/jVNGyYEXvsdenaaR2do4KficrVvZ9uQ89mMhGc+Vz2WPn2RFd9CEKgqNLyoX2tF3zpbBY7QIK5ozk",
-	"lpezYkbeLAjQpmkrhs5FAzq6FwgA1xgDELvd5lRAbAC4IAFuU7HtPuJrZox3n3jHztn2Q+SWc6B7h3Pa",
-	"pHsYW1Hb4WIR1K/ikmqyluDakaOPFw6ZQME0MDUXBh3SWt72PUAi33d7KyJ781D0QOTySquKLEs5d7Qj",
-	"4OJpQEbfZ5hM/GgB0DdAIpLKejsaYd/q8ZoNRU0cvjo73rUu2c41XRm5Flxp8CZm1JF6Gl+GK+CYc3VO",
-	"OjyCFCUVuPy28Sh2Yeyhd3BsA5dsJgy/YBkr+ZLPU6G2OW1xTB9s4XwSwwia8AXhRhNncieg9hFFxZJZ",
-	"6QVdB2mJgYFJaEqqTbZiVJk5o2aXehlilVrLtv3JpSVZ6Jg5tZvDNhaPud0JxQS7ZIVdDVeujfP6HHgq",
-	"tgA5n8fiivD47o0fZ3quNReZ27qEu7iXX8LuegHVOw/HVwngwu9rBjF08lJD5ENBpAv/6gU31VYFTYPW",
-	"cjcd6b3zY6uPHWSf7JaU1uSiK5T15KckyNg4s2vuz1Rr52VLlfHMzo+Oeg9APSPg3+g2aV5CLFHkQWzP",
-	"m6qWFzEGsw6Bo4fEYz95e+3xpVtR7S8ehOp5PjFKYr1Fa80uh0qIIOn6SIII0Q+1867nmIzAO1J670nv",
-	"Mmn/tfSuLktLbWpxLuSlVWcOcYqcTvDK9wG+kCCmOD9whxgOxEc6OhoLx98WC6AfGeGisJeIuVAiFzkp",
-	"c44Bag1NtrR8aX+c2QEsdtkBRo+QQls3JEjYUpY4MPlBxvdPLA8BUjAOfIX6sYHBRH+ztBYOYjpI7Bh1",
-	"w0Ua43J/y62e0JKKADAId50zJjB4h3AxJZaUXdDSkjIjUTQNg6RVrcctLckJ7vrJkAqWthDhikByOWhN",
-	"KOtcZTWx+O+BTusmOyCey00G4eN9WCEKvKqyQMSkKLcYbNnV02EEux6ZA4Z41/ZztsU4T4g8hlsCFllH",
-	"P+aslFbSlz0Maw5qD/DXBfwGodkt4KewWQPqoeTdoN2OaOG9Uw/I10No9xhw6BoAdO3vwSPfWXj2GmXa",
-	"okyf8TfccNpEQCBFTpORoavYR/g2FiVPcWB/+2a84AP9Y1f6SRrrWq0INpk7O1SkC6W4nyVHuRSaCV1D",
-	"6I+RuSxnPSudZiUDNSJrCWTZOUvEQ733jSO7HXnMF1Y/fxJpB4otuTasFSofglaaIKwthJdX1Bim7PD/",
-	"7+P/PP34Ivtfmv32NPvLv5/88vsXn5581vvx+aevv/7/2j99/unrJ//5b5MBtsysuC0X6TW9kzIwPmhM",
-	"oHFraXcO9YU0LAO9L7ugZert8DUohUlJqx3Ihfkc+IDNHSY6Z9us4GWdxsUfAhXU9RwoNReEUUsJqclX",
-	"IE23ZrRtdswG+s/Aqt7SG1vUCHRW9ujbA/9B8LpDT3dd4gQypY69fziD+7iDrIFk9IqV+Hg5nHgJL1ph",
-	"G852PRz0Lkbhx96lLUZQDHMeHCm5lrb/8PAq4Jke5BZuohhJ3VvRWBvQZYg3jUXQSxqMXLdu64lXF9t7",
-	"3ChpE4v7eI3l9Ycfu7xkhrxxrhRwYIeYLFEA6uEU3BU32B58it5F+szVqhHaKRx4QSLhElOiiK6Q2cGz",
-	"kHxg3Fl4WcHlQpB14IS7ZdmbwzmWULZw7Sn0Iwsl13DZ+rJmbIAcsEu0sK5hLZ1ZXea+Pr5YegkKyt53",
-	"YEbL79j2Z9sWThUCzZ2EOfaWNGYar+V5jeNaR3O9N68U5rsR92I+BrkMoT3keMO3idYL9YE3oJRLnYoJ",
-	"XTZx1DEWzJlVitmG5bVpzJ4d43qw/9+tDNh9SEiHu0Y+B5hncLekAPvjxtpzYj8G8nibB0arSskLWmbu",
-	"LTdJzaGFf+29Y1krfaE+/PXF2x8dxPCAyKjKgq6RXgg0anSMB7sWK2rIPY/BYIjyBoAuS3ePuVy3HoAv",
-	"IfFMR3W1wpPDItyY5hE/uqbuQXjhRe0Dn3edkwEucZezQWPwQV+Dtn8BvaC89CZ7D2OaVeCSGleOg7lF",
-	"PMC1/RQiv5Jrj3XBlE4Kxu39c+lDSJ9n+U3Vo9w027QhfdH20LF4ATvS1qwxeZIm0qWnaXDB6rrw5gBY",
-	"v6Zbi4xo9e0TNFGvwXCU6ZKnXt3a1lACrQbUZTuU5dy7BrHf9QiTWwesaPDk9vmwk6HdmkvnS1cL/s+a",
-	"EV4wYewnBVe6c8vtpfYpIq+sHCUeyDGV5B2qRzDhIYqRSx52rcWFUa6iHln1J/FYiafm1hPO7jpqUmMh",
-	"7ouJAMRuHSn2UeqB+ypYPj0WhQcMKlqv1Ac4L8Yz9qSSAcfD6N4J7p5RrnAq+xNGez3MJZdL04eD1Kw4",
-	"V921lCudLZT8LeWke9mfNpoQe6UHHa0cde7JgJLEOwldr3BEIcvfdUEKSvW1gepyx/B00mQRbw5n8JIN",
-	"ifXxE0/b43WAkMN9gxAWqs7OfkG91T8jU4EX7CVkI29pVOlrGvs/n+D4zTV1MPfNHfRyTvPzxGIap8PW",
-	"Q7eRxHcKeRvbpzMjkf9iaOtSIFZMrblpk/tGYbuq4IzTjhaZGwkZsCmWjV1m0lLLxDC1uKTC+ESWjoC5",
-	"3nG5jUuptIF8zclVFizna1oOvB42BLLgS46ZJ2vNoryJrj+pJBcGkabguirpFr05mx15syBPpxHxcodQ",
-	"8Auu+bxk0OIZtphTDbJIY8DyXeyqmDArDc2fj2i+qkWhWGFWLqWnliToNGD/afLCMnPJmCBPod2zv5DH",
-	"4ESj+QV7YjfPyZST02d/gQdM/ONpmpZDZu1B2upJehprwWUIu1qm6AZL01qsJHHQncEuY24MtHQEf/+N",
-	"WVNBl6kMdDtgwT6N20BnH0SBSaFBZCLcpOdlhlqqk62oXqUS8OdyveZm7dwptFxbbGmStOFcfhR0GUBy",
-	"HcDxH8HBuSJp293dGpTS1QZ+oGvW3sQpoZro2oLa2MQccZsRl56wwNyXjbEStgSLFqDDG5qUF1FJgdos",
-	"sv+IsiTPhqDM5l990Yf0G8yy7HIl41zjAb/z7VZMM3Ux7qJ5Mcn1IY+FFNnakofiiaPU7Ts36C2VJstd",
-	"f5bdQ46Vkewo2W6sohGVvRZ+iR0DXhPjwjIOQruDV3bnCFirBDb89O6tkwfWUrG26XbuQ5ZakoViRnF2",
-	"AZEd6bOxY17zCFQ5avOvA/39PtF74TASoPyNTYnqGCTf3w7nHh+WPaT0Snl+zljFxfIE3cNBmMZRu2L0",
-	"XIp6wCBaSSs7cVoSaEQqurW7HETQHa7nC8Z0lsuyZHlSR+0Ed9nmpKIcr02c9dX7Ve6Ya8kE01wPsPOz",
-	"s4/LldVQ7GfLiSMrC8YboEufvvsr6gEfyA6wZMLC/ebVPqh7A7e9Npw1dp8Np+Vu9pPrA2mmMYd4BvMO",
-	"77JtZ+H90eccdxmmqV7d/dYmgM6+fPZ8EPAvnz0fgH3qEvW+//aFHeE+loIJsgfuqE/r7VlR96KMfSbx",
-	"A2V4y4cCd01NSx8FCxd1wZSrNtYCB8xJUA+KMaK5ON8bxbA3a8g713Y4/ODs7KMShT3Ily7QEL3J2i/+",
-	"eLaXFF5wmCga6PMV5QPeu5qx9IT2g53xvVSGo3sTY/fs6mgUzc+TttQP9osO7o4YkxA5PurRIW/wsPKj",
-	"7fPBz5Z6tuZrpg1dV8m9M9ruHLI1YJF2+0IXS/s1y6UotMWgnBFWSb3al3tBp6faCJjM56lvMZlcKszr",
-	"DGK4kZ24+LFbsjMDQBvGTElphgC1cLZSN0hpCK3NynJjH3HBoCRBdyUYJwgqeJTmf0a+twKTz4hNy3I7",
-	"Jdw8wnGU84GlZM3UecmIUYy5cgYloxesqQAGoz3S5MOGFxrqe5Vsw3O5VLRa8ZxIVTCFpeFsczALYCc3",
-	"39MZcfHPLmLkw0bA8kKxmHiduEwf5xMe5+IVT1Ea7f4MhZk0Ky+giMGlRCB0kwVCW0G+XQaoNhhdWfDF",
-	"ggH1gOWAVQH6NR8imKCWGQRlhGHdmu6eBvQwLNMr+vzLr4YQ7fmXX6Vw7f23L55/+ZUV6qkgtN7wklO1",
-	"jZvZVlMyr3lpHHuk5ILlRqrYeMKFNowWPdxCw5qbBcSyRS1y57AXusQV595/++LLZ8//7/mXXzlLXDSL",
-	"jxd3oYhMXHAlhf3kbZ8BQ9yUYTa24droB3JOQ+KJ2QgnnSTO6ctnz+/gnOwsh57T3W/qRmRgT0mJSnYf",
-	"c9jDjXiJjYiL+2k/93f4whrtl56alqxYMjVtpBvLrJqUN1b5lyrSkBYMIxStsMGFUbKoc4aJVt63iHEE",
-	"Fu+BFGr8RN5eQEB9HcUGTm9pD4IgIW/AQvIUFXYh2ysEwsUumMKQsmagx8hxI7i0oQrc5MBrzi2VFU/S",
-	"8lJdLRUt2DgnGJAAfsIeIW+IH+FCHjbAz7Z9VwFv6YgtzSut4ERxRAwqszWCTIqR7yC9g/r9u6EA3tdY",
-	"m1CxEiMtoXwX1prrae8LxjIrXScx3mrVkFzOVe1p1eJmzDJwJJ9AIKFGsJeEQww+xoCmLZwAU5bTMq9L",
-	"VDV3yOWXOS3hpbBB7JItjLS4F9cabZ6KuJ1rDgEKWPcK51NWMIh6QNayC6a2rgVa5nyZKXtvVMdzrK9/",
-	"ZCW7YGUScEYVCGTfykuypmIbzsJO0YAxjQIzA+SoWYAHEZ72T85oGIGP98wh5G4g7VEMbG4Rn3PFFJcF",
-	"zwkX/2Duosf6GGAM1quTwnBRQ/lLxRq4UX4iECHejQLvY4BKerRbuKhhFrAmiEiwy9ZpF5H21Suddc4Q",
-	"bB/L7kTGsWeqmOZFnYZsoWjehuwwZHSX9x017ESFo9U3hJcd4hUu+a5L18XlDtp0Tqu/S4N0qkWXxxAr",
-	"GgIOiaPhiVgFlwDNtxwwzEgjgWlHqYPC2M4XMf2MxDZ7xrYtWuNjWjifWePwWTLvr6gH59siOW5wzisl",
-	"mPcB+rusHqkdHMiZFwDQl9zkqyzlnukAwBYWhnddu0h/SpQu4BayxYLlZgwMEDSGZRsHocDPFopXjBaQ",
-	"sKAJ+sNwvy4oj3+QxA6tI5FHaA7aWSPxwChPDqi/EDBkH/L/LEfivsv3AJ4yI66Bl3Hc2Se3zLVxyPMm",
-	"JF2gZMs07EoIbojuCCTGSbsA+EkLVtLtrimhQXvSIPN65wfkOZA/xjIUDKYYjIH3U7t7tmty26S74HA9",
-	"+7cirgzWO0mZcIL0qVxDBJ9Lipnw6U2+cVpkpmtAY1+wP5QCbgr63vGj881kZUmH1frYp942wBe/D/BH",
-	"dyPu+fUNDrCR6HElv6QRJUqYnESZInyPIvIxzMWufyz2dF42PQbdfah5+lQT4EHLGflRMe3SYUVvwL9i",
-	"6o5fCVTUiwqGtiunPgQ8GDj3dwxMeymf/firy/5MYUvmWyAcgYp0Yx3evLIKlnuoI0Ym4592Bxm3H/8Y",
-	"TosDQiqy35iShFvlaMGU4k1mkpyW5ZisJA8ZPfsBIb5WY+oQ/3pBy4Hg83esQrS1J/fhry/eOk++oRD0",
-	"PB39fXb2kRpL6KAfGUzA92k6GciWc3b2cQ5sHHPh4J1KukQkAz8sd+S2u/3c6301P+KhxNLRhvr4pD5A",
-	"3/mgWFJR7rxTm/j7/s66RAzDZGiXkac54O4iXKaDQbr+LdWr1zQ3Um37Wa3hbTidbuzs7KM970O2+NlX",
-	"aRnEgpCe5EOU06xtZA1Oy+Aw7IV0uejlNiOQ3AxMyP/3/Muv/J/Pv/wqTmQWvk+mk55xqjmLb+fwqofC",
-	"bXJPVvNKLcAmgk3BAt3Kx2YJ0bc+66J7ZHZFps8ZpoZVbL4leiUv4bEKbJRNZezOCc2zKm3hAgnxxyZr",
-	"h4+b8FMTlwf/7q3RAPMzzZdpuJ8BFXgftkwuyN8E+8DXLPz2HvKt/G2x0My8efX4x++m5Btq8tWU4G9P",
-	"SA1lkJ0vLPnxu+f3tMwBtwp4s/iObYEqCHaZQTFuYi4lmigIq1ZszRQtG9y5rxUMHtTzsQcFZwPn9Nwd",
-	"VHxAa6qtOASZZbr9f2YK4q+e3Mvih1beX/eDuFlJ2hpVk0i4c6/gM2aoJr5+c5/KDBbdKOZZCP5NFXOf",
-	"TlzRjOG69QlvAK6zNV8qsBykRx0u9hFpeglFbShG1/vEDJu0Omy1tfAOxA14kWLlZk6yYPRxfmfZexew",
-	"5lsQr71L9HzbFm2pIN6B2krcGDC3T8geKkVwdvYR7OZ+RI7mDK3BSwTka/SzgGvsbkA69/JYLyuaDq31",
-	"9y0EAIIBCRfXAuqw9HowWeo03oiCbZhqfHi+b3AtUYkrW2Flr6x5NE7Ltigs3S0Nw9xWdgptWLHjCWpx",
-	"oCiHbu4ltQOPGb+82vgiA9ufyC4ZX67SG/vjlYa+kGbEoV3c/aGliDgkR9JJ+hA+BfIQJ+3ZRyKq6g9F",
-	"IKpqWNLtWAYWmMI0BdY17QLDJKWqkgTle/AaeWGZG9CTATVr0ShhO8tDRfoauDObAZdjs0LkfShJehRj",
-	"WcGqAXBNceA1/o/0VenV5k0IPZqvqxLj1Rxb7mUDPij1XhMTf/spFm46Tv3WI87ZlYOobj7Q/Kqw7E/S",
-	"uzu8/G/ipVxXJRs2nVVUoPFswYV7SrpcUUNoUYDjNC2Jd0uSeV6rxlmzG0D+My15AUYTDXndhZQVJHKv",
-	"DBf2P5DSTtYG/8+osv/BOIj2/xCrIiuJHWoC5wLpgP1APvnMZDrBzhOP2UkbSjKWorcp7QS//jwhbhTc",
-	"uwRjBcRQN/V1Tmhu0M/RxZcJZi6lOk8wtbmGJ85WKIhPdp2mplSZuqJooKTBU9oVtQh5sgNoDjJda/Si",
-	"b/lJ76WVbFNZXDscwEKtL0ZCGDZPigumnDuOdFn20fEGC3f0UtgSB94ha0qR6ndMy1rlLCnXRB+DZKO5",
-	"WJZQOA8+uYIRaOdD/x18dG4qL7nLfqhI42sXgZt3UwIkl6ogmEiaaQi095jmnnbF0ooh4Ong0oZZCv7S",
-	"Xg6vTvjcZoeLPvsKpvakIF64FfwXpMzC3PwuR5NitOgBfyYOBT+uHjaYuaWtwCFIcU6NWwNpjm/be8rA",
-	"Nq9bn6aTVSM17BTCGo3dZxHb16WR4ZOX4Yr5gUfFXvQfKxI0p9F3d1i3NSCZip+MogCZfshlrraVkSfQ",
-	"BpqcaKPq3GiMumzm7F1SS3UwYmfv8nrytRWLpebob2pkptgFo0NuVGDKY/+s4QUTXCltYxIGSFG5sRJM",
-	"d49x7PTWAiBx/AcmPcGosnLra2lQu+drWn3EWX4hGXmHEIdKixCGttbL6vBwJRwqBbqmpckGzWdOVSbv",
-	"aWlimRrMu+g03zJjp+vaoDKeHD2/D+uJhenqKGgXzIpdlovLK1guBmkHzBukJlRH2lfqwtnSx6ODt77b",
-	"Se50He/Cje1ThWh941YRb0pEGtKvjf6rv07Nyx0VBYnm1wTuRiJADq4uE0Ztr5KFmC8zXcoDlveeL9/b",
-	"Dnu21Dfr7WkpL5nK7Lw7jrj0rqCYLwNbtipNhVKvOB56srOC2MXoq20EDnzQTrgu+/eiGbsTNEDLXIqs",
-	"NfvdUh2klxlgVxayFO7ZPbpu717lDU2HUi0gElsulunCEJbQn7PtwzCLJsJse+cJLrjDdmnQun8IDueR",
-	"E+Clc/JFJ862oLOnyOSn6QTVLldNd8e9Mu171cR/rHmuJAVn+aYiFeupc87yAQF8YTd2BQCk/SxQN8DO",
-	"H7YVC5Go/Uq8a1p54wMYpaxGOLtN+zt5F2Jw++F5uRSGcqi3m9R0MQKVlRUQqsZNZPag0PfniDN3YgF2",
-	"70++BgSKfLjioGX7//6WGcXuwZXgnG2zki+Y4QMOw+XCu0T4ZrMbkymGchy3fN/ADFdiIHyTFtqqm/Bl",
-	"CV/i7NME6SgkOdP+L00KZphaW1RcyUuyrvMVyO50GZRv8F2CcOrORK3RfUbJdvZwl99HVzTHgTBtX0nV",
-	"kiniMukFm4X3hVpTDvekidbs5teCQB6a8kvbl7b5e0zlF9Eu8CKMcjgnskN7MM7Z9gSd5OD3KxCS4VTQ",
-	"A4BBXuhbBOla6aXjlOd78PW85V+ItcBbLrYB/Bv0M7TwORPCgX6G/WTuY5cH64DrUGvWX+f4FBTx3iZU",
-	"3GZtY51k+5s74Nu6z6V1oKKr83wEOo4u5gAf+fXZr/h6CUbczz6D4T/7bOpcd3993v5sse2zz9JBJ8mb",
-	"c3MutKFQoB3DTZfEjig9SN85B5m8xnRYaOO1DE0KeNMuy06eD1EQSBYI4gmFtAeslBVLtoZa5jEHhQTu",
-	"ii3rkmJ+Cy4EU61OYzL1ovpvNsKZuuDPDxuRahuLk9A62o4zkfKMD8Zg0964kSlYOtXm0XabQ0biq47Y",
-	"5DRuRsTsqNcZ8TWmZA0j+rRK1xnzgxsDRq3NKqNFkU6LoJcCzHLeGMd9lj8QgPGE29gUoj7sR8gp4dwj",
-	"Qg4X9s+alpFrBDL1Oc3PmSgg4txSOSyzLwkTulbOJGhhhfEsKG4YGTNz3TS5whMDPOcN1ws/O/uocrT+",
-	"uohjl8wRMkdjVytmFPZw5O6aq7a9VTGHkslbyZbauVxDn1QLYvn2qV6Axmo97I7UKSYVR/5DxQTff2D4",
-	"prxp8yKTriXQFIXocGYsj/f4zasnBEopDhW1ixSt/cuOK6yOg8j50HVh6daOOASKBWND6RY6WVrIgg2Y",
-	"gndW97RjgVaIZT6hVTdEdi+UI7Mifks1FPF0zZv0cQ8xFWILSPLmVVLOaBXPObhi5HSyVLJO+2AtFTwN",
-	"dYOjrBIAAhYq8BhncfL8y69IwZdMmxn5OyTHd5FzvbLp7dMkvCnHTlsfALAQaIZikEsWE825cgfay4jF",
-	"XdIYGOYeXM295/gV2VoIJxms8benFtp0AkJOZjapFHFvegIQqVy6Hig0EhGvVozzTSSG48IoipQ8kxDb",
-	"0YcPYz4ahyPlCbxifRQaQeLP2VaxqwpC30Fn9I7dScZKIGNQ1/dqVKxkdCBMvNwk7uLnz7PmOs7IW9ub",
-	"MLGQyqro6xqeDdkGsvS717tY5IVc9pi4yUrgmMZe/MaUBAuEINK5jHQvbNhsSKlDc1AOtEsZZWEIVXaC",
-	"lfPxexCNpgjkE1Rw+/eW1MJwlKXsNv4c7WJluZgF+u8rXiawoJL2u47hmBIhiQS3u7glJgZsSjAgzC6x",
-	"WguR7pZmxKXLirTfgMUEcGh5G7kEN+aNfEXFko0v/9jHyVEXvF8AOXHN09Up7QKWuIDljcB5vy6wQg7k",
-	"ArIfQKZRDMslBFPcHafTpds1E1flQj9ib3R0yBm/YGq3OqEG1Anfe7cSodg522ZGpsdm+EqFYn7Q28Do",
-	"itQ2WuN0QIkKCVXQrTEWhPEGWXljUcPrcPQO6o2uTj8MnprnbNu4zkTI6lTEK6hsyBbTJvUPfM0aJQel",
-	"wpQ8xUexRNRV00oyZhVGkv1ox3LCMLuxQg9ghYsk2IkTox+NI7SNXo17mYKvcAsinyZIvLgjfHpbsXYW",
-	"F3D5DVa/VkZDMEDMyKuQZhU8fDGxWpN7FY1jXT9gzFUZSiZx5Y1oVHnjN7gKgxsp3JoEIXANUDaybfpS",
-	"kmtC8wU0GLIq+WabBVNNu5Rlx7dcqN+ahn2jkm9WVeCmMGAec620qeClaeCkXavVHNLiJ+XyxiG6otuJ",
-	"Fxcn04lduP3HLsz+u1C/YVhIObGYVS0m08lq3neKTt9zhzoZTJZIezZpa8oteTNc2AYD91hdd5b2d8mc",
-	"XExNYL6HmkRjoz2WMGt+eEnL8sNGON/DftaJfMhjl5bOZZdpTWqB1o1fPTH/dUp+XUjF+FJktKraf1t0",
-	"sr/Y2/HrXG4y5V1B9a8uPjk4HUOAoRWBXTQRir+ZK+5k4AXQtmnIP3zqdAnNZdMc36DCYKPlqth9OiFs",
-	"7PQWpxUm63jrvMQD77MM0oUceOOio7vxgxkuyAecdGSyR5p0y9NiPsV+gdodHuR7eV9vvdGtp2o5uG6w",
-	"K/YFfJ4TqpY1pka+g/XtWcGAzkgrXri6Fz6gpScMI8GtFSuIVC7NOF+4HPJDBTP3FxvH3aucNM7zRuhu",
-	"kjkOEIepVStZ5WraSZHlIRomSoJ0hlEkZ5MZeYOpV8EjHFiX4oalqmG31g81hi5ZWcKzD2J0Fk43Couc",
-	"BZ/yUHIZMFsxcHNJBCo+tErkbWI1cFjzDb5dQGYkb1JzimvA8ITGRB7bPQdNODj1Qe52sI49GU2gur71",
-	"XXwfVRoeVqLrAbQb4kYodLcx7R7Q7GW/rj0UlhTS/IGQbVSR+LOzj6wC6tAukRoHsFVVqBtfMrvv/6wh",
-	"btwiGQw78B4Q8e8BBFlQz81097iSPK1Nal1MSnzwusfqgrZ2NU4Ar3yNIGBxLpOi3O4KL0jcmbbsMkSl",
-	"QzUP3QQ9arfKqF7quCV242/sCvsBODe0vitU9792Sf/OAC2qsa9vK7ITZHR7q3cGStBKXXjCXMpLpk2L",
-	"jPnoiU46ypbEgvFtfL1mBaeGlVuyoLyckafdBxQhw3iY4qUJjauYsheejUmy3JFMunu0T7WIXAN2qhZY",
-	"p7S0J4iEVrHMSzOe9IoCS5jWTcTrmXiBMf1olAlD2Zvd7Ierfecq6cwSnUI1Yd3r1p3ywCrNuPgd6s2u",
-	"2LkN7cl8ANM1pL2rFa7fe8avB+rnxmfs35dd4dxrlr/GGXds7I5QzwUtWgks/Oa2gj9DFU3cbVdIGJCF",
-	"Xg7U7t15moudp7lj/FZa6UtvBcFCVWk646wmmMD70u849khlBtidKwcvfn/qMZc/eNyMQg1vCboucvhZ",
-	"d6DHsP8Jpeha/QI9T6yOrEO2Vhx3RhwJSZd806xceGrm6XFIWxRhmmWxyKDXtLqCA+w1iEcE8bCjDht0",
-	"02mStTsJI1H0DkdoHIKs0Oyf8Iczwoxdux89fYTwtZujm8alUBt2qNgaEsw3Gn/idFzV8yDgNlXo0fcJ",
-	"XJXiNB1xLHq82YS8sSPT8pJutX+QaDBreDi/q1gzNWEMjytQ4CtKem9UjrEVLOcVZ8IER7X4XCySD5vx",
-	"0wO75wBLdTA1Pr8INiQXrUJJXtLLOc3PO0/M/oXZlUinEYeeum2mZVsUwoG9zc22eenH9isKRxoxtP0J",
-	"3kJqj4j6hS3dQ/Qaf4ydBC/KGHggqQsdkdyF+YZJ3Wqe7WKGqzktMGtpyOekW+wQhdANuuAoedFE3AjY",
-	"Y5nGlNU8O2fbrOBlPZj8ZDU/d3N/x7avXEs80jU1+SoCqrmUPp1/1OUK9GM1z0ZFyrXzvrrkkENVL1dz",
-	"7dbznrGihZv4DGd7BomzK90/0gTM+vh+c08uZ6s5VqvgQyu84G6JP0vD3ryKT8suateJYY97TnceXYc+",
-	"kkZ40Zx0a1P23H/nA7T78uOz0aE3H3vhtcdphu+8kKKdQmbA+UDYRvY4v6fqvHXrHbN2A9grr0hn1JaO",
-	"EaWR0qzE+kqdLDZDYaWale7JPspZDJFS4QHdhckV5B0VhVyT1z4Z9OOf371+QhTTdWk8k/Hl2izzcZDc",
-	"b+HZwYVXauFW/j4KMQ3L58J5Diy5Nirx8nb3hRHsLdjnpWsbLbRpXHXRMQtr2PRSCnEnBaXFUJhwLx+x",
-	"rZCTNIKphvzDYAOEUmNzIFFy0QdB75h6jyefbVPiUsGd77orHXdhYLnuxrRmqTr356Eh0B5Tgncj2k09",
-	"nYfCoeTTdUP66Wa6mn6I6mETWxhVN7Pn6UtndwT/a2lZ0RQY3Gy1D/Cbbilb7TgMx4fh6c2HU0TPunvj",
-	"NNrjpWM1vJ4Fk2hmpn1HbpwQpH/HWxrNCPrjEwwty0j5WdSi0J0tDBk2dvkZ7dR9nOrj2+x0WRpSCsZq",
-	"Aq1ME21IQMBzkZpNkhGtZc4bZzMt1y4ut5fsLPI4i6y6VjR3+ea6aT6WPHcJzQ/1jHrr+36aTtZ1afgV",
-	"x/ne90VXrTQ75EvHCkVBVUFY8fzLL5/95f7S4H8aecJvow3uu7G6ZbnnEmp43tZjw+pGEDF/lLOl7JOs",
-	"QdcHtWweUYOrQ6oa1XiPBQBkOF+MfzFyjpDzbasShrRqe2l48xNk511RvWpIJ74gesMEFZQ4etX1boeg",
-	"3Mjt4q6dsZc8z/zVyK7lhhhfkpsfUQ8TpObyPYQ7F5NdxLOxpPb7iEK1V4iPOBb5fNoD2OCqZFZQbAjq",
-	"YCI7fx4oP/iJ3vNl7x7G46W3GhqAl6G0Yg0WCbKSaSO+gbWxgeoKITW9TXkfw5VK07xSTFuI0i6rK5XM",
-	"9bWrtEKTRj3xynjQgb7v7GknNxjs26C4XJ3fUwq5XTjwMPIopb2Xd8vfQ9mQyJjQ+JAOspsGclgUjwp+",
-	"7EL9weINbWV8fE6xxuTXchge8unWlffq/hAlb4hzZJI3iP5NKAAIxQIzxrkM0OgS40qktvfr+klxPkFY",
-	"3UJifiFhaG6aYoyTF26kyXRSq3JyOlkZU+nTk5PLy8uZn2aWy/XJEuKMMyPrfHXiB4Ks5q3kpa4LKVlh",
-	"l00FLbeG55q8+PENSNzclAyiDOHoolopp5Pns6eYCZwJWvHJ6eTz2dPZM7wiK8CLE6xgMzn9/dN0cnLx",
-	"/CT2e12mogXfM6ryFaKxazuDrNYMdeM3RWj0WqoXfjj3ag6eM5PTj70cra46iaXR9m8o9uhL8p3GjwiN",
-	"U0qfHu5PYYNGLo1hMqZWmBRIMZJ7FSDyuAKnKsIumCAcMbHka6wnhi5oNF85mS8BM7Q9EOCmWjRdsgje",
-	"GflJs6a6t5HnEPWLyooP+6sUu+Cy1qHTAGB2iBRcDY1LZJOGXXOKEkRNUOEfbpcQ5w5v7iIK75m1yp27",
-	"h76CLWhdGmfNzrekFiWW04icTnRYGlTCRzeenLodcAH2PrZID5+AnyRzEGYWwgNP5A3GPoFmDdKDi4YC",
-	"G6lTvB2OT0Mhgdh/boreL3IL2Wc1s+1Cav7O++TU+b/ZYfFz5NkEnlnoXTe0YBeoldGyTC0zclXoLvOv",
-	"G7fMBvtxtbrOV+Cp2QW0Cxkml3e5oEIUrtubqesfec/57AjBay60FK0NHNHHbgfbVKUs2OR0QUvN0tvD",
-	"cJGtrQkSoQ9Kwb1zDoKdvBCuTInOIi+5SSunhW0hpEin7u8lBTZbIN2W6UwOvXVwbR7ulbNTXOu++ZCK",
-	"yEPLyCa5CxQGsJfQ5U9Mco2QnWaY2u0NlNj9eQh8z2f8M6V3enAB1jPyWirvQ+jS41MN1MLbvV2VY+dk",
-	"WnBN5yWWZwCjVsuxD/gDyEFtx9zYlW/BS7hDcIrI+zBXU3CGEIUlTBkXDWMnr6GXK5cWkZfWMDtGgA0I",
-	"ZBE9QeCChxl+kCJzndZU0KWF0aKu5bBx4Cn6L+CugqE0Rt5dKOlLyB2Che1qSEPo1XXrPGSGv2NoKXpr",
-	"RHWsp35ToQp22MbgfBw9VKAHWbuUTVPnNAWxS6tqh97NH36ZTnw1QyCOz58+9eKue1uIFn/yD42KazNg",
-	"L/wsyJSHxLwnox5wqbuTE1ETFbMJSINi3rqqzbBn4MZkIFz1R/5JO75W0SUXzp8WEHFNz1EHwewHzi/f",
-	"E1Sfi8pKbOEp1sl47pKPMJw3YnR7A35JqidtyB+DW+sTu8AvrnWOg2Ush8tJdtbhG44B+51DQAyQwjKY",
-	"n6aTL//oS7BITZca6iaDmjT55VNH+Tr53QfG8OLToCb2VsrzugoPQlYntfzJvdS3FTJs6+7VN1ugaTsV",
-	"svDM5Nkk0BMol9YQwADkJN4jIGOHqBdjmeYNEvijWH8U6+9GrL8VVnoAA71FhplmUkceNfni6RdHNvtw",
-	"2GwJzG8Pmz3pUYB9fFdE3pldOiorJLfl1hv8fYQr+m7u4M4vqgoSToERXT8kPn37WtGfhC0f7dJXskvf",
-	"MCvt3PcD1NNmluamHpXVKNy1s7FHieAoEfwRJYKQJeBe5ACvmjwc/n8rj7RHnn/k+XfG88ONHsfo40r4",
-	"R/7u+XswohyZ+pGp/9GYeqIAxWEs3lsr08bMa7H8lzj0ixi0o/5/lAWOssDt6P8tAnCo6n8UCBL5rY5i",
-	"wVEs+GOLBYfr/EEg6LyF3ogocDQCHBn/kfHfuxHgyOyP2v+Rzf/x2XwcSDfWsa6dZO1Dq1auYo5ss4II",
-	"BtlojSSytMxoD4ePB9rH4I9842YCmaICnnaWBd846uyzjOWyU+hdSMOw3ssgFJBzBgY7OM4AswcMhRmE",
-	"r78nJ/alSeJJb7i0SmoL+RJiM308wT/sznlsrJv8KMF30xfkCbG8UCxH8yXJQpoK+8saf4Jo5fd8aX8q",
-	"8SdIuoBR4ql90Hw5vBEauq3xHzveqEU6ChAtpJ1vYr51Enz6XNLi74N0gPVTUgPRIgsM5IunXnOR7Zw+",
-	"NLgREOZsIV3kUgQD3eyBwTc4NNDjVrUZv7JoTUtuqbDhazYj3zuiQwV59/ol+fzzz/9C8PJb7QbRZWjB",
-	"OCQWL4uBC8SjoCZ8HkOK3r1+CQC8D36to1rtPdSAUTe1chjx4S38Txwj+6cMVLzPAAlctTNDOM0Sqznu",
-	"FlVCzcc7DP76k2jJ00lXtbh++eaOttTeyc6Ex0CwfynldczjdJyJo/0CM5SM44B35dt/68XQYtQfWgWH",
-	"wqVDiSFEFzdZBpMEHZtdTfA+mp2P5oPje/Of8b35XzqcONqnk9/bxHp/WHFUbXbIkNk0SYcUp0TiLsvY",
-	"Kxb/6V4Nb43sHEhs7i5y9JpPScd3mD+IKNsjQidzuRkkRP8F4p/V/luyKFzDudwQe698uhTdScQbGkBr",
-	"Z3P4xv3WFJl2Rv6ldPU1c0tJqFqCMYo8gsG4WJ7CAI8waw8HalI7OQQbcmFOnz3//AvXRNFLMt8apqcO",
-	"HoCOfPUFQGO7Ppp/9cUj/wRBIcG+/en0xddfuzEqxYWh85I5C0NvTm3U6YqVpXQdnHzMeg3th9P//p//",
-	"nc1mj8aQcrmx1PyFKH6ga3b3RP1Fc3ZcwNFkN3oi7XZ3telJART3d7xh6LqcYU+54dR1t3cmyixyfLs/",
-	"8oyb4xm6Xq+p2lpazwxc+wjVnMscGgE60uiVmQ3Th7KbhsO4UuCOhUBuWtqWArVUVsIs2YbncqloteKW",
-	"o2xno2wy3wB4d05vj8aBm/EtCMpIN9dOk7wOLgGC+SswMP2r3WRvyLfIhT/P8Bm8Kpn7wReez+V6TTPN",
-	"LI442XpMihw3w+4UOTjT/ae4aSN3ugZ0xQsozx/fPi4KtkmbMsLNH1t5/5WbUibLbD+kZ4cB7cxeEiyk",
-	"1KmEHVNYqDResguarmCzr4w17uoYBvBNTDbbJPYoURwlituUKBDtRsgSB1nPTkq51AeY0IhtP0L5eiuX",
-	"+n5saUcx4GbEgHt2HfuT+nFBda/gEBG76DpeCll2sWTb7ndEbBWVN72dzNEPX2a61belUi4zzzEOz7m0",
-	"fGW7PmjBbJ/sdA2T9y5j6+7otdhjAFruUkxHRZ4dH9CPzPEAbtXy+cBs8Hfo7bF/djv6Hmvtjc5XC26G",
-	"5rPfJncfmnmMtTvG2h1V07v00oBDPvndX8/9nhlwzceke7cNx2uTDXk4+mTcsk8GkLmxtPAOM3jDlEdy",
-	"czTmPWyXki7FPJnTkoqc7bXIoeitDZiho9o6QFBc8QEgMDspqp/sqBsddaNjkcVjANnYALIbE7puVhqJ",
-	"iecoLe17LvgxNWqK680b1nBU2f5MAsgheUVazxNgi3X0aVdyEUwpYlkqphnZqfMdU4scU4scU4scU4sc",
-	"U4vc45P0MQnIMQnIUYf7104CMsbtxL1kWkClYOg33mqMMsCgKHLbnii9Rb2U6zkXrNGC/AqaquRG2oOC",
-	"RitqAh/2DY0kOrga7FlXpmQ5wF/BEwc045zxC/jvQjH2G8sMVVbCHsNvW6vxAEIx0mj+uBrpQWuzkjFa",
-	"3YhPvuLLl6s1JP41ITswocSvZGqF5a2sySVclpKfQ39XydRu+hqLgreLwbsa4UM76rpnAM/eNC/Tu3gF",
-	"OmasOWasOWas+ROYROalzM+zFaMFmBn2O6BBB+I6zMg38Z9t0we3rD9nAh5OMKZBqoKphLlESOOJTFCz",
-	"ZW2q2uzwdIOpv3WQH60ldxosddQRjzrin0xHfOHfnddUnaNgaAm91Ex5khXTxkcgABqe8wofc+uqgIdc",
-	"8qEtHNI8Z5XdSAwSJCFIsHnx9sHqY8MGPVw6HTh4oBayO4hwzD6xTWV52UPbJgfWA9kkOtdMmIe2RwjV",
-	"HWzRDb+N2u07IHLUNj8+h4bnUNy96THBz7+w/yoe8snvcLYZCsZ7fVih09AbJt6iPZI4XhmcLp19Nwbo",
-	"muYM1A6IFOWWLEq6nJG/2yuEAdUaTTVom5k2eguS3kIyFO7d+1/X+qcHpBck2Zmd8naNHyPo2fF6/nEV",
-	"81GeCZFePrbaSdchwRvq0wZjrsEQ3zXXB+H/sDoqQXU/OjocHR2Ojg4P29EhpiDzLVkqWVfkzSundABa",
-	"BNTB08pcEj/0aQY1/pKqQk99kr98RRXN8bUGcjApRn569zarhaYLRh7zGZuRr6fkZEr+/UkY3LZwIw/s",
-	"AsCW7XSxuCYOHn1BjmVmjmVmjtbDo4fJ0cPk6GFy9DD5V/cwuU+vkOmt1zQ5+p0c/U6O5q17tT7HR3vy",
-	"u9WJ9udPIFbDLlsccsgUHWPdmCQKTim7u5Ted0hCou066LKOv5zHVANH8vJQrOefphPN1IW/67UqJ6eT",
-	"lTGVPj05YRu6rko2y+X6BJ6cXf/fg9wv12tgVOEXN3L0iyNltvsmk4pb3ltm+pIul0xldmaE+fns6eTT",
-	"/x8AAP//0ys/oQOtAQA=",
+	"H4sIAAAAAAAC/+z9fZPbNrI3DH8VlJ5T5SRHnHEcJ3XWT6VOOXa88b15K9vZPWfjXLchEpKwQxFcAJoZ",
+	"JZe/+13dDYAgCUrUzHg8jvmXPSLe0Wg0+uXXf8xytalVJSprZo/+mNVc842wQuNffGFEZeF/hTC5lrWV",
+	"qpo9mj3Oc7WtrGEbrs9EwbhhVJTJitm1YItS5WdsLXgh9D3Daq6tzGXNoT7b1gW3wpywV2tpWOiR8TwX",
+	"tTWMs1xtNpwZAd+sKFgpjWVqyXhRaGGMMCez+Uxc1qUqxOzRkpdGzGcSRvbvrdC72XxW8Y2YPfITmM9M",
+	"vhYbDjORVmxwcnZXQxFjtaxWs/nsMuPlSmleFdlS6Q23MFHqcPZ27otzrfkO/jZ2V8IPUBb+5rQmmSz6",
+	"6+W+sdAXjrXmdh0Ntak/n2nx763Uopg9snor4uG3R/0WOnZj7PX6U1XumKzyclsIZjWvDM/hk2EX0q6Z",
+	"hdV3lWHfVCVgje26VZgtpSgLc+IH3V1g1/nwEA8u7IHProdMq1L05/hEbRayEn5GIkyoISurWCGWWGjN",
+	"LYPRRbQEn43gOl+zpdInjNd1KXMk1Mxv24bbfC0Mte9JHwkBG2pqsJyXpZkzWVVCZ1rkQp4L3aofflRL",
+	"KtbeGV4VcGy0XQje6diNVy2jAnHdA1tECxjvk6i2m9mjX2dGVIXQSHU0ttl8ttRC/C4yy/VKwPlJLAt2",
+	"F89zNp+Fkc1+m6dIdWmFzqzcJHbyuSNULcy2hPVd4uatBVvJc1ExqHXCftgayxaC8Yq9ePaEffHFF39h",
+	"RDXAJ6irwYVoeo+XIRAdcCX/eQwNv3j2BPt/6SY4tlS8lilu8bj5zp4/HZpMu5HE+ZOVFSuhaeGNEWnW",
+	"9Bi+7OnGVzzUwdauM6C04Y0NJydX1VKutloUcPi2RhArMrWoClmt2JnYDW5h6ObdMZyFWCotRlIpFb5R",
+	"Mo37f690ulCXWcVTq/CYLdQlg29MVmyleJlxvcIZsnuiyhXs46NzXm7FvRP2TGkmK2vmbq+FKygr++jz",
+	"B188dEU0v2CLnRW9couvHj56/PXXrlitZWX5ohRuGXvFjdWP1qIslasQhIZuQfjw6H/+958nJyf3hjYD",
+	"/znuPoZl02IptKjyxNp9r9TZtu7fGszXgSPAcYGbaxqGAfKSiBbe/NnXvr2Q+xc932ootstWWnBk82te",
+	"9Rf/hTu2Zq22ZcHW/BzPKN/gPe/qMqhL647LeMJ+kLlWj8uVgmufplGIJd+WlvmO2bYqhTHYmuOZsEW1",
+	"VueyEAXIBOxiLfM1y7lbCSzHLmRZAqvYGlEMrUR6dgdYcqgE47rSeuCE7u5iNPM6sBLiEpl2f/rfXrqr",
+	"qSgk/MRLhs8DZrb5Gl81OKq1Kgui9vjUlirnJSu45cxYBbfZUmknVdNVN3f1m0cVy3EDC7bYdUtWRav1",
+	"w3XGvoH87JOPIC8D8rKcOTHBzOYz12UWfuB1bTKccWYstyIuU9dQolKVSEh9hx9ObnxZXiojMqsOCPle",
+	"DsYFi0TbeMWOE/mBrWLn8IGeO0jZFVyNZblj1m0AEEQQ4OdMLtlObdkFHp1SnmF9Nxug6Q2DzbftR65V",
+	"DK6QIeLuLUaCtBdKlYJXjrRrupdGPNFd2bv2RvdTuI1HOkhWclUBzSal4VGXMx3CqAgtqNTMNQ8fB59j",
+	"nSEcYF2h9KAAf8SQoY3EYOHnw8Md+RBYabXdu7at5+5ix7ACe/7UkRqeP7Zx8vOCG/HVwwzFGrg38NDD",
+	"M+6C68LM3XeWr7nmOR19OPBwen958X22rQxfCvaJPBEn7Os5O52z//w0NA4lXMsDkw+TOfa1QeOavT30",
+	"lU5fpqpy11+w7/Ajg49sWfLVCfvHWri7WBpiLsRN5kwLu9WVKNypLpQwrFKW5aqy3B34eOUHJhyP5wDn",
+	"cYqlDG6O4Tdf6W9UKg60iKytCM/BOStEKZC9NiSMvxqr1Q5+RwKdM1XDdaO2tn8tV4Vrlj53b2m8sgZJ",
+	"PJ7JgUmXciMT+tAf+KXcbDes2m4WpNrx70Or3NbgNaMFy/G2WLRkjpqvhGECno+SFHDYD5O0h1rwfD0s",
+	"D9GYDhzLDb/MtNpWxQjFi2VKxw9bU4tcLqUoWGhlaCxNN4fGI+xaFZkRpcit0kewtcWOPX7xJHvIqAnm",
+	"m5jT60Jq0yYArlfbjajsCP4yOKvOYN8VN9jI6rhNanRk0R75RgZnE3o5sEeVuEzQOkhL8AWpNiL1E/aL",
+	"E+Xxq1VnogoSP8mugtVanEu1NaHSwBix6/0vvkpZkdVaLOVlf5Av3XIYxhmVce8Nv/GOLzbSEDRHxDE4",
+	"pqjDd0UBqsrAHlMKmscxh+Kn6kmoyYjLD82k3UtKJVwpVc/mM1VbCQWQt6qtxf8KDieABMTZfEbcO63v",
+	"VVUpKzFwvR26zOjiC1rDi7UyoiOlAl/fYn16FNpyx6jP4ak3IzrA65UuRIIxvVQazl5BfJ5U+k4XuGN4",
+	"ruDZl+NjUJVwizmmpDTcafShEhfhAz1A/BO6ELWoCsMUkaWoilpJ4F4/hlNFrxNcnXNeyqIxfnSG9e8t",
+	"dGLXYscuhBaRkDCoYKVJp0iCmxx32+Tpva61qpVxhsODbxFf+q49RppZ3MZzRIszsUs+ebv8nrhXMObh",
+	"9lLd/Uwr9HCA2EdeO0vVvW72XjWjrhkslJHolNBQwVcnWKUNp636I1S1cd9k+squZUKlNjypDS1Fp6d3",
+	"Z74wcpVRiz3OJVevQBOylCU+lf4Fd6Hf2a2hd2K8t15vYuSq4narxaPX1WfwF8vYS8urgusCftnQTz9s",
+	"SytfyhX8VNJP36uVzF/K1dCi+LEmzZJYbUP/QHtpvmMvw3RTXdjL4R5qDgXPxE4L6IPnS/zncomExJf6",
+	"d2f5hNq2Xs7ms/ViaBT7nrzNquYt2/piBw/fgcXBJvfJQMhATK0qI5B0HZt94X6Dn3JVWefBEQkNp/8y",
+	"JF00bQPfE9pKaslbeB/9MfsPLZazR7P/32njJ3JK1cyp63AWlM12SHylU8yt42PxrXlBr6JNvbUkgadY",
+	"RDjTv84a63O7z2Zb1OJfIre0QO1hfCI2td19CgP2d9LNrZZp3RQj1617Q7zDdSSBPkMRot/yL8YpsGu+",
+	"khVOfM4uQETb8DM0SVXKroUOYoUT7YkHYqONHOLeB+6ePpmlTkxiT821N7XZtW/PxQ3t7gFr/evXv/K6",
+	"lsXl69e/dbSChbhMb8Q73WVxLo4ixs6apajy7hJO1wuivbJhMa5OR9+D/ugl6o9uhphaZpQrbVMzpImD",
+	"RITQWdibYyXfq9VHyUhKtcrAvHk1Gl09hap/ImZydQK6WeI5YhduVzK7qeW64cN2JR47cdbEqbg+UzVG",
+	"2G94yav8Rq7ThWtq9A7/ICuJg/iObEfTNvttDkt5E1vsVvdGDjK0d8QRnjY3dYaDX8+1t/amtnTURt6y",
+	"ZgG7vIlFermt63J3A0v1TsnV4ChHbQRN6MCNH1q8ypK9L14xMYkbZhJbu/5OGqv0TdA/+vuvqbnx+9oM",
+	"4dvK6t20xWGL4+W85kY7Me7m9vpoYa49gmmr34k89w0YZskT7UYkdmjuiC2G4tOmhk2l1buJLb3SXo7Y",
+	"qv09q8sbvBvehT5tzavVMSxIXb5f9pMMz3r9+lf4APP24ULBVbZxeG3cj3boyFNza4WG+v/nk/9+9Ovj",
+	"7J88+/1+9pf/PP3tj4dvP/2s9+ODt19//X/bP33x9utP//s/ZokogLtzpuYzY7ke3JBn6KyDH6lRLXKl",
+	"C1EwRxwn7An9x/tISnI43kgDNvQ5MwoHguEy3hkH9iEUN84jiBp0XnzSsg3fYfBLdVapi2rMi6WnsXT0",
+	"2zeEuEC2eOpj2MY36pJ5gYEO8M0zDnU51LOsiEidQu4bdSnuqiZ+AWM7hm88dV0qfbeV5IO+QeC1hp9w",
+	"LJ59SRPvGpOGaVGKc17ZqO3R9EyrOpZQgcYxQJ5X8bbBHL7VWukbIB1vD+mMZz7bCGP4SqR9VeM5+oJj",
+	"JuUHjCssYAro4vNMCDD/3chRWK20WHErDlHsMyGeSpjSYnsLloU7eAAS3mGwCdJYmZvgc4kl/V/gIT1v",
+	"+3rO5uMYBC6e3+e0K+HIOyxX50I3HtgmjHnuR5u8xsac1kA7/evGLdnxF04kLf61VAtn6P5zSY3RxEiU",
+	"+IhfOO9cGuNV0Q49aMllewUx1wazSp2wH5VFL/ULcluH0FJVCi8c0UVHQ7tJwe3II/Od4KVdP1mLd/DA",
+	"i9o+MIqfY+/tGzy6uZXnItNiJY3Vo2zhrZG8iCt+VPdXNPHxXGrv2rW51QGzQLv/I0n6Z+eRf1MSzzvd",
+	"dbhdDy9sPKODi0dNXnHR7vyCtaJGxpFle/WOJMWmvyNXFKdpHttXciPu+qIi79nzalu2xMPNRiIqURpw",
+	"y197csmkZWtuqnsQQCeqqOZO2EMDGYBTgtU0lm9quI/fNMXfMFkxI3JVFYYZWeWCiVrl63Q3e6Za8tRM",
+	"uxGuAxOGT/Ajky2gp2j59oxn1IyPnuxNuly9aiIR/qrVtr7rZD0MLfD69a8rXYPE/leHJtDVd57cusJz",
+	"7xLIKloCnBe74A4ZTW/EFemqkU0pnM4FufrAnqYfXhQEvgY/52suq/lRB66FJDCWcUfkdjTbjoAYWi/M",
+	"AIMWD+jqx+Cun4Bomket9oHVjZu9+uJ9EALaB/nwHVThnLAXe1Q4zdt3sc3PhI35QfPIxVBbXh6QZ489",
+	"4VeTzuIVuaLkG41hIsYjiPHWWfp1WPY/uLTPlMbVf/ebTHKkFeE8unC1BjwCrAIgbwxwbS/y9Zv+hu5p",
+	"LXCgzMbCId7NNDLXb/qKPlZKjAd01Lq/9cGoFG26kD8gLkoi3r9qQ7QARgUrBApibKnVBueWAmlBnEgv",
+	"r8Buap5bFrVuGCkTkB8G+kbdLBF2R2WkV0f4A/oZPdZJ3+G0sZ2qEEJnWvlit7o6tu8Qcj3YYShBwDcd",
+	"NBzExHDMh5Zc2hH+YbBcja04jKFPJvNZa8R9Egj73aIEv89MaY/yQaiSvZ0bAJ719QeXOx36ToPw8exI",
+	"fa6lZCM4pn4rT92U8PPcH/7H3zxn/8/Ln35kHnz1hL3wqKUNYePFrIVR5Xkjegd006KB99ZMFifsJ/dU",
+	"hRsgjhgP7Z30Ng9nkdypJpo7icvRMthyy7h7D9ML93X1unoqlrKS8P3R6wqY3emCG5mb060R2rnhnawU",
+	"e8RckxD79brqH8chnIUIWZ3V20Upc0CBTm0NQZMmWlCWlxEAV4RS6vapCRzvs2hqNQOGorY2c0jUmRaI",
+	"M9fvzQR8IWwZa+/tdc5c2/ija5+59tPXRg9yszeK/WiksmrDhcJG/qisQw/hF4wohG2NMOzNhte/ysr+",
+	"xrLX2/v3vxDscV03gaZvGmxTGOhoM+boqFWcLO5hJi6t5hlioqUJxWw3aOQvS4Zl27ipWq003zhMtS4i",
+	"656Vps7HmUKiaeGMXlKtt/PIBb2zVfg7W4uyj+N67MZEIS5X3pcDYTJ74NxfRbkI+IrLynjpF+4LoGoH",
+	"JgwoW2AsEsUJe75kKEXMu6kMYiHHMwBpCP83BmzLeQUNEhIQ0javdl0sDSOs9cLDCwC+eRWh4xyJsuLg",
+	"BPkB0b/YQnOx94ufBehZNspYBIxFZCpqMkGC6cFsZWUJFayFtNsbSIR7285GMYgcHIEx8rpmKzRHI+8I",
+	"tPgoEKOvM8wmfoYBmBtgEUkzeBuJ+NDssdQgYvLxs4P2rnXI9s7pysQVYA4Fd6yex4fhCjTmQDiTMG34",
+	"zlSaVcp26CgGXuuRd8CXQrBQUaH1VpRyJRepVC45b92YHmjZ6TJDC4ZMEYY5D3cHhK/Jgm8dshgvSZ2f",
+	"HA0YBbImVckez7ZITxtNG+qzCxRjEU5uDosD6GIyl7ASWoDPT+FwdqmMw6obiLSHAdHARXHF8fjqjf43",
+	"3ddGVplbusTbwssvYXW9hOmVT/FRerUO31EoX2l1YVDxXjDlgIV7wOZb8H5LD62F+jYSRKdlpcZGDslu",
+	"SWlNLbtCWU9+Sg6ZCmcw535PW+PA7ri2DSoftU6PMxz1CUOYMbdIkF3Bqhj3EPab6xb2YbXaNxwzJB77",
+	"zttzjw/dmht/8Ip5dE+MkljfoaPoPlwzGH8PqgxFiD7Mvsf/pGRXHs/Mg5h55DL4V2lWbcsSuI1zyJ7N",
+	"j8ImIwXmNrEZ5wrFFPocHqQ0xHsm2hoYx0/LJfKPjMmqgEMkHMg1VjJG5ZLA6RueDLwc/PLg8fYZA+qC",
+	"Bka3kCJb1yRK2EqV1DDYSn+OifKYQVZC4r3Cfdt4wUR/D7zvUUxHiZ3woGWVprjcn3J4J7SkIhwYprpA",
+	"OzY2w2Q1Z8DKznkpKhtsY6GR9FPrk9YryQnu5tOhJ1haPUgzQsnlqDlhjSvNJhb//aDTb5M9I4b0LJgz",
+	"JmG8gH2s6ywwMVWVO8I57b7TsQWYj8p5UHisBTz/KccDKlvwlKAzuOMfC1Eq9MzrUVizUQcGf92B3+Bo",
+	"9gv4KWo27JMgeTdktydTyMGuB+TrIbL7BGnoGgPoqh4DMKbT8BxUyrRFmf7F39yGjdHYceQ0Gxk6in2C",
+	"b1NRchcH1nePfu7nrvSTVNa1SjnN+MLpoaK3UOr2Y7JiuaqMqMwWEXitylXZV72SDlmqKmsJZBlo5Po+",
+	"8L5wpLdjn0iIwdh9Gr0OIrV9eE0FF5rb9cxAbRqI22qZntMLpcLFh4UZFm5N7dZHfa6syPDdlyHq836T",
+	"cUfSam0ko1xOcsAqiR0BanAhy22aFn8MXNBsF8ipZcUEB07Ibb6GD+0eocye3vD9MzCr7/mNTWoEOWvY",
+	"+nbDHwhdd/jpvkOcIKbUtvc3Z3Ad97A1lIyeitLy/mrHmS7poBVQ8GSf4aB3MArf9r7XYjSK4ZuHWkrO",
+	"pQ2/NjwLtESi3CJtBFVuejMaqwO6CCj5sQiKzmLUwjvX9cSzi/U9rpW0isV9vMb0+s2PnV4yA/O4SB7c",
+	"sGNUliQA9WgKz4pr7AA9EejqkA39wX9hXhjbNp+34xtZqVbXNX13xjNgAZcVmGbI804tl0YkBv4T/s5k",
+	"5W2cuM+JxNLEyYUzdMJXnKnLckTpb3jVT5I8D0kjoA4Nw7ffKwzHUJTLuevNirIEUuPaNj2awdrhi+Eb",
+	"mImyB7SsDjUTKay/ND8rg0ZU3yrtbD87E2yoOSbg/9vzvTbx8a6oNCI4fm5f0qMYTtsDz4WHKCW7ttrp",
+	"eVwuFjd/R7e3K0elkdojTyb2/OkR5Iq6X0Ua6S6BsIv9lDzaISNx7mJCCx4b4ZnTZCrC6Y5hQSPcOAIz",
+	"ip0n3qnbxjfPb8xpw9c97L3xnAidXDbwG736zbzJJ4LfQhJcpHfkkvTB+Z6H73Zbl8KlrGxKYdP094A3",
+	"h5/Ugf2LTOuJGGWlhXE6K5KxIv0EZdSsunqKjqgSMquNu879c5PqMbUN7Hm/OuTmxBaR0NfR3FMSjPeP",
+	"SqkrYhvWgGq7Jbg0r5NOr5BpNMlLQeQOpLvX2VLw8m9i93coi7sKtb2SYqyg1Wj6vaLQK62utTXXc5tI",
+	"CU+uxYOUTzDTQ2SPYQ9k3m45OR15AuA+TmX3WDUZcWIqWAjQq4pLkW9tYznrMP4gl93y9dcR6cZchwev",
+	"KVyfcXfNz0HCfpcbxmtwh+Zl5tyBkg8CLOEdhm5ZEEkfqFffPv7+Zzfity7XWhbUVemJYKFGTXVn56IF",
+	"H5QYQ2JmsGV4HXL3Vej8gZzc76tcYFbNjvYTLlpHRbQwjR9YK3UeHFW27ATyjfUQcn5qNMV9/mqNzQCr",
+	"dFzU+DmXpbf6+jEOBMDhlBpvwKNvi7iBa7u6Ra6J127rXGiT1K20188lgmP9O8svqhmFvdDmDemDdoCP",
+	"xRPYk35yQ5lhQz6/iBZAXQo9ENW7aBwyHCbk6u0GX1WZKWXKcaNtUGNYaugJud1kcHPvawS+mxFWm86w",
+	"osaTy2eSGoNmtRbKOfRvK/nvrWCyEJWFT7qB8WhOORxq7txMrqxfS/hYGYT5uEUNG3Z4jG7NZUa+1uRC",
+	"K1eY3oB+w+2am0/Yu+to2hojY19MdG/ffWq22M018TL0xjNPRcEGzquWo9MR/u9xjz2pZMB3PTp3lXSW",
+	"+CvsynCOeBxVpNdwmbPT/OGoZ1aciPtajyuTLbX6PRUJd9HvNuqQaqUbHf046pyTgUdSOD7Dy3doi0IK",
+	"8+sOKTyqrz2o7u0YrO9Nwv9mcwYP2ZBYH31k7aCJAUaO5w1BuLiGgH58t3pPJF7RAXuiqqVctV5U6WMa",
+	"lTCn1H5zTN2Y++oOfrHg+VliMo3festXyirmK/ltMO3dOWGRC3wo6/K710L3lK3Ng+2qgjN1O1pkbiRk",
+	"qNiSjSmOmJdGJZrZVhccQyGpHjEwV9tE6u0LhUBb7SDJ2IiXyw0vBxxQGgZZyJWktPpbIyLIElefYbJk",
+	"IppCmrrkOwoIaFbk+ZLdn0fMy21CIc+lAcdkLPE5lQA9Hk4pKLB8FZiVqOzaYPEHI4qvt1WhRWHXhtbT",
+	"KBbeNARJFpLSC3shRMXuY7nP/8I+QT9MI8/Fp7B4TqacPfr8L+gDQ3/cT/NyzCo9yFs9S09TLWopqSpc",
+	"iq6xNK9daiF+F0edGaoy5sRgScfwD5+YDa/4SuijxkJ1Gs+zzjpUWMiJTOlQyvlsIywHrpOtuVknencI",
+	"NBvnkWfUBqilSbdLfflWyOuM2HUYjv+IMTI1S+vubhnYOanx/xEMZq1FnDNuGCYIkY1OzDE30LljjuWC",
+	"0po3ykpcEujCB7SSSnnJai0ri8/mrV1m/8XyNdc8t0Kbk6FRZouvHiZCsFs4Maw6buC3vtxaGKHPxx00",
+	"Lya5OuyTSlXZRgK7/tRx6vaZG3S4TbPlrkvk/ibHykjQSrafqnjEZa9FX9WeBq9JcWEaR5Hd0TO7dQLc",
+	"6gQ1/PLieycPbJQWbdXtwke9tiQLLayW4lwUg3sDbV5zC3Q5avGvM/r36+XlhcNIgPIndlBUfxnSPnXU",
+	"MPi7h88O9x4c6SJCfmZ8o6oV8ha37okkTHtfoVeJgJQ635YYY5CZgfG/Qm60kdU2jsqO/dpF4IRemyQu",
+	"HekFw+5VwzPVfnkjoqDm4B4bkko1syE1wuMNcf1ovv3OxmuwhgTyH68tjIvBuwHV6rQlx0yzv41zRug4",
+	"ds2reOevsBIkAI8ajqy8uOyF2iv0N+aGb8jJlZ4zck29Alm5Fo5Z76sv5pA0MSxJiCsKEqnsZg5ruc9M",
+	"5hEn7RyzFmvtEmeXOnqruZcbd/OU9ZaFoK3DOmztWmn5e4wWsoxVlWnPDVA3Db/8YtUS6rzJaaNvs/Yu",
+	"dDBBawYGNBT9e3Nug0NIWhdZwCxIIoz4wxON2SoMF2xM+G4ZGkYW98ue26BI8QUbTUjVWpDGixVX6wqH",
+	"0qOV39ysIgDTo6cV0UelLNOArSCuYjPdq/IctdkHGcwVwLaOgr5M+kskXQZP2DOlk45/c+cUCBWoasp9",
+	"8LB7YHO+B3wEe+diiLIa38FmAfc4dKRS7yWkbCzUZlLudPXgCsZZSvrx6e3o2fTZbcB3DocxjzOyDI3v",
+	"m/aoHDZf0O0McBYM/w65Bbz0nckCaMTh+l3FIPShn7Yh08QYHDuX5m5oXNEbb8jCp9TZmRC1rFanBKeA",
+	"lgNqtUuvC1VtB7w/amVFZSUvGRZiNd8BJQZ9+x6ohqUQJstVWYo8aZDrgCFBcVZzSbd37MUuq4N9rUQl",
+	"jDQDuksAWF6DOQY+M6tikzI26kJgze3rI/zAh3ChRQXjfv700Kh7DbejnJzryVFJE35xdeL7HPsdXmUo",
+	"B+P92ZV344Tyt7+0iUFnX37+YHDgX37+YGDsHtbx5XePoYX3MRXC/R84o+5r0Lt1D8p4sY0ayuiUDwHd",
+	"2S0vPWocHtSl0LqBBQzDCViZSyGYkdXZQdSPgxk6X7iyw9fD69e/6qqAjXzSQh9tuzfT3iKYeA23agdP",
+	"fChuRKQ7hA/Q40ulLYXIwC/vNzTYap6fJR1HXsEXE8KDCcMjChQ2oyGi0IvsZ6jzyveW8tEdvmVfv/7V",
+	"Gli5o65bsx4F697v6rLCzkppSEcdVWC50hqxeAvKmdTBkRy7JHsxhdtjzLRSdmigMM4WGLRSFt9JorIB",
+	"oUQwHzwWz4RwtWAWMoLTP2E/KC28FwNg2u5Ajr9n3HuVYsY52wh9VgpmtcAcUUawUvBzFzISWrtn2KtL",
+	"WRgMRCnFpczB7bBey5wpXQhNjwcojjZQquT6u49ZKkSDsPLqssLpFUrQCy2eJ03T4+IET8R4xnNSvXd/",
+	"hh82RpTnwpywVxeKBmEa3F0Mi2vVWGwtoZEVconYppamgxpXrNd8iMZ0IcuSQExCs25O7yFArEthmVnz",
+	"B19+NURoD778KkVrL797/ODLr1zoF99eylJyvYuLQak5W2xlad31yNk5ofdGlmJZGSt40aMt8iJwvaBY",
+	"ttxWLuaxqULqWLTbQ9kvP3/w/z748ivndhD14vEVHXSXqM6lVhV88o4egUJcl6E3cSmNNXdkn4bEE3tZ",
+	"OekksU9ffv7gFvYJejl2n95DdGSVEbi5Tq9jjmt4WT2hQoQNYzq+zZ17wefdcdy0FMVK6Hkj3cBl1YDo",
+	"g0pW6eiFtBTIJVDYkJXVqtjmgoCJX7aYcTQs2RuSh8GPxkYMFHnPQiQyIQVBkDkt2X16oVeqPUNkXOJc",
+	"6G5ipE/oxo3GhRkOROFChNxURfFpWl7a1ivNCzHO4x8lgF+oRsDZ9S2cq+Ma+DuU7z7AW2/E1ssr/cCJ",
+	"A1JFT7fUu8j3sN7B9/2LIcC7Z1KUBWLKETKZVV7pM++93pdCZCBdJykeXtVA8zzPRQ2UHtEPfENdHrBP",
+	"ZJAGZGEvCQfMSsJMS7tz4JiynJdkk1BVtkcuv8h5iW6RDWGXYmkhP0iM6BeZ4mLLrVr6Ncg0tyKuAYcN",
+	"KHjnSpAbgqyac7Mv35VrtBTnokwOXHCNAtl36oJteLULewFdNMOYR0BmYeT0ssBwCdrtX5yHRDR8OmeO",
+	"IPcPErZiYHGLeJ9roaUqZM5k9S/hDnr8HkOKQd6eq8rKags8iGnRjJvkJ4ZWgK66sU8BOhm+C+PiFlP5",
+	"N3bXSly0djvO5tTGrjGWnwkatuuHcXvUnmphZLFNj2yped4e2XHE6A7vC27FqQ5ba26ILjvMKxzyfYeu",
+	"S8sdsunsVn+VBvlUiy+PYVY8AHQxx8MT5j2X18OXHFDMKKvw0o6gtkPbLvAqSZmwvPvbhhKt9uGHBon2",
+	"+F4yH5xlBvvbCdOmOf8oIZxUrC98yt/+Cg5k4QkDMBfS5utMVYMDoBIwhhddvUi/S5Iu8BSK5VLkdswY",
+	"EGSJ7HWDo6DPMIqnghcI8NmAZBE8Vncon/yoGDRtIpGnMhJfZ43Eg618ekTuQd/PQeL/uxpJ+w4fdYlo",
+	"oIePgfvgaCe9ZK6MI57nAaSUs50wuCrBYBqdEQSSTtu0faeFKPluX5dYoN1pkHm9pzfdOWg5gguFIscH",
+	"jd2+a3fO9nUORboTDsezfyoiM2N/J1Ui4usbdUmui95TzGVgGgsMAsTMN0jGC9dUN3PjXUnceCyKcRqG",
+	"Lo1R8vr1r/jFrwP+8b5TWHaOewdiZhiY5Bt1+dTNTuk0yRThe4RgSTH9MP+x1NNx4/QUdPvQjOldTQwP",
+	"S56AhcQ4+PjI4fUNfjVv2L+3IPCE4BygKiMIxVdTrqT3TQcD+77fH+CVy+klHJgsrkjIuU8Z8BOhzwfj",
+	"EVGnqi4HUOMinj0eBwuaiwZ0pFX8mFMeicfUYe/Yh4zETXbX/mTfI0H4/fHrO0AbISdWkiWEr3iCjSOO",
+	"xQ4vlXDDdIP+nz8FynFGXGZVEghkP2Bj2zBMa+saRGiv34VWTC4pVZeWDcoz6JzGIDzfZdbVR0bwWGKp",
+	"Tfz2nJcDQJ4vRE0sDXYOkD8ccQ/BeeZpJE2I+7RwPLAe2+fxN4A8/vr1rwsU8fB7k1yuHxuQREAAyUlC",
+	"dfjcq301x9OhvLrRgnqgjv6A/ubRoVjNpQvTbLBM+yvrQG2Hr6h9CsBmg7uTcKixg3f+MyGeRo/7RKx9",
+	"5+nvlCiRv4qqGb65O4apPqLjUjhbGjzJg6uq1En/uQ7dLdS5gAioDLUBa556YL2EnxPuUTBWdGDfkB+l",
+	"cyx3AZgwLFcJh7Ktinsmgtt2T+B5N7qzxbwLtYXUOGGBSctHMY2XMOb+aL+Tq7UwFruHtYQVYxuZawUU",
+	"ehUPt40oJK/Svf2A326yMznQ0/fq4manVf/ly3RPf/nSrlktNOpqS9Gjzut3HYwq+4Ip0gdgjFtcgqgb",
+	"gmntZ7PezXrEw0sd7b8iZhDyHHJHT0a24hfUW7WhaVsoTGdiN/4ueBpfAQzZI3vz+RuG3ufI3efuknnz",
+	"wP3KiW2HrBHszRdvnJBkfGhv+ja5tof6J7UyED6+I4b1aQI6dMMLEcl5w47so9EA6c54O5+psrhCrSPd",
+	"Q0dP4/BxuA4Ca7d/9JNIIPfGIMFNe8f7WFO5IQfr4Io65Cn9HTfrZzyHZ1E/8TR61KWhT8HW+vr1b8es",
+	"7udfpTU3MIR0J6+izElt03TAtUBMCa/aVMteBiWGKZTW3Fms/Z9gtIvSJYXvs/msZ9JrpJTvFugLRSrB",
+	"5JqsF7VeoiWJiqLdvpX1CW7o73xuN+ead4+SBZwJSkCpBSSLXKsLlwlfGpekrc+d1ousTtsFUa/2c5Mb",
+	"wEPr+K7ZRhif6ux21RE45s+NXKXH/TnKxy/Dkqkl+6kSr+RGhN9eYlYHYnjPn37y89/m7Btu8/Wc0W8Q",
+	"PV6IkKiH/fy3B+9pmgPOqOjp8TexQ3kZeKqxu1Iwe6HIsMNEvRYboeFq8pN+XzMY3KgHYzcK9wb36YHb",
+	"qHiDNtxYoSl/Rbf+34VGiK5P38vkh2ben/edOFlJ3ip4addPIM9tSi5a42fKg8vIO9IkuEzhMGx7zReL",
+	"LOBDRgUinZbQWuk20P9BzFdpso1cabS3pFt1K5xsLYgNCfX2EIyj9yQeNgR2lUrxxDsjboYXqaNdz8kr",
+	"mGJxX4hlf2DNt6B48qgZi11b6QPgUz7crioIQuqg+mkocO/161/R28C3KMkIZAz61qLmibxT8RjvjdUZ",
+	"65vO0+iL/rwFjDg0u+Ef7UEdl8QLO0vtxnOA7RO68Xz+oaG1TlANeRIJXghtssbVLq31IWHpdnkYZdCB",
+	"LowVxR7HneWRohwJyiW3Ylz75dXarzK0mFbZhZCrdXphf75S02BRPbxp57e/aSkmjvj5JskfwqfAHmJc",
+	"90Msoq4/KAZR18OSbkdnvqREialhXVNjPsxS6nSc3w/oa/sYLjfkJwPPrGXzCNv3Qo7faxgEZgcCteya",
+	"iPeu4LhrIbJC1APDtcWRx/i/0kflB1nJ/aiqj5mRm7okSDN3Lfdyjh6V4KsJtn33KLw3DWX6zkFJxZVx",
+	"tm4ei/SmoDr6qUD3I5D+VD1Rm7oUw0almldkVlrKyukCL9YcoQ4w3AzC8XzGpzzf6ibEpYsx+ndeygKV",
+	"JgazR1dK1fCvqq2s4D8Yk6+2lv4vuIb/UPRo+39EVZGWBJqa4b7ICkHJqSGPTz6bz6jyzFN2UofSikB9",
+	"gdkJ9UDiur8Jn7+QSsSTPQQtMiajf2ygb/WDRh8f17jhZwEWyNGVbxKvmW7+//eFMXIj2enffUj+UNLx",
+	"l6ls45H3QbxBmN7bpQ3Pel/ZQqvtam1bDTkFWjtjea+mVeqsXW25DPUS2cQHmU1rM1DY8v7NtdAbXiHj",
+	"PokOF81mNp+50c3ms25/yeP0UeGJJA71Ab13k1B5DGxIMjq+nyavvbdu+xH2FAN2KiEKw6xCD1bQrIji",
+	"lOeWItcccFEl7IXSZykTsEGn1biPkO47Lelxbbc1J7cCHmJfieD98EwzNDcyszUUF92KfD0ox4nLGnbj",
+	"+AEWenM+coRh8VR1LrQLsHAn0VEsmbF7SXyZG94xc0qJkT+7CHdgSgO8Shor88CvnJN38F1tY/SPf1b5",
+	"jhOAVWOfSTQU731b7LPpdkZ9NZQPwkaDUiyU8mTerAf1dF1LNSk0xlxSWJK63Te/wyqOkf2V/Ca6o5zs",
+	"Q+AMtNK1JxEqds01HZRtu2TUI4TOaFP89IUwaqtzkVRdRB+D8gKsY6Vg2n1y0ENkynPbit74Zq22APeH",
+	"8fFX0Vp4xDCMf/dYTVrkSiOoEekMUMQLEI7o816t2GMXAuKSRzGl2ROQf73G0Ge4Ol674dUOQ1gxPUWH",
+	"LNwMIicIn6lHC170Bv+6Onb4ESMYRk5t62hpSHFmhXc2pIW6PCTptlw7wa7TKAb26lkapbzPJXWoSqOm",
+	"S94pyC6eCTFwpzwThNbR3Cu8iR1LKZ5BoklcTS3BjnhwDHeTcNcNBbA7MLSzSrFlazzDV8OhVem63o24",
+	"UZ4l7xJirnClao/gQcloeCH0nNR6DkRwz5PsRrDJyO/tLqL/IUe2l9VeXNw+9la0ZOW2IGSRrsfKnBVC",
+	"y3OvZ2oq0Q7EZZkL0b9BYutHS5rUlTQOeu2KOf9H4cP0nWYTUnRjXdrjS2KQ3+vYdTkC8enDwuV6V1t1",
+	"imWwyKmxeptbQ8hwTZ89hgJyNKEKHZxeT5sNmgeXN9xkVmVanAs+FOqJhnMAHXQghFSYhQZScvvos9VZ",
+	"Y2o7vbQ4kBijhnyxCPmq3Lk0d4zDmm94/Sv18hvL2AsasfTIo1CBbcyqPh5SiZpKDd3w0maDxmpnmGIv",
+	"eWljDTYMyAF7tJxG+mzCyJUzfSVbz9+HrRLGdHUShAmLYp+d8OIKdsK3Q7wD+w16AFL+t4/UufNcGU8O",
+	"3tcFOrnVebwIJ7bPFaL5jZtFvCgRa0j79vmv/jgFskWNWdS/8XnJeyBeeHRFZfXuKrpIucpMqY6Y3ku5",
+	"egkVDiypL9Zb01JdCA2ORftItfTh6nidMyoJJzxCpHIrRu2RPCIKBpMxV1sIaviolXBVDq9F03Z7NZa8",
+	"zFWVtXq/Xa5D/DJD6spC2sgDq8c37dWrvVn3WK6FTAJiNjIXF9Nn9GdidzecEBJQgL39RJiAYS8QtHH9",
+	"GEAxokDlCwdEQIHmbUHnsOWDFIkZCb97zpVtn6sGo6bRn7TSCHQVlM7OCJ+a1dgHUpL2asa6jCq/2tUi",
+	"oOUJpvkFoyVnW4PpeWtv6kMT8ECEwM15u7AXASewDyGWq8pyWcEaJHW3uIVrUdbIqBqn7JM7Rb5/j27m",
+	"NvkeWJ98gwQUxRLGwIrw//6SWS3eg+PumdhlpVyKtIoAbpild0D2xU5uTKYYSjrdisFEo3dJYJ1Nnm6m",
+	"NH1Z4Zc4HTgjPopZ54z/y7BCWKE3QIprgG7a5muU3fkq6MEwUkBW3jOq6ajVuk/x2U7n7hIumZrn1NDc",
+	"6Xr1SugQWefVhz7yYMMlnpMGUa6b8Ax+wxx6R+fR/oFyK0a8C6NZo6TaiXTdfhhnYndKgUf4+xUYyXBu",
+	"7oGBQeF3OaRr5fuOc9AfoNezVpwr0lOLWprh32C8axQNdWS8az+7/tjp4TzwOGyN6M9zPExuvLaJJ24z",
+	"t7HB2gk9aDrG+lBodfpW9nFGyMexbhTUh76CaJb87DNs/rPP4ui++DNQ22efpYFxkifn5kK5aT1cG667",
+	"JHU0AlXCFZ4ueUOQ/WRugQsNvSPwxzYWcVUwzCiE4glHaFZRqlokS1sUd6INxoz6Wqy2JScM3r7ecUzq",
+	"ZHr+28vKqbrwz1eXVaps9AeVjpbjdQXe+1vSAmXi0qW1deADwT4TNREyUeeY8zn5iRLJJj95dPXOxzOx",
+	"06LbWM13IFN0fu1AgkdfQkBK6/ff+h4HMtsIu1bFQaehhfyBCnbsVbZNUCPhsyNN6+ztnmU8osUm+fbs",
+	"7Z7VP7LFZ9hC02Jy045s85VrA1v1mW7SauBVhepKr6SUPh0lPgyI8tunLCjb4SPiATsn7YC/Lf4NasvG",
+	"QZuEHUj6LaoC0UKB+2OPVjFRma12qlIYK7YHQ3HNqFjIMU2Rq+QQxIxBegg1FVS3OWnFsQRdTT7FOVUF",
+	"8auAzVEJP9iIG0N5eHoP5coBiZ9DX66gT4gAd+PBJymSsd4MB0WQISnsVCuOmBsW6g80T6nUs5bR2Gsv",
+	"Oq/NkNW+I7FgefbJ86efUm6+1kccA3USPUAPT9uPi0zFY0bkInm6YyFL8tVGkYRRIKjcDsI2W4oBFTl5",
+	"mpzzcsDevcTX8jMoxbBUF97w4ChHZrQBh3+QS1zxJvXHXUxj0xpkO5Vq1FT08MoKb4U7qHV0yC/z2Uqr",
+	"bToSZKXRZNYFL4LHEQqepNigaO9TiAYv5EoYe8L+AefQCSVAjAEIkdvebmLSK66dliT+gAMLQFAkHjqv",
+	"x6jPtdvQHnyLdIDf2Mx7CHhNigvjr7UQ1A6NHUZQSA0Bhb8kiT0vRGVRbeMcv3tyYpwpsOtZShFBJSjL",
+	"Ubn35hSrn74JmxUsEf2NgX1BdOc3NDywrr9x4UTGco1OQdyy++T/Ki45+PqzN6+39+9/kcNQMnA4xT+F",
+	"6/jz0/tv/GDJU603Hz8Ssv0PzDdOi2AVK5U629ZYLVHe2+KRRR1Cc+luStqn4Hm3F3QmRPx7WOf4RmmB",
+	"ht5EppWre9RTwojeuR5x7ybk8vGT+BtWDl6Fw3dLiXfL9/zKV0sp+ADuanmZYJBfPMgaHnnCvofaTFRL",
+	"pXNhGD2HmHsMOcKMiYax5y4tFT4Xga4rVYE/DqrLKqa8K1KHi4bFRv9wnuNL1rgcDDAG6U99UMl/8hLl",
+	"1TkN8lPSxiTO7LaykgRcWMa/R6tYc0yjzdg/1rJMUEGt4LuJxzFnlfL5mqOSlGnH5YyWxo3ZHckWId0u",
+	"I4/0nM312qcEdIT8PooWbXRxBF1jmhyt0TmmzBB0mv2+9Gly1AF3Xpjt2717zEu1Sj8EyhVNYHUj43y/",
+	"0ZGVGgDXhw8oaGqBWrlN0Bvf7oBTuofxnO9nqk1eObmQ50Lvf+PpgTeer73/ZYf5fzOr0m0LMqnS2ys8",
+	"ptFCQNy2FbiSftkGhHKKeItfJ3SCOPDrLboyREZ7byFwj3ZXCU9e4+cVEevVgwDoWkzbfwBhJQJrRVE9",
+	"JeTKUVciKRCSS20oTR+x7Ht7phOa2U8VZoAqqO5+mhjt4RCRbeTiMKxnO6K5xgEPA6v2IGvtatGGRcdo",
+	"0KCibqUIgp0yJ+xpyFsGxVzSnyaZGWlyuyGilPzJy0NCaleOce0tNRhFilE8eGoSjMAVINkIyvSlJFeE",
+	"50ssMKTq88Uul0I35VLqNl9yqX9vCvY1fb5YXaNPzYDO0pUytkaz6MBOu1JrQPJg6cdSE85X811Q487m",
+	"M5g4/AMTg3+X+vcZqVBRg1uDS+Z6Mftt3Dl3pJNhZwnP2FlbfdGSN8OBbSjwgIkgVtMOZUdwcAu+3NH6",
+	"+6gu6eSjTp/wsnx1WVFPCXjGfCjSg5cu1EMYw7YVqZzeeGb+Zs7eLJUWclWBGq39N5CTeUOn481CXWba",
+	"hxCYNw66KgSrIPYMiMA0FCf+ZpgWEe8PQ2Ua9o+fOlVCcdUUJ4NpaGy0XBWH3SSEjb3BerwmhOPvXZCe",
+	"L4wXpItG9xpfx3dj6y5NKPYRj7b2nmE+f0pWU1gHrjCGj2fh2Plwj4EAvoN3X2++0annejU4b1T29gV8",
+	"mTOuV1vKNXgL8zswg4E3I69l4RJJ+xDqnjBMDHerRcGUJopjculiDqrVQNRPZ0ZDq1c7aVzmjdDdZEca",
+	"YA5zeFaK2sUHqCrLA1BClFXgNQEMvJ4FnQdGEuHVpaUVbRTXxGNgzrhhFwIczAI4RhZ2N0LMOQmxSMxN",
+	"l46hFuiTlYh+v0UxPE34YCB3MVIuIipiVgObtbgkgxLCyXs9p3u4BgpPvJjYJ7Dm+BIOHqiYDBVVlp+O",
+	"ZlDdmKwuvScOzMBMzHaA7IZuIxK625T2HsgM49saL0eitJxXlbIfELGJS6u536Gs5qsBihM1cgcTDD+4",
+	"cBG2SV37VWClgHX/9xYhxYDIsNkBI010fw8QyJL728x0tyt5p7VZrYtljDfe9K668Fq72k2AptdGEACa",
+	"yyDxyr5YmMSZacsuQ1w6pMc2DR6OcbMMCV3GTrEbtwkz7Adu3tD8WlYjE3wMD5qNnDtiRy92pQZaXONQ",
+	"3RboD8rocKr3RvXwWp97xlwSvnzMxlzVbn6nlsRCcdFyg6DqVpQ7tuSyPGH3u1atSoX2CP2zCamuhV6q",
+	"oQd/PyVHLJl01+jQ0yLy19j7tIBy4E2kPKPVIvPSjPsFiK8QFPHmwZBeV48pLpSUMqEpONnNelDrPvLy",
+	"JFHJJa4EHt2t1u3y0EMHKrknTjP5Pc+bfTHXl7wn8+GYriHt0SwPKm6b2O60I/CAB83ePfZGf3rF94Ct",
+	"jlxY6nHPwu6BCFjyooVt2MEkIm5Jg5XGrTbBfxHsIb9onZ1Grt+7m8u9u7mn/Q48v9OCDOEsRVoTyoh5",
+	"4VecaqTgq/bDqNLB73c95vAHN6hRpOE1QdclDt/rHvIYdgrinOIAHm8cQpQfnArjO2GOhVAz4Xft9ZXl",
+	"0nMzz48Dom1EaXDF0gW94fUVvLWvwTyiEQ97T4lB36km1txJGJ0VgO6ohcZLi/HGr+L6MGO+9fQW4tdu",
+	"0kuXeZiWobkOtdioVtR7anfo/mkE3KBSZeSQBmvaQnCMMUzixYZU7iA9lhd8Z7xBoqGs4eb8qmrBrUop",
+	"w+OUzmRFSa+NzikQSOSylqKywXsw3pel0HvU+OmGnTng1drnmpXnQYfkQqs4y0t+AX6IHROztzBL8l/k",
+	"0Q09d8vMy7YoRA17nRuUeeLb9jMKWxpdaCMybnjUx4j7hSU9wPQaJ5m9DC8Ckz+S1YWKxO5Cf8Osbr3I",
+	"9l2G6wUvKKGFvw6d34o/tiSEXpJflFbnTXhYhWus0pSyXkDQY1bIcjuIi7lenLm+/yZ2T11J2tINt/k6",
+	"GlRzKH1+3KjKFfjHekEWgIM4Ma2UIFTRCFEMzMe4+bwUomjRJpnhoGaQOLvS/T1DvkJkv3lPfoDrBaV/",
+	"lkMzPJduipBO+fnTeLdgUvt2jGq853SR0XHoE2lEF81OtxblwPl3PkD7Dz+ZjY49+VSLjj11M3zmwabQ",
+	"wwtNOB9UUAi28weu28CY7rJuwDARq7jVarVKyZJwR5QCx9wewmAMtBGlM9lH6WzQ5S0Y0F1MZ8Fe8KpQ",
+	"G/bM5wn65O8vnn3KtDDb0vpLhsIzrRAsjOT2z1FsZByceK2XbuYvo3joMH1JmIdDELnm9meFp+CQ6zQU",
+	"Whrb+E+TYxYlhe8hOkonBaXFUOzw4D0CpegmaQRTg6lpTPDuXCCL6kG3Qpk9XR/w5IMyJU31e34DMx13",
+	"YHC67sS0eqk75+euEdABVYJ3I9rPPZ2HwrHs01Uj/ul6utr7kJ6HTSDsDzLX6jGiC8B+VkXAYL2xV1bU",
+	"BUXiC42itW0/ttrBMe4eRtObj3GJzLoHg2fa7SXXIryzsBMj7LzvXU8dQueux+hlhPXJBAOxhM3jZ7mt",
+	"CtNZwgAHs8/PaO/bxz19fJm9LktDj4KxL4EWLEp7JCjg0WmMEHGMUblsnM2M2rgg8h5IZqgUPzJRNC9S",
+	"CcxLsJ65XFfHekZ97+sClsq2tPKK7fzg65KrVvo6lCt3FVYF1wUTxYMvv/z8L+8vQ9rbkTv8fbTAvVmV",
+	"blrOXMKtzNvv2DC7EUzMb+XJSvVZ1qDrg141RtTg6tDL836UxwIOZBjcyE3WO0JCqEBE6gqe7aWVzU+Y",
+	"uAUCZxrWuRb+cFJECGeOX3W92zGCPHK7uG1n7JXMM380smu5IcaH5OZbNMMMqTl8d+HMxWyX6Gwsq/0h",
+	"4lDtGZIRB4jPY3TgAtelAEGxYaiDqIt+P0h+8B29lKveOYzbSy/1duFWG8ZiXGZ1tYzFN9Q2NqO6QkhN",
+	"b1FexuNKHGm71sLAiJKDtmudBKbbl3WvybCVsDIetaEvO2vaXnFat0FxuT57T3iH+2jgboB+pb2X98vf",
+	"Q9BdbMT91WCXdjFLh0XxKBfkPtIfzOvXfoyPB8BrVH4th+Ehn25Te6/uVxHSSAzoyp4T+TehACgUVwRv",
+	"6JIDkUuMVlblqmyv100gOHU23OyP4jS9PAj4wCXHGoQwZottfpaEaEf1f0Zvjb1oxQX0UOXWvUvMccno",
+	"5zMaQRKGrTE2UaGjsJb3xUU2o0/hKNs1cHAtGC+NaoWg4FON/G0XO0JLSfYN2fMHgAUiPYOsorldQQe+",
+	"kdVQL7Hi5rrdEG6fxxIfTC7RynMw3wtVfaWk71VGK3/EHQon+QlVSqVlj+g7kGGLbuKNjJe7tSatsR2Q",
+	"dKIBjcX/VkvSf3li6x7T5eqgeZe1XHFGHEmMfzjYat8RxAy6KJd7m4ucqPIuJtFAk5dJp4TuEFMOCQMt",
+	"rhf7mkva+8y+IOR9rR1U6qUcf3b7Wkwo1QYawmiWPS0NiAdjQN99FE0resbH1KwXccANxd/gWPpH5i1y",
+	"76UizMfK8hyvh4pvoNRjxyVm89lWl7NHs7W1tXl0enpxcXHiWchJrjanK8Q4yaza5utT39DbeWfqvj1W",
+	"igJud17xcod35uOfn+OspS0FBtOjhBJli340e3Byn3IhiorXcvZo9sXJ/ZPPZ5T4E0/oKeXwnj364+18",
+	"dnr+4DQO71glLz7Bdb4mac2VBUwuMJH4wC8t+MYpmtTWspqvZOWAYdaiwjyyhNTqsP/bh+z0MquKfxlF",
+	"7kri0p7m5pz8+Ss7Z0YIVqjcnH57WSttzckGlQvAdrD68yIM8pnSj/105rPGQXX26Ncebr/LD40cdvZo",
+	"9u+t0EADblcjW33j+9mnt8OwhtotFEaj2q0moEiN9zZp2iLHZvRdhhCIikmXJ0hupPVuDBo4r1OtJMaM",
+	"ZY8cMPl0XeKeiWi8J+wXI1zqsUvLrDoTVdAJNumafHIGV2lgYNBEalzNUyKR7AdXzekjMTiRV94/aqUF",
+	"pzx/vIqiaE9ivTZ3/jSFWHIw5pHRON+xbVVSQuPIt9OEqc0xjBW9ZXPuVsCBC/kQXjO8A76TzI0wgxEe",
+	"uSPPSb5DBTY+0iPRxeu3HY3PQyrX6DThr8ZqtRMFDd3MWUiO2nEDmjs3c2X856YhikAgJ/ahCdPQRMbL",
+	"MjXNyCOwO81vL900G+qn2RoAZeWmP9DuyCiFnsMHDWAXbm3mrn7DAwIy1GLXLVm1FnBEHVgOcVmXqhCz",
+	"R0teGpFeHkGTbC1NULz42E9aO9qpWQcTyyWKNlnkjD5r4XlBiUpV6eSpvUQRdodXBwi0s2NPHR6bu3vk",
+	"oItrnTd3qGJHaKsaYDtMfwiH0GFqJ2+NgMw3zO0OxiPu/zw0fH/PeG8g71vocEwIbMi56rvsZdy456U0",
+	"Dc37WI5CGr4oKQkl2o5aQjveD7AYnfiX2GN+KUs8Q7iLdPcRfmfwOawKYEyZrCLB4hnWgqYXOxaxl1Yz",
+	"e1rABQhsEc8QFmt6+FFVmau04RVfCU2kCzds93HtV5XEmIh495FkSCZ6BBW289EPkVc3euKYHv5BCA7k",
+	"FBkckMEf0i0qBNk0yxhifCJ/AHLUbicTd4lyB0ZMXzF+58j74SftBMVoG+Zo+XFWnMXOMxqHx6Z0EQjH",
+	"YWxH+NvG+h8NYRPhWfZn2qNE5EDp6JDig/qAKhwLIkNqwO9PzldTrHwz08Dlm0yJNIwM5pvg2m9/m88o",
+	"Z4mhN/WD+/f9y8N5M8SyM8jN8FvTYy/gPYj3x6DsJOMsadf3Y1Ry65h56/yQxLupt3Y4FuHSZihn9lv+",
+	"xbgrvnlZzOlMumzbvCK8JRcJ6O8WD0kKwmtw/nLiruN3I0z1zYuivQDpl2J75J9gIM2nMMGH19rHgtv4",
+	"5DTqnEifvX8evuCYYb9wBIiLLrRWGq+mLz/0KQBRc7Br/zoz+GKc/fa28w4+/cP9L5PF28FH8fcEneeK",
+	"MlnRVe18A9tvUyrrztU3O2Tve9+mvtUgMSCrgSd8dBeEQc7iNUKOfsxLa6z8cIN33fTCmV44t/PCeSdX",
+	"6REX6Du8MNOX1HRHzR7efzhds3fnmiWM2QPX7GmPAxy6d6soHqTLR1VN7Bai2cnFwGNqULTIntv5cV0j",
+	"xCWa7c1duqff/QPxI7mWJxX9lVT0N3yVds77Ec/TppfmpE6P1Qhgo7Owk0QwSQQfokQQcIneixzgnyZ3",
+	"5/5/J/bq6c6f7vxbu/PDiR530UPx74h0pvs93O9BiTJd6tOl/sFd6mAfX0tjld4dutrtukks4RGHtnat",
+	"tPwd7po4lqtRdFYCYfCcBY9y//KArIMB3B10Lp/RhyJU1dYaxzG0MMIyTtWaezD2JLaY9BshHgtB9uqU",
+	"a/E+OWNr19+59bhDwsZ0X96MG1vXuMItekgsregaWYIz91Dnsbf3VSS89hAWYqm06I6BXx4YA78cM4Yb",
+	"Fhs6PGOc8NCcq28rq3eTABEEiHg5JzFiEiM+QDHCe9AcIUm4Km15wXFiimeJM7uRMyIKHwWTKbmicQYL",
+	"Zw9uQNPtrAFr9Dlz8RN5nHkWAhfRtrqQVZAqWiE3AXbOHVayyM89e3AY5bzh38Dho/tuybX3Z6s5zILL",
+	"MiT00U5AUopteLVr92yVG9chpwaa1R2UZCY3/0k++nPLR56jjJaN2od1Eo9al1lYzUk0mkSjD1A0SmR6",
+	"P86I4hoYcBe7llHlCTX9OB7a5GExWVsm6ejdeFi0GMCxzhWTSJDIWTKJBZNY8GGLBcd7VQSBoONtfiOi",
+	"wORmMV3808X/3t0spst+8q+YrvkP/5pv470fYR7pIkDtdayYB6eJuArk/6c8PcAVeBM2uEcMaMHNT34Q",
+	"k57/T6Xnnx+6M4GGaqXtnrM09yAHTcau2Ah4jbDcm46NRPzCeBKHJI/W4X8RV/x4pA/Yg2bi46W2vWvX",
+	"luQ6l1J7Ybv9T3LOJOd8AHJO7KQwFqKhnSAwAkElXwy6zUXhJR2rmCoL+J9Dr0JoFyYN4ya/e/iHLbEq",
+	"ntwhcWoScW5GxHkJ97hKgBZB185hh5sc6d4RFgrTDOGq3Ie+m5G7/VkhalEVhiny4xFVUStZ2RP2Y5gs",
+	"ESOiOFEe0HANdYZF9xaiSuNlGUh/sbsCrpHJZ3TIU1hG/WUKKU85bMZSXror3Cc3RFxUD1yOu6msYEsp",
+	"ykEqqjDVFTZ2NO4aJS2ZvT3w9Y9kx/aS8NtT60KIsgFg1mPROvTZNiYt4s4iJO2oJZQrhIT3+Gr/gpXz",
+	"xLZt0jIFedY6APmQQuDR6+oz+ItlITsO/LKhnzBJwku5gp9K+glzvVByitQ6QF6RwYUwWG1D/0B7oyYZ",
+	"vU2DVjl20VvsnJI5vS9pDe2dRMH5yN9E71bhHnhwM6eVhMvKyg2AVzqmwyv24tkT9sUXX/yF0eG3onAa",
+	"hqEJU5MZNNQaXGAeBbfh8xhW9OLZExzAy/A2GFXq4KYGirqpmWOLd2/iHzFm8EcJ3Po+UdJo1s5S1kSX",
+	"ZVbtF1V8qf2GtZtVzXw0qpTuq/DYFFdH605aHU5okH8qvcMY/8k4M0Jcfjg5wRGuj+/eHZGglun9EI+/",
+	"OXQkMQS05Sa5aZKhU7GrCd6TZ8SkZZlcIj9Gl8g/NaZwtE6nf7SZ9WFs4ab4oL63KZLGFU6JxN0r46BY",
+	"/NE5tr0ztnMks7k9+NhrejtNJrQPRJTtMaHThbocZER/RfEPXv8tWRSP4UJdMjhXPn2E6eT/DgWwtNM5",
+	"fON+M0Hd75T8K8VL6IXy7XG9QmUUu4eNyWr1CBu4R1lMJHKTrZNDqKCs7KPPH3zx0BXR/IJBDlwzd+PB",
+	"0bGvHuJooOq9xVcP73kTBDcwEPjp0eOvv3Zt1FpWFnKgOA1Dr09j9aO1KEvlKjj5WPQKwodH//O//zw5",
+	"Obk3hpWrS+Dmj6viR74Rt8/UHzd7JyvcmuxGd6Rd7rYWPSmA0vqOVwxd92bYG5erLlPHHc5MlF5gcruY",
+	"7oybuzPMdrPhege8Xli2aJOai+ogJUBHGr3yZTPWK1WcC71zGBzMqu4ttFCXc29Ht8oZzk+Y8yFl0rh0",
+	"RudclshOvEXPZ5ze1Epb8NlYy1LgzN3A2AU3TFQck1+56+lCSys0uWK8MZZrJym9QUeQGhNA42Pq4f2H",
+	"9AjrNljBfHyz4+6AQX/Yif+/N/4/KXYmD+G7i5TWZgI9zvr69a+8rmVx+fr1by1mKiGTcFoFRQz4CHAR",
+	"dfl+gUVw/RMzhw8w7+bd0n6vzOaNARv4FLJda4WG+v/nk/9+9Ovj7J88+/1+9pf/PP3tj4dvP/2s9+OD",
+	"t19//X/bP33x9utP//s/Utaqu2Q5i660/bn7sVEtcqWBKzjiOGFP6D/+sEiLJuWNNEZWqzkz5LmON4Z3",
+	"Q4N9CMWN84WjBt2xl9bnotxWZ5W6qMakweneg55+5z3jneP98dTHSFLfNBf7JIpPovgdUN8Ic6wCp9HZ",
+	"+JNISpmAVxeVNiQml+JS5mqleb2WoKPZnYyycn6Dw7t1CXaSym5WKuulsGvS4yIt0zDfIIM3b2CRvWsM",
+	"EBf9fEKOpXXpLgLDcl6Rx+9mwzMjgEbcvT4m85zrYX/mOerp/WeOeweSWTj5Y+Wyp65LpVPTv1MxUWlB",
+	"5JV/vuNYvHgoTUtnIeEYlOKcVzZqe7S8QKs6VhAIbLPNYifBYBIM3qWOjshuhHbuKHv0KVx4h8Fh4Aw/",
+	"fvEke/BfjCowsZHWodi2z8EJ+5ZKcC1YIcguFNBs29bbVRy7APuleW5ZNALjImSFFlFoCzJIYiMHNGo0",
+	"lNuXRX4CPaS/Dt2KueFLg1t5QDs1aaMmbdSkjdLvXHXUsL9jHcOQtdxtoeqgnqSjHXGLMcWDT0LPB6QN",
+	"WZVq4TNf3pChkZpk2CTA5KSsjk+55aBNjrKpez4tTYjVJYskKU9b2Y2MrHIRxeNu65XmGF+TsDf+Tewm",
+	"75l9ct5fccMwLex7tKB2yebPbkk9E7vJkDqJrpPoelOG1IiNkXXtI44+eOcGSo9nF45ly1S51zbp2mBW",
+	"qRP2o7IM419dch12sValCI5A0oSh3aQtcxLSJyH9AxLSQdd3ROwL6gZPAIKkA9TUlcN9tDreMKIqhM58",
+	"ltDAXOAIbk0/dF1HcE6DSFCEyENgOk2L6lxoLQth4hD6EXIqTOj9BO1MotYE+XSLkE/vGcrnI8XVaZkd",
+	"Wkn9GuMDcclDcd1tXno0wtZjV+/tgc8fufa5VKvM3+rH6p+/VytQQf2ZNNBHybT7RJH9CS9iBAcsuc+t",
+	"aVSyignQYJIhjritWhgcuNu3ib5xuPebtU8f7m9bSTvUH3yb3X42lyk9x5SeY1If3CZqBm7y6R/+eB5G",
+	"yoCCsRfg4PMbCo5/dDfsYcLIeMcYGTCJ0bzw9nAxaFwTu5kUrndb4drlmKdxVvBD3pylNBadmB0XAqsF",
+	"MhS6srHRO4t4jxy9Sdo9vc2mt9lNvc0mQOGPC1D4p4Qqf45e7c6MtNgF+w77B7A51JED91js3NrM6cBw",
+	"vRKm4QUgcjg78hXU7UHxSl1kezTvNybF3qx4F99Go569P8hKImv/jlZwegF7MWLR3HXTG/hjkujMtq7L",
+	"UckcqaQPS4MGUBRZqwu22eZr+EDXeS51vi25dVj/g/LVS+r6Ft/MjyNR1IiGj3ItUAiJRo6XhhdffJI8",
+	"LYzQ5wLEHBmb/zkKpYZxijplIeq0EYK9F+TYOFRx6eS0hH0s8LojDWW3GZv6TtloQ7QHH/aOyA5hrrsW",
+	"pyf4xLDvOMM+JkldXBZ79Jx7X6a6IQeokKkOurrTD/cpUd3ktTQlqpsS1U2J6qZEdXfdoW5KKTellJs0",
+	"wH9yDfAIp1mvDJYVU1WIEYoKkwwwKLG9az/a3qSeqM1CVqKRsvpREVbBRmGhNbfhHvYFrWImOEqexO+B",
+	"zCfh23Cbr10MhPsNiEDzXecFgTHKBva5EjrTIhfyXOhW/fCjWlKx9lagLktwbReCdzp241XLqEBc98Ce",
+	"ZFqVA7KBqEiu9GObzWdLLcTvIrNcr4R1MlJnWbC7eJ4gPPmRjRIvWpvn5wcrEA+52Ulz5FaC5I4mSuYz",
+	"F3odm94Ayh+zLqCdG8bDxszhCbVTW3aBvKGUZ1jfacJgKzbMCEvnpUVlVm8H3Qld9QzHczBH4vw2XHam",
+	"dI9Tuscp3eNHoL1blCo/y0jlNSpaACs4HZk5Yd/Ef7a1dLJi3OSiQi8TJCWn5khr63rqvkpZz3mCqkFt",
+	"bb21e2IVcDzfuelMirVJsXZ3FGuTOmFSJ3yk6oRg1d5wfUZCNVySygjtOXt8r9xD4dnKXNb0dNrWBXoM",
+	"3oJV24/rNuzZY9ZJXNZSi+KuLZMb1h1ZJL4worJ3bY1oVB+cXwQu3xEA21B8clMLbmq0evMps+ifOFCL",
+	"Nvn0D9zbjN4PB4O1sNKQVwCdogMPFjoy1N1snlIDxQO6piroO+cGAUL1suQr5/mLZwT9HqzXa80jMRpZ",
+	"b6EEvYGcqbirKDYD0gux7Ay6fLeKoxH8bDqeH65SY6XVtjanf+C/Y+Iou/TpXUit2nSs2thk0Ebg4xJf",
+	"k7yuBW8LsyfseUKHr0Wj1PCXjNRMK9XS2J+wv+IkhkwBQT9SiEtRUMQNr+7hwwGWQxRsWw8xm0jVgr0c",
+	"4jtYCNPntFOH0Tv9lxffZ4Yvhf/Iy3rNFwIVIbw0yolWkSqkzbP8Lh0Bo3lNT5EuZmm8wc+fem0Bjgsh",
+	"QQsyElQF/ebV4s0mNOVpR9hO2Dkz4HPMja8kK5+Ds6luLFn3MJSC52dLWZawnfgw5JVHxL2yG8UHpGEP",
+	"ZJCCrFnpGvBqBgnx1pPY7V0CWUVLQGQBqVBzVS2l3gwtAN3d+DjvZ4CRG9GAK9It6y5X703U9MOLgkjW",
+	"YS3LCq3LRuSYr5VQl0Wt8nV6ILduX4g5gPspWoyP3v4wSRt3WtpoNEYjzCctNwFiEq4+AbFulLF0vs08",
+	"lg98J6zmO7UFlT+6bARu4BtrUNbjSpxMGBUwX/YP5wH9JmiR37jYPSs8nCxJGHy10mLFrSjmDKQkxd4E",
+	"re8bYkdk7/C2BhIIGntK04BvVhqykjR2CYxX0RQ0fCELoako0wRcSyYl2KtU+uhg9/k5Utv9uYw+E671",
+	"HRdcWud/1F3piRVArs3Rt2XT32SK/zMplcK+nv7hVM1vTw1SyIjXq7sEwl3iUpI5JzXgrdKaG7xFBl6W",
+	"bco+wIp/Dg13EN46rk3Bb/AaWRYmLnrXAyY9pR/BOA9FTGKpiUn+mYRtEiNPuQ0P5YMgPzzkSADLHmVs",
+	"7J29xgDfJEVYSp2q6vIgkKxL4/EZfVFXFExHsYANbcbCc8MY55E6KF8LXpPDqHNWRSGZV+xN5NPgWmts",
+	"/W+G2DEeSfPYvqJ39F5uDGWg47JZOjc5tbyeU4J7xQ9z71t2T/jQ3E73ZAXeR6JdvxKfo0MiCsKaG1IX",
+	"iyqquRP20ED2aKiM5ZsanlRvmuJvjlI97Znqsee4NWH4BD8y2XI4ipbvajq5ZsZHT3bv5TVdWn+mSwsF",
+	"kdOlEKNVRIWEYS628DVk4xHCsJrLoDmxqmalOBdlz3ZFjHvOaq9Nmbf8K+mKg/wdiAjXYvX0F69W4hhN",
+	"Ed1WV9YTuZZk1fQ+qCm6Z9jG6XDcmwWLjVMgQTStlcbKPHIZzVVZ4uegYd/hR1FBFrngenWhpfWKKlfv",
+	"3LFZP/5NrTS1I0vRayiZ1vCFMwm5pe4F/NR6WzXqs65fNIIc1Mq3wB7ef0iTMM088ZqpBIzVDWSPHu2Z",
+	"EKPebpMz6+RCfnUX8hvG0PVa5kPPx2dCPI0Y60eVSI4YSOLiaRiFZ/6tqwDopH174K6PUXPi4gWGkvRi",
+	"HJncDjltdBGEMc/9aJP57cbgHAfa6aeCdkvWHudkfpyEyzulNibhchTmUiRkVsJeKH2WXch2wHcsOETW",
+	"u1potlZbjSE7fHcH5Si42Bbb/EwEu2rUdl+IciDHNy4/RW4Qo+Sol/L3EEof9elmMme8lCsYuqrYL6+e",
+	"DEMmW6HPeblXy+LDlmAfZ/NZwXdT2NIk6X1Qkt6U9ne0ZBRelgcy/xKniZ3JmhS/GDnDywMGm2Pdw65m",
+	"9Y5X5OM17Uyy2J2Xxbbm9IJLC+YHItexYSL/4DLCNYOJLjjhk3gzfqPgdko9pdm2srJsRzfLjTBMbQmS",
+	"vXKXk+lp7oJXgPWKbGfS8sEcVAr0cBHoUeinKbDsdV9A930pCWb4TOkX3pHlfcW63Oql9Kq37LlzovAC",
+	"sd/qAW9cvzv9pgl8ARPgIQR0bJCAht3I/D4lTRPHWibiAU1Wig9yCl/8aQ0tx76C4/JXARxOg4rdVqr1",
+	"OwdwnHoFTygsEwrLBG88wRt/qPDG8Z2w2Lkoq+dPHX4EkkUgHdqtzAWl0RsbFWUXXIM5nr5DSJbmOS6d",
+	"XXN6l0MQ5bbCMMpP5Ik4YV/P2emc/eenoXEo4VoeWIUojOpWAicnBOiPRPd5I9mkJyCoCQhqwpWecKUn",
+	"XOkJV3rClb6TuNLvEwu6L3REJD4sekQkcrQA4pPod88SCK6PXzzJHrKNsGtVMCNKkVul55HTeFyL69V2",
+	"Iyo74lEwKJphT5nv6ZZF+OQS/FQ9UZu6FDRFQqUZep1XWR7KJs97pVSN+iIroQCSpNpa/K/gMF8C5sOn",
+	"fCmsOOaR1h++FksBNxe9RaVpFaF3vdRwYoVcVfBxkJO5Mhmv6xuksP748E7ujwx+Pjy2qwngo0bH2UJd",
+	"Rrc1dO28bdQl/sUk3t0rxcuM6xWKqOwe0rusVo9QlLl3wp4pzSQmGN86OYQKyso++vzBFw9dEc0vGCC4",
+	"9Motvnr46PHXX7titZaVBWccJwf3ihurH61FWSpXIaA3dgvCh0f/87//PDk5uTf4jlCXmV8UMfk7TJD2",
+	"kx3r7hrh4609NdsFtLUYDhV96UuQONXUJUk4aDOxl1gw5AbU5S28lBh4KVh12Q+hna6Bqd6adcq8xI0L",
+	"dsmMqCxaN4BxGkXadXrE5Q2CvkdY+//jJyzO6B3IY2OEfwc2sapoYjHbjVMloQ0pYdfxSzTZdSa7zmTX",
+	"mew6k11nsutMdp3JrjPZdSa7zmTXmew6k11nsutMdp3JrjPZdSa7zmTXmew6H6NdB33mUfWakR51fMqZ",
+	"liGjrwB/7BSziDMW3dJvgmIYlKFsrcqCdjZqj5QVHp2MyqNLv3ubYE38ytbcEBxcrVUOS1qcTNaOD8va",
+	"8QdoXw5mu+EMdHmlaOebSSSrcZaCkE8mBqR/Q/KaLN7MQYUVZ+I4kHKmbzhIBPc5NdJ4xN8PyPAarfFx",
+	"nGG0SXOKjJ7Y1F2JzHs7n5Etk876VpezR7O1tbV5dHoqLjmIlye52pwiMKur/0fQVKjNBs374RfXcvSL",
+	"Y4lQ/TJTWq5kxcvMXPDVSugMeqYxPzi5P3v7/w0ApRjp6imnAgA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
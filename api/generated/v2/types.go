@@ -22,12 +22,19 @@ const (
 
 // Defines values for OnCompletion.
 const (
-	Clear    OnCompletion = "clear"
-	Closeout OnCompletion = "closeout"
-	Delete   OnCompletion = "delete"
-	Noop     OnCompletion = "noop"
-	Optin    OnCompletion = "optin"
-	Update   OnCompletion = "update"
+	OnCompletionClear    OnCompletion = "clear"
+	OnCompletionCloseout OnCompletion = "closeout"
+	OnCompletionDelete   OnCompletion = "delete"
+	OnCompletionNoop     OnCompletion = "noop"
+	OnCompletionOptin    OnCompletion = "optin"
+	OnCompletionUpdate   OnCompletion = "update"
+)
+
+// Defines values for ParticipationRegistrationStatus.
+const (
+	NotParticipating ParticipationRegistrationStatus = "NotParticipating"
+	Offline          ParticipationRegistrationStatus = "Offline"
+	Online           ParticipationRegistrationStatus = "Online"
 )
 
 // Defines values for TransactionTxType.
@@ -44,9 +51,18 @@ const (
 
 // Defines values for AddressRole.
 const (
-	AddressRoleFreezeTarget AddressRole = "freeze-target"
-	AddressRoleReceiver     AddressRole = "receiver"
-	AddressRoleSender       AddressRole = "sender"
+	AddressRoleApplicationAccount AddressRole = "application-account"
+	AddressRoleFreezeTarget       AddressRole = "freeze-target"
+	AddressRoleHeartbeat          AddressRole = "heartbeat"
+	AddressRoleInnerReceiver      AddressRole = "inner-receiver"
+	AddressRoleReceiver           AddressRole = "receiver"
+	AddressRoleSender             AddressRole = "sender"
+)
+
+// Defines values for Order.
+const (
+	OrderAsc  Order = "asc"
+	OrderDesc Order = "desc"
 )
 
 // Defines values for SigType.
@@ -78,6 +94,12 @@ const (
 	SearchForAccountsParamsExcludeNone           SearchForAccountsParamsExclude = "none"
 )
 
+// Defines values for SearchForAccountsParamsOrder.
+const (
+	SearchForAccountsParamsOrderAddress     SearchForAccountsParamsOrder = "address"
+	SearchForAccountsParamsOrderBalanceDesc SearchForAccountsParamsOrder = "balance-desc"
+)
+
 // Defines values for LookupAccountByIDParamsExclude.
 const (
 	LookupAccountByIDParamsExcludeAll            LookupAccountByIDParamsExclude = "all"
@@ -88,6 +110,12 @@ const (
 	LookupAccountByIDParamsExcludeNone           LookupAccountByIDParamsExclude = "none"
 )
 
+// Defines values for LookupAccountTransactionsParamsOrder.
+const (
+	LookupAccountTransactionsParamsOrderAsc  LookupAccountTransactionsParamsOrder = "asc"
+	LookupAccountTransactionsParamsOrderDesc LookupAccountTransactionsParamsOrder = "desc"
+)
+
 // Defines values for LookupAccountTransactionsParamsTxType.
 const (
 	LookupAccountTransactionsParamsTxTypeAcfg   LookupAccountTransactionsParamsTxType = "acfg"
@@ -112,6 +140,24 @@ const (
 	Values SearchForApplicationBoxesParamsInclude = "values"
 )
 
+// Defines values for LookupApplicationLogsByIDParamsOrder.
+const (
+	LookupApplicationLogsByIDParamsOrderAsc  LookupApplicationLogsByIDParamsOrder = "asc"
+	LookupApplicationLogsByIDParamsOrderDesc LookupApplicationLogsByIDParamsOrder = "desc"
+)
+
+// Defines values for LookupAssetBalancesParamsOrder.
+const (
+	LookupAssetBalancesParamsOrderAddress    LookupAssetBalancesParamsOrder = "address"
+	LookupAssetBalancesParamsOrderAmountDesc LookupAssetBalancesParamsOrder = "amount-desc"
+)
+
+// Defines values for LookupAssetTransactionsParamsOrder.
+const (
+	LookupAssetTransactionsParamsOrderAsc  LookupAssetTransactionsParamsOrder = "asc"
+	LookupAssetTransactionsParamsOrderDesc LookupAssetTransactionsParamsOrder = "desc"
+)
+
 // Defines values for LookupAssetTransactionsParamsTxType.
 const (
 	LookupAssetTransactionsParamsTxTypeAcfg   LookupAssetTransactionsParamsTxType = "acfg"
@@ -133,37 +179,142 @@ const (
 
 // Defines values for LookupAssetTransactionsParamsAddressRole.
 const (
-	LookupAssetTransactionsParamsAddressRoleFreezeTarget LookupAssetTransactionsParamsAddressRole = "freeze-target"
-	LookupAssetTransactionsParamsAddressRoleReceiver     LookupAssetTransactionsParamsAddressRole = "receiver"
-	LookupAssetTransactionsParamsAddressRoleSender       LookupAssetTransactionsParamsAddressRole = "sender"
+	LookupAssetTransactionsParamsAddressRoleApplicationAccount LookupAssetTransactionsParamsAddressRole = "application-account"
+	LookupAssetTransactionsParamsAddressRoleFreezeTarget       LookupAssetTransactionsParamsAddressRole = "freeze-target"
+	LookupAssetTransactionsParamsAddressRoleHeartbeat          LookupAssetTransactionsParamsAddressRole = "heartbeat"
+	LookupAssetTransactionsParamsAddressRoleInnerReceiver      LookupAssetTransactionsParamsAddressRole = "inner-receiver"
+	LookupAssetTransactionsParamsAddressRoleReceiver           LookupAssetTransactionsParamsAddressRole = "receiver"
+	LookupAssetTransactionsParamsAddressRoleSender             LookupAssetTransactionsParamsAddressRole = "sender"
+)
+
+// Defines values for SearchForBlockHeadersParamsOrder.
+const (
+	SearchForBlockHeadersParamsOrderAsc  SearchForBlockHeadersParamsOrder = "asc"
+	SearchForBlockHeadersParamsOrderDesc SearchForBlockHeadersParamsOrder = "desc"
+)
+
+// Defines values for SearchForTransactionStatsParamsInterval.
+const (
+	Day  SearchForTransactionStatsParamsInterval = "day"
+	Hour SearchForTransactionStatsParamsInterval = "hour"
+)
+
+// Defines values for SearchForTransactionsParamsOrder.
+const (
+	SearchForTransactionsParamsOrderAsc  SearchForTransactionsParamsOrder = "asc"
+	SearchForTransactionsParamsOrderDesc SearchForTransactionsParamsOrder = "desc"
 )
 
 // Defines values for SearchForTransactionsParamsTxType.
 const (
-	Acfg   SearchForTransactionsParamsTxType = "acfg"
-	Afrz   SearchForTransactionsParamsTxType = "afrz"
-	Appl   SearchForTransactionsParamsTxType = "appl"
-	Axfer  SearchForTransactionsParamsTxType = "axfer"
-	Hb     SearchForTransactionsParamsTxType = "hb"
-	Keyreg SearchForTransactionsParamsTxType = "keyreg"
-	Pay    SearchForTransactionsParamsTxType = "pay"
-	Stpf   SearchForTransactionsParamsTxType = "stpf"
+	SearchForTransactionsParamsTxTypeAcfg   SearchForTransactionsParamsTxType = "acfg"
+	SearchForTransactionsParamsTxTypeAfrz   SearchForTransactionsParamsTxType = "afrz"
+	SearchForTransactionsParamsTxTypeAppl   SearchForTransactionsParamsTxType = "appl"
+	SearchForTransactionsParamsTxTypeAxfer  SearchForTransactionsParamsTxType = "axfer"
+	SearchForTransactionsParamsTxTypeHb     SearchForTransactionsParamsTxType = "hb"
+	SearchForTransactionsParamsTxTypeKeyreg SearchForTransactionsParamsTxType = "keyreg"
+	SearchForTransactionsParamsTxTypePay    SearchForTransactionsParamsTxType = "pay"
+	SearchForTransactionsParamsTxTypeStpf   SearchForTransactionsParamsTxType = "stpf"
 )
 
 // Defines values for SearchForTransactionsParamsSigType.
 const (
-	Lsig SearchForTransactionsParamsSigType = "lsig"
-	Msig SearchForTransactionsParamsSigType = "msig"
-	Sig  SearchForTransactionsParamsSigType = "sig"
+	SearchForTransactionsParamsSigTypeLsig SearchForTransactionsParamsSigType = "lsig"
+	SearchForTransactionsParamsSigTypeMsig SearchForTransactionsParamsSigType = "msig"
+	SearchForTransactionsParamsSigTypeSig  SearchForTransactionsParamsSigType = "sig"
 )
 
 // Defines values for SearchForTransactionsParamsAddressRole.
 const (
-	FreezeTarget SearchForTransactionsParamsAddressRole = "freeze-target"
-	Receiver     SearchForTransactionsParamsAddressRole = "receiver"
-	Sender       SearchForTransactionsParamsAddressRole = "sender"
+	SearchForTransactionsParamsAddressRoleApplicationAccount SearchForTransactionsParamsAddressRole = "application-account"
+	SearchForTransactionsParamsAddressRoleFreezeTarget       SearchForTransactionsParamsAddressRole = "freeze-target"
+	SearchForTransactionsParamsAddressRoleHeartbeat          SearchForTransactionsParamsAddressRole = "heartbeat"
+	SearchForTransactionsParamsAddressRoleInnerReceiver      SearchForTransactionsParamsAddressRole = "inner-receiver"
+	SearchForTransactionsParamsAddressRoleReceiver           SearchForTransactionsParamsAddressRole = "receiver"
+	SearchForTransactionsParamsAddressRoleSender             SearchForTransactionsParamsAddressRole = "sender"
+)
+
+// Defines values for SearchForTransactionsParamsOnCompletion.
+const (
+	SearchForTransactionsParamsOnCompletionClear    SearchForTransactionsParamsOnCompletion = "clear"
+	SearchForTransactionsParamsOnCompletionCloseout SearchForTransactionsParamsOnCompletion = "closeout"
+	SearchForTransactionsParamsOnCompletionDelete   SearchForTransactionsParamsOnCompletion = "delete"
+	SearchForTransactionsParamsOnCompletionNoop     SearchForTransactionsParamsOnCompletion = "noop"
+	SearchForTransactionsParamsOnCompletionOptin    SearchForTransactionsParamsOnCompletion = "optin"
+	SearchForTransactionsParamsOnCompletionUpdate   SearchForTransactionsParamsOnCompletion = "update"
+)
+
+// Defines values for SubscribeTransactionsParamsOrder.
+const (
+	Asc  SubscribeTransactionsParamsOrder = "asc"
+	Desc SubscribeTransactionsParamsOrder = "desc"
+)
+
+// Defines values for SubscribeTransactionsParamsTxType.
+const (
+	SubscribeTransactionsParamsTxTypeAcfg   SubscribeTransactionsParamsTxType = "acfg"
+	SubscribeTransactionsParamsTxTypeAfrz   SubscribeTransactionsParamsTxType = "afrz"
+	SubscribeTransactionsParamsTxTypeAppl   SubscribeTransactionsParamsTxType = "appl"
+	SubscribeTransactionsParamsTxTypeAxfer  SubscribeTransactionsParamsTxType = "axfer"
+	SubscribeTransactionsParamsTxTypeHb     SubscribeTransactionsParamsTxType = "hb"
+	SubscribeTransactionsParamsTxTypeKeyreg SubscribeTransactionsParamsTxType = "keyreg"
+	SubscribeTransactionsParamsTxTypePay    SubscribeTransactionsParamsTxType = "pay"
+	SubscribeTransactionsParamsTxTypeStpf   SubscribeTransactionsParamsTxType = "stpf"
+)
+
+// Defines values for SubscribeTransactionsParamsSigType.
+const (
+	SubscribeTransactionsParamsSigTypeLsig SubscribeTransactionsParamsSigType = "lsig"
+	SubscribeTransactionsParamsSigTypeMsig SubscribeTransactionsParamsSigType = "msig"
+	SubscribeTransactionsParamsSigTypeSig  SubscribeTransactionsParamsSigType = "sig"
+)
+
+// Defines values for SubscribeTransactionsParamsAddressRole.
+const (
+	SubscribeTransactionsParamsAddressRoleApplicationAccount SubscribeTransactionsParamsAddressRole = "application-account"
+	SubscribeTransactionsParamsAddressRoleFreezeTarget       SubscribeTransactionsParamsAddressRole = "freeze-target"
+	SubscribeTransactionsParamsAddressRoleHeartbeat          SubscribeTransactionsParamsAddressRole = "heartbeat"
+	SubscribeTransactionsParamsAddressRoleInnerReceiver      SubscribeTransactionsParamsAddressRole = "inner-receiver"
+	SubscribeTransactionsParamsAddressRoleReceiver           SubscribeTransactionsParamsAddressRole = "receiver"
+	SubscribeTransactionsParamsAddressRoleSender             SubscribeTransactionsParamsAddressRole = "sender"
+)
+
+// Defines values for SubscribeTransactionsParamsOnCompletion.
+const (
+	Clear    SubscribeTransactionsParamsOnCompletion = "clear"
+	Closeout SubscribeTransactionsParamsOnCompletion = "closeout"
+	Delete   SubscribeTransactionsParamsOnCompletion = "delete"
+	Noop     SubscribeTransactionsParamsOnCompletion = "noop"
+	Optin    SubscribeTransactionsParamsOnCompletion = "optin"
+	Update   SubscribeTransactionsParamsOnCompletion = "update"
 )
 
+// AbiMethod An ARC-4 method call decoded from the application arguments, using the contract descriptions registered with the indexer.
+type AbiMethod struct {
+	Args []AbiMethodArg `json:"args"`
+
+	// Name Method name.
+	Name string `json:"name"`
+
+	// Return A decoded ARC-4 method argument or return value.
+	Return *AbiMethodArg `json:"return,omitempty"`
+
+	// Signature Method signature, the method selector is computed from it.
+	Signature string `json:"signature"`
+}
+
+// AbiMethodArg A decoded ARC-4 method argument or return value.
+type AbiMethodArg struct {
+	// Name Argument name.
+	Name *string `json:"name,omitempty"`
+
+	// Type ARC-4 type of the argument.
+	Type string `json:"type"`
+
+	// Value Decoded value, in the ABI JSON encoding. Reference arguments are resolved to the referenced address or id. Omitted for transaction arguments.
+	Value *interface{} `json:"value,omitempty"`
+}
+
 // Account Account information at a given round.
 //
 // Definition:
@@ -331,6 +482,41 @@ type Application struct {
 	Params ApplicationParams `json:"params"`
 }
 
+// ApplicationEvent An ARC-28 event decoded from an application log.
+type ApplicationEvent struct {
+	Args []ApplicationEventArg `json:"args"`
+
+	// IntraRoundOffset Offset into the round of the transaction which emitted the event. When it is an inner transaction, this is the offset of the inner transaction itself, which tells apart the events of the inner transactions of the same root transaction.
+	IntraRoundOffset uint64 `json:"intra-round-offset"`
+
+	// LogIndex Position of the event in the transaction logs.
+	LogIndex uint64 `json:"log-index"`
+
+	// Name Event name.
+	Name string `json:"name"`
+
+	// Round Round in which the event was emitted.
+	Round uint64 `json:"round"`
+
+	// Selector The 4-byte event selector, the prefix of the log.
+	Selector []byte `json:"selector"`
+
+	// Txid Transaction ID of the transaction which emitted the event, or of its root transaction when it is an inner transaction.
+	Txid string `json:"txid"`
+}
+
+// ApplicationEventArg A decoded ARC-28 event argument.
+type ApplicationEventArg struct {
+	// Name Argument name.
+	Name *string `json:"name,omitempty"`
+
+	// Type ABI type of the argument.
+	Type string `json:"type"`
+
+	// Value Decoded argument value, in the ABI JSON encoding. Integers are JSON numbers, addresses are strings, byte arrays are base64 strings, tuples and arrays are JSON arrays.
+	Value interface{} `json:"value"`
+}
+
 // ApplicationLocalState Stores local state associated with an application.
 type ApplicationLocalState struct {
 	// ClosedOutAtRound Round when account closed out of the application.
@@ -497,6 +683,78 @@ type AssetParams struct {
 	UrlB64 *[]byte `json:"url-b64,omitempty"`
 }
 
+// AssetSupply Supply of an asset and its distribution among holders.
+type AssetSupply struct {
+	// AssetId Asset ID.
+	AssetId uint64 `json:"asset-id"`
+
+	// CirculatingSupply Total minus the amounts held by the reserve and the excluded addresses.
+	CirculatingSupply uint64 `json:"circulating-supply"`
+
+	// Creator Address of the asset creator.
+	Creator string `json:"creator"`
+
+	// CreatorAmount Amount held by the creator.
+	CreatorAmount uint64 `json:"creator-amount"`
+
+	// Decimals Number of digits to use after the decimal point when displaying the asset.
+	Decimals uint64 `json:"decimals"`
+
+	// ExcludedAmount Amount held by the excluded addresses, other than the reserve.
+	ExcludedAmount uint64 `json:"excluded-amount"`
+
+	// FrozenAmount Amount held in frozen holdings.
+	FrozenAmount uint64 `json:"frozen-amount"`
+
+	// Reserve Address of the asset reserve, if any.
+	Reserve *string `json:"reserve,omitempty"`
+
+	// ReserveAmount Amount held by the reserve.
+	ReserveAmount uint64 `json:"reserve-amount"`
+
+	// Total Total number of units of the asset.
+	Total uint64 `json:"total"`
+}
+
+// AuthHistoryEntry Change of the authorized address of an account.
+type AuthHistoryEntry struct {
+	// CloseOut Whether the account was closed by the transaction, which resets the authorized address.
+	CloseOut bool `json:"close-out"`
+
+	// IntraRoundOffset Offset into the round of the transaction.
+	IntraRoundOffset uint64 `json:"intra-round-offset"`
+
+	// NewAuthAddress Address authorized to sign for the account after the transaction. It is the account address when the authorization was reset.
+	NewAuthAddress string `json:"new-auth-address"`
+
+	// PreviousAuthAddress Address authorized to sign for the account before the transaction. It is the account address when the account was not rekeyed.
+	PreviousAuthAddress string `json:"previous-auth-address"`
+
+	// Round Round of the transaction.
+	Round uint64 `json:"round"`
+
+	// Timestamp Block creation timestamp in seconds since epoch.
+	Timestamp uint64 `json:"timestamp"`
+
+	// Txid ID of the transaction. For an inner transaction, it is the ID of its root transaction.
+	Txid string `json:"txid"`
+}
+
+// BalanceHistoryEntry Balance of an account after a transaction.
+type BalanceHistoryEntry struct {
+	// Amount MicroAlgo balance of the account after the transaction, without pending rewards.
+	Amount uint64 `json:"amount"`
+
+	// AssetAmount Balance of the requested asset after the transaction. Only set when an asset-id is provided.
+	AssetAmount *uint64 `json:"asset-amount,omitempty"`
+
+	// Round Round of the transaction.
+	Round uint64 `json:"round"`
+
+	// Timestamp Block creation timestamp in seconds since epoch.
+	Timestamp uint64 `json:"timestamp"`
+}
+
 // Block Block information.
 //
 // Definition:
@@ -640,6 +898,18 @@ type BoxDescriptor struct {
 	Value *[]byte `json:"value,omitempty"`
 }
 
+// BoxHistoryEntry The content of a box after it changed.
+type BoxHistoryEntry struct {
+	// Deleted Whether the box was deleted.
+	Deleted bool `json:"deleted"`
+
+	// Round Round in which the box changed.
+	Round uint64 `json:"round"`
+
+	// Value \[value\] box value after the change, base64 encoded. Not set when the box was deleted.
+	Value *[]byte `json:"value,omitempty"`
+}
+
 // BoxReference BoxReference names a box by its name and the application ID it belongs to.
 type BoxReference struct {
 	// App Application ID to which the box belongs, or zero if referring to the called application.
@@ -669,6 +939,48 @@ type EvalDeltaKeyValue struct {
 	Value EvalDelta `json:"value"`
 }
 
+// FeeDistribution Distribution of the fees paid by top level transactions, inner transaction fees are pooled by their root transaction.
+type FeeDistribution struct {
+	// AboveMinFeeShare Share of the transactions paying more than the minimum fee of their round's consensus version, between 0 and 1.
+	AboveMinFeeShare float64 `json:"above-min-fee-share"`
+
+	// MaxFee Highest fee paid, in microalgos.
+	MaxFee uint64 `json:"max-fee"`
+
+	// MedianFee Median fee paid, in microalgos.
+	MedianFee uint64 `json:"median-fee"`
+
+	// MinFee Lowest fee paid, in microalgos.
+	MinFee uint64 `json:"min-fee"`
+
+	// P95Fee 95th percentile of the fees paid, in microalgos.
+	P95Fee uint64 `json:"p95-fee"`
+
+	// TxnCount Number of top level transactions.
+	TxnCount uint64 `json:"txn-count"`
+}
+
+// GlobalStateChange A change to an application global state key.
+type GlobalStateChange struct {
+	// Action Delta action. Value `1` sets bytes, value `2` sets a uint and value `3` deletes the key.
+	Action uint64 `json:"action"`
+
+	// IntraRoundOffset Offset into the round of the (possibly inner) transaction which made the change.
+	IntraRoundOffset uint64 `json:"intra-round-offset"`
+
+	// NewValue Represents a TEAL value.
+	NewValue *TealValue `json:"new-value,omitempty"`
+
+	// OldValue Represents a TEAL value.
+	OldValue *TealValue `json:"old-value,omitempty"`
+
+	// Round Round of the transaction which made the change.
+	Round uint64 `json:"round"`
+
+	// Txid Transaction ID of the transaction which made the change. For inner transactions this is the ID of the root transaction.
+	Txid string `json:"txid"`
+}
+
 // HashFactory defines model for HashFactory.
 type HashFactory struct {
 	// HashType \[t\]
@@ -786,6 +1098,39 @@ type MiniAssetHolding struct {
 // * delete
 type OnCompletion string
 
+// ParticipationRegistration Key registration transaction of an account.
+type ParticipationRegistration struct {
+	// IncentiveEligible Whether the registration paid the fee making the account eligible for block incentives.
+	IncentiveEligible bool `json:"incentive-eligible"`
+
+	// IntraRoundOffset Offset into the round of the transaction.
+	IntraRoundOffset uint64 `json:"intra-round-offset"`
+
+	// Participation AccountParticipation describes the parameters used by this account in consensus protocol.
+	Participation *AccountParticipation `json:"participation,omitempty"`
+
+	// Round Round of the transaction.
+	Round uint64 `json:"round"`
+
+	// Status Status of the account after the registration.
+	// * Online - the registration brought the account online.
+	// * Offline - the registration took the account offline.
+	// * NotParticipating - the account opted out of participation and rewards permanently.
+	Status ParticipationRegistrationStatus `json:"status"`
+
+	// Timestamp Block creation timestamp in seconds since epoch.
+	Timestamp uint64 `json:"timestamp"`
+
+	// Txid ID of the transaction. For an inner transaction, it is the ID of its root transaction.
+	Txid string `json:"txid"`
+}
+
+// ParticipationRegistrationStatus Status of the account after the registration.
+// * Online - the registration brought the account online.
+// * Offline - the registration took the account offline.
+// * NotParticipating - the account opted out of participation and rewards permanently.
+type ParticipationRegistrationStatus string
+
 // ParticipationUpdates Participation account data that needs to be checked/acted on by the network.
 type ParticipationUpdates struct {
 	// AbsentParticipationAccounts \[partupabs\] a list of online accounts that need to be suspended.
@@ -795,6 +1140,27 @@ type ParticipationUpdates struct {
 	ExpiredParticipationAccounts *[]string `json:"expired-participation-accounts,omitempty"`
 }
 
+// ProposerStats Statistics of the blocks proposed by an account.
+type ProposerStats struct {
+	// Address Proposer address.
+	Address string `json:"address"`
+
+	// BlocksProposed Number of blocks proposed.
+	BlocksProposed uint64 `json:"blocks-proposed"`
+
+	// FeesCollected Total fees collected by the proposed blocks in microalgos.
+	FeesCollected uint64 `json:"fees-collected"`
+
+	// FirstRound Round of the first block proposed.
+	FirstRound *uint64 `json:"first-round,omitempty"`
+
+	// LastRound Round of the last block proposed.
+	LastRound *uint64 `json:"last-round,omitempty"`
+
+	// TotalPayout Total proposer payout in microalgos.
+	TotalPayout uint64 `json:"total-payout"`
+}
+
 // ResourceRef ResourceRef names a single resource. Only one of the fields should be set.
 type ResourceRef struct {
 	// Address \[d\] Account whose balance record is accessible by the executing ApprovalProgram or ClearStateProgram.
@@ -818,6 +1184,27 @@ type ResourceRef struct {
 	Local *LocalsRef `json:"local,omitempty"`
 }
 
+// RoundFeeStats Fee statistics of a round.
+type RoundFeeStats struct {
+	// BlockTime Seconds since the previous block. Not set when the previous round has no fee statistics.
+	BlockTime *uint64 `json:"block-time,omitempty"`
+
+	// Fees Distribution of the fees paid by top level transactions, inner transaction fees are pooled by their root transaction.
+	Fees FeeDistribution `json:"fees"`
+
+	// FeesCollected Fees collected by the block according to its header, zero before block incentives.
+	FeesCollected uint64 `json:"fees-collected"`
+
+	// Round Round number.
+	Round uint64 `json:"round"`
+
+	// Timestamp Block creation timestamp in seconds since epoch.
+	Timestamp uint64 `json:"timestamp"`
+
+	// TotalTxns Number of transactions in the block including inner transactions, derived from the block header transaction counter. Not set when the previous round has no fee statistics.
+	TotalTxns *uint64 `json:"total-txns,omitempty"`
+}
+
 // StateDelta Application state delta.
 type StateDelta = []EvalDeltaKeyValue
 
@@ -941,6 +1328,9 @@ type TealValue struct {
 // data/transactions/signedtxn.go : SignedTxn
 // data/transactions/transaction.go : Transaction
 type Transaction struct {
+	// AbiMethod An ARC-4 method call decoded from the application arguments, using the contract descriptions registered with the indexer.
+	AbiMethod *AbiMethod `json:"abi-method,omitempty"`
+
 	// ApplicationTransaction Fields for application transactions.
 	//
 	// Definition:
@@ -1014,6 +1404,9 @@ type Transaction struct {
 	// Id Transaction ID
 	Id *string `json:"id,omitempty"`
 
+	// InnerId Identifier of an inner transaction. It is the root transaction ID followed by `/inner/` and the position of the transaction in each `inner-txns` list, starting at 0. For example `<root-txid>/inner/1/0` is the first inner transaction of the second inner transaction. It can be used to lookup the inner transaction. Not set for top level transactions.
+	InnerId *string `json:"inner-id,omitempty"`
+
 	// InnerTxns Inner transactions produced by application execution.
 	InnerTxns *[]Transaction `json:"inner-txns,omitempty"`
 
@@ -1366,6 +1759,57 @@ type TransactionStateProof struct {
 	StateProofType *uint64 `json:"state-proof-type,omitempty"`
 }
 
+// TransactionStats Transaction statistics of the rounds in a time bucket.
+type TransactionStats struct {
+	// ActiveSenders Number of distinct senders of top level transactions.
+	ActiveSenders uint64 `json:"active-senders"`
+
+	// Bucket Start of the bucket in seconds since epoch.
+	Bucket uint64 `json:"bucket"`
+
+	// InnerTxns Number of inner transactions, they are also included in the counts by type.
+	InnerTxns uint64 `json:"inner-txns"`
+
+	// MaxRound Last round in the bucket.
+	MaxRound uint64 `json:"max-round"`
+
+	// MinRound First round in the bucket.
+	MinRound uint64 `json:"min-round"`
+
+	// TotalFees Total fees in microalgos, including inner transactions.
+	TotalFees uint64 `json:"total-fees"`
+
+	// TxnCounts Number of transactions of each type.
+	TxnCounts TransactionTypeCounts `json:"txn-counts"`
+}
+
+// TransactionTypeCounts Number of transactions of each type.
+type TransactionTypeCounts struct {
+	// Acfg Number of asset configuration transactions.
+	Acfg uint64 `json:"acfg"`
+
+	// Afrz Number of asset freeze transactions.
+	Afrz uint64 `json:"afrz"`
+
+	// Appl Number of application call transactions.
+	Appl uint64 `json:"appl"`
+
+	// Axfer Number of asset transfer transactions.
+	Axfer uint64 `json:"axfer"`
+
+	// Hb Number of heartbeat transactions.
+	Hb uint64 `json:"hb"`
+
+	// Keyreg Number of key registration transactions.
+	Keyreg uint64 `json:"keyreg"`
+
+	// Pay Number of payment transactions.
+	Pay uint64 `json:"pay"`
+
+	// Stpf Number of state proof transactions.
+	Stpf uint64 `json:"stpf"`
+}
+
 // Absent defines model for absent.
 type Absent = []string
 
@@ -1411,6 +1855,12 @@ type ExcludeCloseTo = bool
 // Expired defines model for expired.
 type Expired = []string
 
+// ForeignAppId defines model for foreign-app-id.
+type ForeignAppId uint64
+
+// ForeignAssetId defines model for foreign-asset-id.
+type ForeignAssetId uint64
+
 // GroupId defines model for group-id.
 type GroupId = string
 
@@ -1426,6 +1876,9 @@ type Limit uint64
 // MaxRound defines model for max-round.
 type MaxRound uint64
 
+// MethodSelector defines model for method-selector.
+type MethodSelector = string
+
 // MinRound defines model for min-round.
 type MinRound uint64
 
@@ -1438,6 +1891,9 @@ type NotePrefix = string
 // OnlineOnly defines model for online-only.
 type OnlineOnly = bool
 
+// Order defines model for order.
+type Order string
+
 // Proposers defines model for proposers.
 type Proposers = []string
 
@@ -1485,6 +1941,19 @@ type AccountsResponse struct {
 	NextToken *string `json:"next-token,omitempty"`
 }
 
+// ApplicationEventsResponse defines model for ApplicationEventsResponse.
+type ApplicationEventsResponse struct {
+	// ApplicationId \[appidx\] application index.
+	ApplicationId uint64 `json:"application-id"`
+
+	// CurrentRound Round at which the results were computed.
+	CurrentRound uint64             `json:"current-round"`
+	Events       []ApplicationEvent `json:"events"`
+
+	// NextToken Used for pagination, when making another request provide this token with the next parameter.
+	NextToken *string `json:"next-token,omitempty"`
+}
+
 // ApplicationLocalStatesResponse defines model for ApplicationLocalStatesResponse.
 type ApplicationLocalStatesResponse struct {
 	AppsLocalStates []ApplicationLocalState `json:"apps-local-states"`
@@ -1560,6 +2029,15 @@ type AssetResponse struct {
 	CurrentRound uint64 `json:"current-round"`
 }
 
+// AssetSupplyResponse defines model for AssetSupplyResponse.
+type AssetSupplyResponse struct {
+	// CurrentRound Round at which the results were computed.
+	CurrentRound uint64 `json:"current-round"`
+
+	// Supply Supply of an asset and its distribution among holders.
+	Supply AssetSupply `json:"supply"`
+}
+
 // AssetsResponse defines model for AssetsResponse.
 type AssetsResponse struct {
 	Assets []Asset `json:"assets"`
@@ -1571,6 +2049,28 @@ type AssetsResponse struct {
 	NextToken *string `json:"next-token,omitempty"`
 }
 
+// AuthHistoryResponse defines model for AuthHistoryResponse.
+type AuthHistoryResponse struct {
+	AuthHistory []AuthHistoryEntry `json:"auth-history"`
+
+	// CurrentRound Round at which the results were computed.
+	CurrentRound uint64 `json:"current-round"`
+
+	// NextToken Used for pagination, when making another request provide this token with the next parameter.
+	NextToken *string `json:"next-token,omitempty"`
+}
+
+// BalanceHistoryResponse defines model for BalanceHistoryResponse.
+type BalanceHistoryResponse struct {
+	Balances []BalanceHistoryEntry `json:"balances"`
+
+	// CurrentRound Round at which the results were computed.
+	CurrentRound uint64 `json:"current-round"`
+
+	// NextToken Used for pagination, when making another request provide this token with the next parameter.
+	NextToken *string `json:"next-token,omitempty"`
+}
+
 // BlockHeadersResponse defines model for BlockHeadersResponse.
 type BlockHeadersResponse struct {
 	Blocks []Block `json:"blocks"`
@@ -1588,6 +2088,25 @@ type BlockHeadersResponse struct {
 // data/bookkeeping/block.go : Block
 type BlockResponse = Block
 
+// BoxHistoryResponse defines model for BoxHistoryResponse.
+type BoxHistoryResponse struct {
+	// ApplicationId \[appidx\] application index.
+	ApplicationId uint64            `json:"application-id"`
+	Changes       []BoxHistoryEntry `json:"changes"`
+
+	// CurrentRound Round at which the results were computed.
+	CurrentRound uint64 `json:"current-round"`
+
+	// Name \[name\] box name, base64 encoded
+	Name []byte `json:"name"`
+
+	// NextToken Used for pagination, when making another request provide this token with the next parameter.
+	NextToken *string `json:"next-token,omitempty"`
+
+	// StartRound First round with recorded changes. Changes before it are missing, so the value of the box before its first change after it may be unknown.
+	StartRound uint64 `json:"start-round"`
+}
+
 // BoxResponse Box name and its content.
 type BoxResponse = Box
 
@@ -1610,36 +2129,155 @@ type ErrorResponse struct {
 	Message string                  `json:"message"`
 }
 
-// HealthCheckResponse A health check response.
-type HealthCheckResponse = HealthCheck
+// FeeStatsResponse defines model for FeeStatsResponse.
+type FeeStatsResponse struct {
+	// Aggregate Distribution of the fees paid by top level transactions, inner transaction fees are pooled by their root transaction.
+	Aggregate FeeDistribution `json:"aggregate"`
 
-// TransactionResponse defines model for TransactionResponse.
-type TransactionResponse struct {
 	// CurrentRound Round at which the results were computed.
 	CurrentRound uint64 `json:"current-round"`
 
-	// Transaction Contains all fields common to all transactions and serves as an envelope to all transactions type. Represents both regular and inner transactions.
-	//
-	// Definition:
-	// data/transactions/signedtxn.go : SignedTxn
-	// data/transactions/transaction.go : Transaction
-	Transaction Transaction `json:"transaction"`
+	// NextToken Used for pagination, when making another request provide this token with the next parameter.
+	NextToken *string `json:"next-token,omitempty"`
+
+	// Rounds Statistics of the rounds of the page, newest first.
+	Rounds []RoundFeeStats `json:"rounds"`
+
+	// StartRound First round covered by the statistics, rounds before it are missing.
+	StartRound uint64 `json:"start-round"`
 }
 
-// TransactionsResponse defines model for TransactionsResponse.
-type TransactionsResponse struct {
+// GlobalStateHistoryResponse defines model for GlobalStateHistoryResponse.
+type GlobalStateHistoryResponse struct {
+	// ApplicationId \[appidx\] application index.
+	ApplicationId uint64              `json:"application-id"`
+	Changes       []GlobalStateChange `json:"changes"`
+
 	// CurrentRound Round at which the results were computed.
 	CurrentRound uint64 `json:"current-round"`
 
 	// NextToken Used for pagination, when making another request provide this token with the next parameter.
-	NextToken    *string       `json:"next-token,omitempty"`
-	Transactions []Transaction `json:"transactions"`
+	NextToken *string `json:"next-token,omitempty"`
+
+	// StartRound First round with recorded changes. Changes before it are missing, and the previous value of the first change after it may be missing too. Not set when the whole history is recorded.
+	StartRound *uint64 `json:"start-round,omitempty"`
 }
 
-// SearchForAccountsParams defines parameters for SearchForAccounts.
-type SearchForAccountsParams struct {
-	// AssetId Asset ID
-	AssetId *uint64 `form:"asset-id,omitempty" json:"asset-id,omitempty"`
+// HealthCheckResponse A health check response.
+type HealthCheckResponse = HealthCheck
+
+// ParticipationHistoryResponse defines model for ParticipationHistoryResponse.
+type ParticipationHistoryResponse struct {
+	// ActiveRegistration Key registration transaction of an account.
+	ActiveRegistration *ParticipationRegistration `json:"active-registration,omitempty"`
+
+	// CurrentRound Round at which the results were computed.
+	CurrentRound uint64 `json:"current-round"`
+
+	// NextToken Used for pagination, when making another request provide this token with the next parameter.
+	NextToken     *string                     `json:"next-token,omitempty"`
+	Registrations []ParticipationRegistration `json:"registrations"`
+}
+
+// ProposerStatsResponse defines model for ProposerStatsResponse.
+type ProposerStatsResponse struct {
+	// CurrentRound Round at which the results were computed.
+	CurrentRound uint64 `json:"current-round"`
+
+	// Stats Statistics of the blocks proposed by an account.
+	Stats ProposerStats `json:"stats"`
+}
+
+// ProposersResponse defines model for ProposersResponse.
+type ProposersResponse struct {
+	// CurrentRound Round at which the results were computed.
+	CurrentRound uint64          `json:"current-round"`
+	Proposers    []ProposerStats `json:"proposers"`
+}
+
+// RoundsAtTimeResponse defines model for RoundsAtTimeResponse.
+type RoundsAtTimeResponse struct {
+	// CurrentRound Round at which the results were computed.
+	CurrentRound uint64 `json:"current-round"`
+
+	// NextRound The first round committed after the given time. Not set if it hasn't been committed yet.
+	NextRound *uint64 `json:"next-round,omitempty"`
+
+	// NextRoundTime Timestamp of `next-round` in seconds since epoch.
+	NextRoundTime *uint64 `json:"next-round-time,omitempty"`
+
+	// Round The last round committed at or before the given time. Not set if the time is before the first round.
+	Round *uint64 `json:"round,omitempty"`
+
+	// RoundTime Timestamp of `round` in seconds since epoch.
+	RoundTime *uint64 `json:"round-time,omitempty"`
+}
+
+// TransactionGroupResponse defines model for TransactionGroupResponse.
+type TransactionGroupResponse struct {
+	// CurrentRound Round at which the results were computed.
+	CurrentRound uint64 `json:"current-round"`
+
+	// GroupId \[grp\] Group ID, base64 encoded.
+	GroupId []byte `json:"group-id"`
+
+	// Round Round in which the group was confirmed.
+	Round uint64 `json:"round"`
+
+	// RoundTime Time when the block containing the group was added to the chain, in seconds since epoch.
+	RoundTime    uint64        `json:"round-time"`
+	Transactions []Transaction `json:"transactions"`
+}
+
+// TransactionResponse defines model for TransactionResponse.
+type TransactionResponse struct {
+	// CurrentRound Round at which the results were computed.
+	CurrentRound uint64 `json:"current-round"`
+
+	// Transaction Contains all fields common to all transactions and serves as an envelope to all transactions type. Represents both regular and inner transactions.
+	//
+	// Definition:
+	// data/transactions/signedtxn.go : SignedTxn
+	// data/transactions/transaction.go : Transaction
+	Transaction Transaction `json:"transaction"`
+}
+
+// TransactionStatsResponse defines model for TransactionStatsResponse.
+type TransactionStatsResponse struct {
+	// CurrentRound Round at which the results were computed.
+	CurrentRound uint64 `json:"current-round"`
+
+	// NextToken Used for pagination, when making another request provide this token with the next parameter.
+	NextToken *string `json:"next-token,omitempty"`
+
+	// StartRound First round covered by the statistics. Rounds before it are missing, and the bucket containing it may be partial.
+	StartRound uint64             `json:"start-round"`
+	Stats      []TransactionStats `json:"stats"`
+}
+
+// TransactionsResponse defines model for TransactionsResponse.
+type TransactionsResponse struct {
+	// CurrentRound Round at which the results were computed.
+	CurrentRound uint64 `json:"current-round"`
+
+	// NextToken Used for pagination, when making another request provide this token with the next parameter.
+	NextToken    *string       `json:"next-token,omitempty"`
+	Transactions []Transaction `json:"transactions"`
+}
+
+// WaitForRoundResponse defines model for WaitForRoundResponse.
+type WaitForRoundResponse struct {
+	// CurrentRound The latest round accounted in the database.
+	CurrentRound uint64 `json:"current-round"`
+
+	// Timestamp Block creation timestamp of the current round in seconds since epoch
+	Timestamp uint64 `json:"timestamp"`
+}
+
+// SearchForAccountsParams defines parameters for SearchForAccounts.
+type SearchForAccountsParams struct {
+	// AssetId Asset ID
+	AssetId *uint64 `form:"asset-id,omitempty" json:"asset-id,omitempty"`
 
 	// Limit Maximum number of results to return. There could be additional pages even if the limit is not reached.
 	Limit *uint64 `form:"limit,omitempty" json:"limit,omitempty"`
@@ -1670,11 +2308,17 @@ type SearchForAccountsParams struct {
 
 	// OnlineOnly When this is set to true, return only accounts whose participation status is currently online.
 	OnlineOnly *bool `form:"online-only,omitempty" json:"online-only,omitempty"`
+
+	// Order Order of the results, by address by default. When ordering by balance, the largest balances excluding pending rewards come first. It cannot be used with round.
+	Order *SearchForAccountsParamsOrder `form:"order,omitempty" json:"order,omitempty"`
 }
 
 // SearchForAccountsParamsExclude defines parameters for SearchForAccounts.
 type SearchForAccountsParamsExclude string
 
+// SearchForAccountsParamsOrder defines parameters for SearchForAccounts.
+type SearchForAccountsParamsOrder string
+
 // LookupAccountByIDParams defines parameters for LookupAccountByID.
 type LookupAccountByIDParams struct {
 	// Round Include results for the specified round.
@@ -1720,6 +2364,39 @@ type LookupAccountAssetsParams struct {
 	Next *string `form:"next,omitempty" json:"next,omitempty"`
 }
 
+// LookupAccountAuthHistoryParams defines parameters for LookupAccountAuthHistory.
+type LookupAccountAuthHistoryParams struct {
+	// Limit Maximum number of results to return. There could be additional pages even if the limit is not reached.
+	Limit *uint64 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Next The next page of results. Use the next token provided by the previous results.
+	Next *string `form:"next,omitempty" json:"next,omitempty"`
+
+	// MinRound Include results at or after the specified min-round.
+	MinRound *uint64 `form:"min-round,omitempty" json:"min-round,omitempty"`
+
+	// MaxRound Include results at or before the specified max-round.
+	MaxRound *uint64 `form:"max-round,omitempty" json:"max-round,omitempty"`
+}
+
+// LookupAccountBalanceHistoryParams defines parameters for LookupAccountBalanceHistory.
+type LookupAccountBalanceHistoryParams struct {
+	// AssetId Asset ID
+	AssetId *uint64 `form:"asset-id,omitempty" json:"asset-id,omitempty"`
+
+	// Limit Maximum number of results to return. There could be additional pages even if the limit is not reached.
+	Limit *uint64 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Next The next page of results. Use the next token provided by the previous results.
+	Next *string `form:"next,omitempty" json:"next,omitempty"`
+
+	// MinRound Include results at or after the specified min-round.
+	MinRound *uint64 `form:"min-round,omitempty" json:"min-round,omitempty"`
+
+	// MaxRound Include results at or before the specified max-round.
+	MaxRound *uint64 `form:"max-round,omitempty" json:"max-round,omitempty"`
+}
+
 // LookupAccountCreatedApplicationsParams defines parameters for LookupAccountCreatedApplications.
 type LookupAccountCreatedApplicationsParams struct {
 	// ApplicationId Application ID
@@ -1750,6 +2427,24 @@ type LookupAccountCreatedAssetsParams struct {
 	Next *string `form:"next,omitempty" json:"next,omitempty"`
 }
 
+// LookupAccountParticipationHistoryParams defines parameters for LookupAccountParticipationHistory.
+type LookupAccountParticipationHistoryParams struct {
+	// Limit Maximum number of results to return. There could be additional pages even if the limit is not reached.
+	Limit *uint64 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Next The next page of results. Use the next token provided by the previous results.
+	Next *string `form:"next,omitempty" json:"next,omitempty"`
+
+	// MinRound Include results at or after the specified min-round.
+	MinRound *uint64 `form:"min-round,omitempty" json:"min-round,omitempty"`
+
+	// MaxRound Include results at or before the specified max-round.
+	MaxRound *uint64 `form:"max-round,omitempty" json:"max-round,omitempty"`
+
+	// Round Round at which to report the registration in effect, defaults to the current round.
+	Round *uint64 `form:"round,omitempty" json:"round,omitempty"`
+}
+
 // LookupAccountTransactionsParams defines parameters for LookupAccountTransactions.
 type LookupAccountTransactionsParams struct {
 	// Limit Maximum number of results to return. There could be additional pages even if the limit is not reached.
@@ -1758,6 +2453,9 @@ type LookupAccountTransactionsParams struct {
 	// Next The next page of results. Use the next token provided by the previous results.
 	Next *string `form:"next,omitempty" json:"next,omitempty"`
 
+	// Order Sort order of the results by round, asc for oldest first or desc for newest first. The default depends on the endpoint. Next tokens are only valid with the order of the request they were returned by.
+	Order *LookupAccountTransactionsParamsOrder `form:"order,omitempty" json:"order,omitempty"`
+
 	// NotePrefix Specifies a prefix which must be contained in the note field.
 	NotePrefix *string                                `form:"note-prefix,omitempty" json:"note-prefix,omitempty"`
 	TxType     *LookupAccountTransactionsParamsTxType `form:"tx-type,omitempty" json:"tx-type,omitempty"`
@@ -1799,6 +2497,9 @@ type LookupAccountTransactionsParams struct {
 	RekeyTo *bool `form:"rekey-to,omitempty" json:"rekey-to,omitempty"`
 }
 
+// LookupAccountTransactionsParamsOrder defines parameters for LookupAccountTransactions.
+type LookupAccountTransactionsParamsOrder string
+
 // LookupAccountTransactionsParamsTxType defines parameters for LookupAccountTransactions.
 type LookupAccountTransactionsParamsTxType string
 
@@ -1835,6 +2536,24 @@ type LookupApplicationBoxByIDAndNameParams struct {
 	Name string `form:"name" json:"name"`
 }
 
+// LookupApplicationBoxHistoryParams defines parameters for LookupApplicationBoxHistory.
+type LookupApplicationBoxHistoryParams struct {
+	// Name A box name in goal-arg form 'encoding:value'. For ints, use the form 'int:1234'. For raw bytes, use the form 'b64:A=='. For printable strings, use the form 'str:hello'. For addresses, use the form 'addr:XYZ...'.
+	Name string `form:"name" json:"name"`
+
+	// Limit Maximum number of results to return. There could be additional pages even if the limit is not reached.
+	Limit *uint64 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Next The next page of results. Use the next token provided by the previous results.
+	Next *string `form:"next,omitempty" json:"next,omitempty"`
+
+	// MinRound Include results at or after the specified min-round.
+	MinRound *uint64 `form:"min-round,omitempty" json:"min-round,omitempty"`
+
+	// MaxRound Include results at or before the specified max-round.
+	MaxRound *uint64 `form:"max-round,omitempty" json:"max-round,omitempty"`
+}
+
 // SearchForApplicationBoxesParams defines parameters for SearchForApplicationBoxes.
 type SearchForApplicationBoxesParams struct {
 	// Limit Maximum number of results to return. There could be additional pages even if the limit is not reached.
@@ -1850,6 +2569,42 @@ type SearchForApplicationBoxesParams struct {
 // SearchForApplicationBoxesParamsInclude defines parameters for SearchForApplicationBoxes.
 type SearchForApplicationBoxesParamsInclude string
 
+// LookupApplicationEventsParams defines parameters for LookupApplicationEvents.
+type LookupApplicationEventsParams struct {
+	// Name Only include events with this name.
+	Name *string `form:"name,omitempty" json:"name,omitempty"`
+
+	// Limit Maximum number of results to return. There could be additional pages even if the limit is not reached.
+	Limit *uint64 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Next The next page of results. Use the next token provided by the previous results.
+	Next *string `form:"next,omitempty" json:"next,omitempty"`
+
+	// MinRound Include results at or after the specified min-round.
+	MinRound *uint64 `form:"min-round,omitempty" json:"min-round,omitempty"`
+
+	// MaxRound Include results at or before the specified max-round.
+	MaxRound *uint64 `form:"max-round,omitempty" json:"max-round,omitempty"`
+}
+
+// LookupApplicationGlobalStateHistoryParams defines parameters for LookupApplicationGlobalStateHistory.
+type LookupApplicationGlobalStateHistoryParams struct {
+	// Key A global state key in goal-arg form 'encoding:value'. For ints, use the form 'int:1234'. For raw bytes, use the form 'b64:A=='. For printable strings, use the form 'str:hello'. For addresses, use the form 'addr:XYZ...'.
+	Key string `form:"key" json:"key"`
+
+	// Limit Maximum number of results to return. There could be additional pages even if the limit is not reached.
+	Limit *uint64 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Next The next page of results. Use the next token provided by the previous results.
+	Next *string `form:"next,omitempty" json:"next,omitempty"`
+
+	// MinRound Include results at or after the specified min-round.
+	MinRound *uint64 `form:"min-round,omitempty" json:"min-round,omitempty"`
+
+	// MaxRound Include results at or before the specified max-round.
+	MaxRound *uint64 `form:"max-round,omitempty" json:"max-round,omitempty"`
+}
+
 // LookupApplicationLogsByIDParams defines parameters for LookupApplicationLogsByID.
 type LookupApplicationLogsByIDParams struct {
 	// Limit Maximum number of results to return. There could be additional pages even if the limit is not reached.
@@ -1858,6 +2613,9 @@ type LookupApplicationLogsByIDParams struct {
 	// Next The next page of results. Use the next token provided by the previous results.
 	Next *string `form:"next,omitempty" json:"next,omitempty"`
 
+	// Order Sort order of the results by round, asc for oldest first or desc for newest first. The default depends on the endpoint. Next tokens are only valid with the order of the request they were returned by.
+	Order *LookupApplicationLogsByIDParamsOrder `form:"order,omitempty" json:"order,omitempty"`
+
 	// Txid Lookup the specific transaction by ID.
 	Txid *string `form:"txid,omitempty" json:"txid,omitempty"`
 
@@ -1871,6 +2629,9 @@ type LookupApplicationLogsByIDParams struct {
 	SenderAddress *string `form:"sender-address,omitempty" json:"sender-address,omitempty"`
 }
 
+// LookupApplicationLogsByIDParamsOrder defines parameters for LookupApplicationLogsByID.
+type LookupApplicationLogsByIDParamsOrder string
+
 // SearchForAssetsParams defines parameters for SearchForAssets.
 type SearchForAssetsParams struct {
 	// IncludeAll Include all items including closed accounts, deleted applications, destroyed assets, opted-out asset holdings, and closed-out application localstates.
@@ -1917,6 +2678,18 @@ type LookupAssetBalancesParams struct {
 
 	// CurrencyLessThan Results should have an amount less than this value. MicroAlgos are the default currency unless an asset-id is provided, in which case the asset will be used.
 	CurrencyLessThan *uint64 `form:"currency-less-than,omitempty" json:"currency-less-than,omitempty"`
+
+	// Order Order of the results, by address by default. When ordering by amount, the largest holdings come first.
+	Order *LookupAssetBalancesParamsOrder `form:"order,omitempty" json:"order,omitempty"`
+}
+
+// LookupAssetBalancesParamsOrder defines parameters for LookupAssetBalances.
+type LookupAssetBalancesParamsOrder string
+
+// LookupAssetSupplyParams defines parameters for LookupAssetSupply.
+type LookupAssetSupplyParams struct {
+	// ExcludeAddress Accounts whose holdings are not circulating, in addition to the reserve. This parameter accepts a comma separated list of addresses.
+	ExcludeAddress *[]string `form:"exclude-address,omitempty" json:"exclude-address,omitempty"`
 }
 
 // LookupAssetTransactionsParams defines parameters for LookupAssetTransactions.
@@ -1927,6 +2700,9 @@ type LookupAssetTransactionsParams struct {
 	// Next The next page of results. Use the next token provided by the previous results.
 	Next *string `form:"next,omitempty" json:"next,omitempty"`
 
+	// Order Sort order of the results by round, asc for oldest first or desc for newest first. The default depends on the endpoint. Next tokens are only valid with the order of the request they were returned by.
+	Order *LookupAssetTransactionsParamsOrder `form:"order,omitempty" json:"order,omitempty"`
+
 	// NotePrefix Specifies a prefix which must be contained in the note field.
 	NotePrefix *string                              `form:"note-prefix,omitempty" json:"note-prefix,omitempty"`
 	TxType     *LookupAssetTransactionsParamsTxType `form:"tx-type,omitempty" json:"tx-type,omitempty"`
@@ -1964,7 +2740,7 @@ type LookupAssetTransactionsParams struct {
 	// Address Only include transactions with this address in one of the transaction fields.
 	Address *string `form:"address,omitempty" json:"address,omitempty"`
 
-	// AddressRole Combine with the address parameter to define what type of address to search for.
+	// AddressRole Combine with the address parameter to define what type of address to search for. application-account matches the accounts array of application calls, inner-receiver matches the receiver of inner transactions and heartbeat matches the address of heartbeat transactions.
 	AddressRole *LookupAssetTransactionsParamsAddressRole `form:"address-role,omitempty" json:"address-role,omitempty"`
 
 	// ExcludeCloseTo Combine with address and address-role parameters to define what type of address to search for. The close to fields are normally treated as a receiver, if you would like to exclude them set this parameter to true.
@@ -1974,6 +2750,9 @@ type LookupAssetTransactionsParams struct {
 	RekeyTo *bool `form:"rekey-to,omitempty" json:"rekey-to,omitempty"`
 }
 
+// LookupAssetTransactionsParamsOrder defines parameters for LookupAssetTransactions.
+type LookupAssetTransactionsParamsOrder string
+
 // LookupAssetTransactionsParamsTxType defines parameters for LookupAssetTransactions.
 type LookupAssetTransactionsParamsTxType string
 
@@ -1991,6 +2770,9 @@ type SearchForBlockHeadersParams struct {
 	// Next The next page of results. Use the next token provided by the previous results.
 	Next *string `form:"next,omitempty" json:"next,omitempty"`
 
+	// Order Sort order of the results by round, asc for oldest first or desc for newest first. The default depends on the endpoint. Next tokens are only valid with the order of the request they were returned by.
+	Order *SearchForBlockHeadersParamsOrder `form:"order,omitempty" json:"order,omitempty"`
+
 	// MinRound Include results at or after the specified min-round.
 	MinRound *uint64 `form:"min-round,omitempty" json:"min-round,omitempty"`
 
@@ -2013,12 +2795,84 @@ type SearchForBlockHeadersParams struct {
 	Absent *[]string `form:"absent,omitempty" json:"absent,omitempty"`
 }
 
+// SearchForBlockHeadersParamsOrder defines parameters for SearchForBlockHeaders.
+type SearchForBlockHeadersParamsOrder string
+
 // LookupBlockParams defines parameters for LookupBlock.
 type LookupBlockParams struct {
 	// HeaderOnly Header only flag. When this is set to true, returned block does not contain the transactions
 	HeaderOnly *bool `form:"header-only,omitempty" json:"header-only,omitempty"`
 }
 
+// LookupTransactionGroupParams defines parameters for LookupTransactionGroup.
+type LookupTransactionGroupParams struct {
+	// Txid A transaction ID of the group, used to find groups which are not in the group index yet, such as groups in rounds which are still being backfilled after an upgrade.
+	Txid *string `form:"txid,omitempty" json:"txid,omitempty"`
+}
+
+// SearchForProposersParams defines parameters for SearchForProposers.
+type SearchForProposersParams struct {
+	// Limit Maximum number of results to return. There could be additional pages even if the limit is not reached.
+	Limit *uint64 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// MinRound Include results at or after the specified min-round.
+	MinRound *uint64 `form:"min-round,omitempty" json:"min-round,omitempty"`
+
+	// MaxRound Include results at or before the specified max-round.
+	MaxRound *uint64 `form:"max-round,omitempty" json:"max-round,omitempty"`
+}
+
+// LookupProposerStatsParams defines parameters for LookupProposerStats.
+type LookupProposerStatsParams struct {
+	// MinRound Include results at or after the specified min-round.
+	MinRound *uint64 `form:"min-round,omitempty" json:"min-round,omitempty"`
+
+	// MaxRound Include results at or before the specified max-round.
+	MaxRound *uint64 `form:"max-round,omitempty" json:"max-round,omitempty"`
+}
+
+// LookupRoundsAtTimeParams defines parameters for LookupRoundsAtTime.
+type LookupRoundsAtTimeParams struct {
+	// Time Time to lookup the rounds of. Must be an RFC 3339 formatted string.
+	Time time.Time `form:"time" json:"time"`
+}
+
+// SearchForFeeStatsParams defines parameters for SearchForFeeStats.
+type SearchForFeeStatsParams struct {
+	// MinRound Include results at or after the specified min-round.
+	MinRound *uint64 `form:"min-round,omitempty" json:"min-round,omitempty"`
+
+	// MaxRound Include results at or before the specified max-round.
+	MaxRound *uint64 `form:"max-round,omitempty" json:"max-round,omitempty"`
+
+	// Limit Maximum number of results to return. There could be additional pages even if the limit is not reached.
+	Limit *uint64 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Next The next page of results. Use the next token provided by the previous results.
+	Next *string `form:"next,omitempty" json:"next,omitempty"`
+}
+
+// SearchForTransactionStatsParams defines parameters for SearchForTransactionStats.
+type SearchForTransactionStatsParams struct {
+	// Interval Size of the statistics buckets, aligned on UTC.
+	Interval SearchForTransactionStatsParamsInterval `form:"interval" json:"interval"`
+
+	// MinRound Include results at or after the specified min-round.
+	MinRound *uint64 `form:"min-round,omitempty" json:"min-round,omitempty"`
+
+	// MaxRound Include results at or before the specified max-round.
+	MaxRound *uint64 `form:"max-round,omitempty" json:"max-round,omitempty"`
+
+	// Limit Maximum number of results to return. There could be additional pages even if the limit is not reached.
+	Limit *uint64 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Next The next page of results. Use the next token provided by the previous results.
+	Next *string `form:"next,omitempty" json:"next,omitempty"`
+}
+
+// SearchForTransactionStatsParamsInterval defines parameters for SearchForTransactionStats.
+type SearchForTransactionStatsParamsInterval string
+
 // SearchForTransactionsParams defines parameters for SearchForTransactions.
 type SearchForTransactionsParams struct {
 	// Limit Maximum number of results to return. There could be additional pages even if the limit is not reached.
@@ -2027,6 +2881,9 @@ type SearchForTransactionsParams struct {
 	// Next The next page of results. Use the next token provided by the previous results.
 	Next *string `form:"next,omitempty" json:"next,omitempty"`
 
+	// Order Sort order of the results by round, asc for oldest first or desc for newest first. The default depends on the endpoint. Next tokens are only valid with the order of the request they were returned by.
+	Order *SearchForTransactionsParamsOrder `form:"order,omitempty" json:"order,omitempty"`
+
 	// NotePrefix Specifies a prefix which must be contained in the note field.
 	NotePrefix *string                            `form:"note-prefix,omitempty" json:"note-prefix,omitempty"`
 	TxType     *SearchForTransactionsParamsTxType `form:"tx-type,omitempty" json:"tx-type,omitempty"`
@@ -2070,7 +2927,7 @@ type SearchForTransactionsParams struct {
 	// Address Only include transactions with this address in one of the transaction fields.
 	Address *string `form:"address,omitempty" json:"address,omitempty"`
 
-	// AddressRole Combine with the address parameter to define what type of address to search for.
+	// AddressRole Combine with the address parameter to define what type of address to search for. application-account matches the accounts array of application calls, inner-receiver matches the receiver of inner transactions and heartbeat matches the address of heartbeat transactions.
 	AddressRole *SearchForTransactionsParamsAddressRole `form:"address-role,omitempty" json:"address-role,omitempty"`
 
 	// ExcludeCloseTo Combine with address and address-role parameters to define what type of address to search for. The close to fields are normally treated as a receiver, if you would like to exclude them set this parameter to true.
@@ -2081,8 +2938,26 @@ type SearchForTransactionsParams struct {
 
 	// ApplicationId Application ID
 	ApplicationId *uint64 `form:"application-id,omitempty" json:"application-id,omitempty"`
+
+	// MethodSelector Lookup application calls by ARC-4 method selector, the first application argument. This field must be base64-encoded.
+	MethodSelector *string `form:"method-selector,omitempty" json:"method-selector,omitempty"`
+
+	// OnCompletion Lookup application calls by OnCompletion action.
+	OnCompletion *SearchForTransactionsParamsOnCompletion `form:"on-completion,omitempty" json:"on-completion,omitempty"`
+
+	// ForeignAppId Lookup application calls referencing this application in their foreign apps.
+	ForeignAppId *uint64 `form:"foreign-app-id,omitempty" json:"foreign-app-id,omitempty"`
+
+	// ForeignAssetId Lookup application calls referencing this asset in their foreign assets.
+	ForeignAssetId *uint64 `form:"foreign-asset-id,omitempty" json:"foreign-asset-id,omitempty"`
+
+	// BoxReference Lookup application calls referencing a box with this name. The box name is in goal-arg form 'encoding:value'. For ints, use the form 'int:1234'. For raw bytes, use the form 'b64:A=='. For printable strings, use the form 'str:hello'. For addresses, use the form 'addr:XYZ...'.
+	BoxReference *string `form:"box-reference,omitempty" json:"box-reference,omitempty"`
 }
 
+// SearchForTransactionsParamsOrder defines parameters for SearchForTransactions.
+type SearchForTransactionsParamsOrder string
+
 // SearchForTransactionsParamsTxType defines parameters for SearchForTransactions.
 type SearchForTransactionsParamsTxType string
 
@@ -2091,3 +2966,103 @@ type SearchForTransactionsParamsSigType string
 
 // SearchForTransactionsParamsAddressRole defines parameters for SearchForTransactions.
 type SearchForTransactionsParamsAddressRole string
+
+// SearchForTransactionsParamsOnCompletion defines parameters for SearchForTransactions.
+type SearchForTransactionsParamsOnCompletion string
+
+// SubscribeTransactionsParams defines parameters for SubscribeTransactions.
+type SubscribeTransactionsParams struct {
+	// Limit Maximum number of results to return. There could be additional pages even if the limit is not reached.
+	Limit *uint64 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Next The next page of results. Use the next token provided by the previous results.
+	Next *string `form:"next,omitempty" json:"next,omitempty"`
+
+	// Order Sort order of the results by round, asc for oldest first or desc for newest first. The default depends on the endpoint. Next tokens are only valid with the order of the request they were returned by.
+	Order *SubscribeTransactionsParamsOrder `form:"order,omitempty" json:"order,omitempty"`
+
+	// NotePrefix Specifies a prefix which must be contained in the note field.
+	NotePrefix *string                            `form:"note-prefix,omitempty" json:"note-prefix,omitempty"`
+	TxType     *SubscribeTransactionsParamsTxType `form:"tx-type,omitempty" json:"tx-type,omitempty"`
+
+	// SigType SigType filters just results using the specified type of signature:
+	// * sig - Standard
+	// * msig - MultiSig
+	// * lsig - LogicSig
+	SigType *SubscribeTransactionsParamsSigType `form:"sig-type,omitempty" json:"sig-type,omitempty"`
+
+	// GroupId Lookup transactions by group ID. This field must be base64-encoded, and afterwards, base64 characters that are URL-unsafe (i.e. =, /, +) must be URL-encoded
+	GroupId *string `form:"group-id,omitempty" json:"group-id,omitempty"`
+
+	// Txid Lookup the specific transaction by ID.
+	Txid *string `form:"txid,omitempty" json:"txid,omitempty"`
+
+	// Round Include results for the specified round.
+	Round *uint64 `form:"round,omitempty" json:"round,omitempty"`
+
+	// MinRound Include results at or after the specified min-round.
+	MinRound *uint64 `form:"min-round,omitempty" json:"min-round,omitempty"`
+
+	// MaxRound Include results at or before the specified max-round.
+	MaxRound *uint64 `form:"max-round,omitempty" json:"max-round,omitempty"`
+
+	// AssetId Asset ID
+	AssetId *uint64 `form:"asset-id,omitempty" json:"asset-id,omitempty"`
+
+	// BeforeTime Include results before the given time. Must be an RFC 3339 formatted string.
+	BeforeTime *time.Time `form:"before-time,omitempty" json:"before-time,omitempty"`
+
+	// AfterTime Include results after the given time. Must be an RFC 3339 formatted string.
+	AfterTime *time.Time `form:"after-time,omitempty" json:"after-time,omitempty"`
+
+	// CurrencyGreaterThan Results should have an amount greater than this value. MicroAlgos are the default currency unless an asset-id is provided, in which case the asset will be used.
+	CurrencyGreaterThan *uint64 `form:"currency-greater-than,omitempty" json:"currency-greater-than,omitempty"`
+
+	// CurrencyLessThan Results should have an amount less than this value. MicroAlgos are the default currency unless an asset-id is provided, in which case the asset will be used.
+	CurrencyLessThan *uint64 `form:"currency-less-than,omitempty" json:"currency-less-than,omitempty"`
+
+	// Address Only include transactions with this address in one of the transaction fields.
+	Address *string `form:"address,omitempty" json:"address,omitempty"`
+
+	// AddressRole Combine with the address parameter to define what type of address to search for. application-account matches the accounts array of application calls, inner-receiver matches the receiver of inner transactions and heartbeat matches the address of heartbeat transactions.
+	AddressRole *SubscribeTransactionsParamsAddressRole `form:"address-role,omitempty" json:"address-role,omitempty"`
+
+	// ExcludeCloseTo Combine with address and address-role parameters to define what type of address to search for. The close to fields are normally treated as a receiver, if you would like to exclude them set this parameter to true.
+	ExcludeCloseTo *bool `form:"exclude-close-to,omitempty" json:"exclude-close-to,omitempty"`
+
+	// RekeyTo Include results which include the rekey-to field.
+	RekeyTo *bool `form:"rekey-to,omitempty" json:"rekey-to,omitempty"`
+
+	// ApplicationId Application ID
+	ApplicationId *uint64 `form:"application-id,omitempty" json:"application-id,omitempty"`
+
+	// MethodSelector Lookup application calls by ARC-4 method selector, the first application argument. This field must be base64-encoded.
+	MethodSelector *string `form:"method-selector,omitempty" json:"method-selector,omitempty"`
+
+	// OnCompletion Lookup application calls by OnCompletion action.
+	OnCompletion *SubscribeTransactionsParamsOnCompletion `form:"on-completion,omitempty" json:"on-completion,omitempty"`
+
+	// ForeignAppId Lookup application calls referencing this application in their foreign apps.
+	ForeignAppId *uint64 `form:"foreign-app-id,omitempty" json:"foreign-app-id,omitempty"`
+
+	// ForeignAssetId Lookup application calls referencing this asset in their foreign assets.
+	ForeignAssetId *uint64 `form:"foreign-asset-id,omitempty" json:"foreign-asset-id,omitempty"`
+
+	// BoxReference Lookup application calls referencing a box with this name. The box name is in goal-arg form 'encoding:value'. For ints, use the form 'int:1234'. For raw bytes, use the form 'b64:A=='. For printable strings, use the form 'str:hello'. For addresses, use the form 'addr:XYZ...'.
+	BoxReference *string `form:"box-reference,omitempty" json:"box-reference,omitempty"`
+}
+
+// SubscribeTransactionsParamsOrder defines parameters for SubscribeTransactions.
+type SubscribeTransactionsParamsOrder string
+
+// SubscribeTransactionsParamsTxType defines parameters for SubscribeTransactions.
+type SubscribeTransactionsParamsTxType string
+
+// SubscribeTransactionsParamsSigType defines parameters for SubscribeTransactions.
+type SubscribeTransactionsParamsSigType string
+
+// SubscribeTransactionsParamsAddressRole defines parameters for SubscribeTransactions.
+type SubscribeTransactionsParamsAddressRole string
+
+// SubscribeTransactionsParamsOnCompletion defines parameters for SubscribeTransactions.
+type SubscribeTransactionsParamsOnCompletion string
@@ -48,6 +48,9 @@ type ServerImplementation struct {
 	disabledParams *DisabledMap
 
 	opts ExtraOptions
+
+	// rounds wakes up handlers waiting for new rounds, it may be nil.
+	rounds *roundNotifier
 }
 
 //////////////////////
@@ -82,7 +85,9 @@ func validateTransactionFilter(filter *idb.TransactionFilter) error {
 	if filter.MinRound > math.MaxInt64 || filter.MaxRound > math.MaxInt64 ||
 		(filter.Round != nil && *filter.Round > math.MaxInt64) ||
 		(filter.AssetID != nil && *filter.AssetID > math.MaxInt64) ||
-		(filter.ApplicationID != nil && *filter.ApplicationID > math.MaxInt64) {
+		(filter.ApplicationID != nil && *filter.ApplicationID > math.MaxInt64) ||
+		(filter.ForeignAppID != nil && *filter.ForeignAppID > math.MaxInt64) ||
+		(filter.ForeignAssetID != nil && *filter.ForeignAssetID > math.MaxInt64) {
 		errorArr = append(errorArr, errValueExceedingInt64)
 	}
 
@@ -423,6 +428,10 @@ func (si *ServerImplementation) SearchForAccounts(ctx echo.Context, params gener
 	if boolOrDefault(params.OnlineOnly) && boolOrDefault(params.IncludeAll) {
 		return badRequest(ctx, errOnlineOnlyDeleted)
 	}
+	orderByBalance := params.Order != nil && *params.Order == generated.SearchForAccountsParamsOrderBalanceDesc
+	if params.Round != nil && orderByBalance {
+		return badRequest(ctx, errBalanceOrderRewind)
+	}
 
 	var spendingAddrBytes []byte
 	if params.AuthAddr != nil {
@@ -445,6 +454,7 @@ func (si *ServerImplementation) SearchForAccounts(ctx echo.Context, params gener
 		IncludeDeleted:       boolOrDefault(params.IncludeAll),
 		MaxResources:         si.opts.MaxAPIResourcesPerAccount,
 		OnlineOnly:           boolOrDefault(params.OnlineOnly),
+		OrderByBalance:       orderByBalance,
 	}
 
 	if params.Exclude != nil {
@@ -468,11 +478,25 @@ func (si *ServerImplementation) SearchForAccounts(ctx echo.Context, params gener
 	}
 
 	if params.Next != nil {
-		addr, err := sdk.DecodeAddress(*params.Next)
-		if err != nil {
-			return badRequest(ctx, errUnableToParseNext)
+		if orderByBalance {
+			cursor, err := decodeAmountNext(*params.Next)
+			if err != nil {
+				return badRequest(ctx, errUnableToParseNext)
+			}
+			options.PrevMicroalgos = cursor.amount
+			options.PrevAddress = cursor.addr[:]
+		} else {
+			addr, err := sdk.DecodeAddress(*params.Next)
+			if err != nil {
+				return badRequest(ctx, errUnableToParseNext)
+			}
+			options.GreaterThanAddress = addr[:]
 		}
-		options.GreaterThanAddress = addr[:]
+	}
+
+	if format := exportFormatFromAccept(ctx.Request().Header.Get(echo.HeaderAccept)); format != exportNone {
+		options.Limit = si.exportLimit(params.Limit)
+		return si.exportAccounts(ctx, format, options, params.Round)
 	}
 
 	accounts, round, err := si.fetchAccounts(ctx.Request().Context(), options, params.Round)
@@ -486,7 +510,16 @@ func (si *ServerImplementation) SearchForAccounts(ctx echo.Context, params gener
 
 	var next *string
 	if len(accounts) > 0 {
-		next = strPtr(accounts[len(accounts)-1].Address)
+		last := accounts[len(accounts)-1]
+		if orderByBalance {
+			addr, err := sdk.DecodeAddress(last.Address)
+			if err != nil {
+				return indexerError(ctx, fmt.Errorf("%s: %w", errFailedSearchingAccount, err))
+			}
+			next = strPtr(amountCursor{amount: last.AmountWithoutPendingRewards, addr: addr}.encode())
+		} else {
+			next = strPtr(last.Address)
+		}
 	}
 
 	response := generated.AccountsResponse{
@@ -523,6 +556,7 @@ func (si *ServerImplementation) LookupAccountTransactions(ctx echo.Context, acco
 		ApplicationId:       nil,
 		Limit:               params.Limit,
 		Next:                params.Next,
+		Order:               (*generated.SearchForTransactionsParamsOrder)(params.Order),
 		NotePrefix:          params.NotePrefix,
 		TxType:              (*generated.SearchForTransactionsParamsTxType)(params.TxType),
 		SigType:             (*generated.SearchForTransactionsParamsSigType)(params.SigType),
@@ -540,6 +574,137 @@ func (si *ServerImplementation) LookupAccountTransactions(ctx echo.Context, acco
 	return si.SearchForTransactions(ctx, searchParams)
 }
 
+// LookupAccountBalanceHistory returns the balance of an account after each transaction which changed it.
+// (GET /v2/accounts/{account-id}/balance-history)
+func (si *ServerImplementation) LookupAccountBalanceHistory(ctx echo.Context, accountID string, params generated.LookupAccountBalanceHistoryParams) error {
+	if err := si.verifyHandler("LookupAccountBalanceHistory", ctx); err != nil {
+		return badRequest(ctx, err.Error())
+	}
+	if params.AssetId != nil && uint64(*params.AssetId) > math.MaxInt64 {
+		return notFound(ctx, errValueExceedingInt64)
+	}
+
+	addr, err := sdk.DecodeAddress(accountID)
+	if err != nil {
+		return badRequest(ctx, fmt.Sprintf("%s: %v", errUnableToParseAddress, err))
+	}
+
+	if params.MinRound != nil && params.MaxRound != nil && *params.MaxRound < *params.MinRound {
+		return badRequest(ctx, errInvalidRoundMinMax)
+	}
+
+	query := balanceHistoryQuery{
+		addr:     addr,
+		assetID:  params.AssetId,
+		minRound: params.MinRound,
+		maxRound: params.MaxRound,
+		limit:    min(uintOrDefaultValue(params.Limit, si.opts.DefaultTransactionsLimit), si.opts.MaxTransactionsLimit),
+	}
+	if params.Next != nil {
+		cursor, err := decodeHistoryNext(*params.Next)
+		if err != nil {
+			return badRequest(ctx, fmt.Sprintf("%s: %v", errUnableToParseNext, err))
+		}
+		query.next = &cursor
+	}
+
+	balances, next, round, err := si.fetchBalanceHistory(ctx.Request().Context(), query)
+	var rewindErr balanceHistoryRewindError
+	if errors.As(err, &rewindErr) {
+		return badRequest(ctx, err.Error())
+	}
+	if err != nil {
+		return indexerError(ctx, fmt.Errorf("%s: %w", errFailedSearchingBalanceHistory, err))
+	}
+
+	return ctx.JSON(http.StatusOK, generated.BalanceHistoryResponse{
+		CurrentRound: round,
+		NextToken:    next,
+		Balances:     balances,
+	})
+}
+
+// LookupAccountAuthHistory returns the changes of the auth address of an account.
+// (GET /v2/accounts/{account-id}/auth-history)
+func (si *ServerImplementation) LookupAccountAuthHistory(ctx echo.Context, accountID string, params generated.LookupAccountAuthHistoryParams) error {
+	if err := si.verifyHandler("LookupAccountAuthHistory", ctx); err != nil {
+		return badRequest(ctx, err.Error())
+	}
+
+	addr, err := sdk.DecodeAddress(accountID)
+	if err != nil {
+		return badRequest(ctx, fmt.Sprintf("%s: %v", errUnableToParseAddress, err))
+	}
+
+	if params.MinRound != nil && params.MaxRound != nil && *params.MaxRound < *params.MinRound {
+		return badRequest(ctx, errInvalidRoundMinMax)
+	}
+
+	query := authHistoryQuery{
+		addr:     addr,
+		minRound: params.MinRound,
+		maxRound: params.MaxRound,
+		limit:    min(uintOrDefaultValue(params.Limit, si.opts.DefaultTransactionsLimit), si.opts.MaxTransactionsLimit),
+	}
+	if params.Next != nil {
+		cursor, err := decodeHistoryNext(*params.Next)
+		if err != nil {
+			return badRequest(ctx, fmt.Sprintf("%s: %v", errUnableToParseNext, err))
+		}
+		query.next = &cursor
+	}
+
+	changes, next, round, err := si.fetchAuthHistory(ctx.Request().Context(), query)
+	if err != nil {
+		return indexerError(ctx, fmt.Errorf("%s: %w", errFailedSearchingAuthHistory, err))
+	}
+
+	return ctx.JSON(http.StatusOK, generated.AuthHistoryResponse{
+		CurrentRound: round,
+		NextToken:    next,
+		AuthHistory:  changes,
+	})
+}
+
+// LookupAccountParticipationHistory returns the key registrations of an account.
+// (GET /v2/accounts/{account-id}/participation-history)
+func (si *ServerImplementation) LookupAccountParticipationHistory(ctx echo.Context, accountID string, params generated.LookupAccountParticipationHistoryParams) error {
+	if err := si.verifyHandler("LookupAccountParticipationHistory", ctx); err != nil {
+		return badRequest(ctx, err.Error())
+	}
+
+	addr, err := sdk.DecodeAddress(accountID)
+	if err != nil {
+		return badRequest(ctx, fmt.Sprintf("%s: %v", errUnableToParseAddress, err))
+	}
+
+	if params.MinRound != nil && params.MaxRound != nil && *params.MaxRound < *params.MinRound {
+		return badRequest(ctx, errInvalidRoundMinMax)
+	}
+
+	query := participationHistoryQuery{
+		addr:     addr,
+		minRound: params.MinRound,
+		maxRound: params.MaxRound,
+		atRound:  params.Round,
+		limit:    min(uintOrDefaultValue(params.Limit, si.opts.DefaultTransactionsLimit), si.opts.MaxTransactionsLimit),
+	}
+	if params.Next != nil {
+		cursor, err := decodeHistoryNext(*params.Next)
+		if err != nil {
+			return badRequest(ctx, fmt.Sprintf("%s: %v", errUnableToParseNext, err))
+		}
+		query.next = &cursor
+	}
+
+	response, err := si.fetchParticipationHistory(ctx.Request().Context(), query)
+	if err != nil {
+		return indexerError(ctx, fmt.Errorf("%s: %w", errFailedSearchingKeyregHistory, err))
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
 // SearchForApplications returns applications for the provided parameters.
 // (GET /v2/applications)
 func (si *ServerImplementation) SearchForApplications(ctx echo.Context, params generated.SearchForApplicationsParams) error {
@@ -666,6 +831,75 @@ func (si *ServerImplementation) LookupApplicationBoxByIDAndName(ctx echo.Context
 	return ctx.JSON(http.StatusOK, generated.BoxResponse(box))
 }
 
+// LookupApplicationBoxHistory returns the changes to an application's box
+// (GET /v2/applications/{application-id}/box-history)
+func (si *ServerImplementation) LookupApplicationBoxHistory(ctx echo.Context, applicationID uint64, params generated.LookupApplicationBoxHistoryParams) error {
+	if err := si.verifyHandler("LookupApplicationBoxHistory", ctx); err != nil {
+		return badRequest(ctx, err.Error())
+	}
+	if uint64(applicationID) > math.MaxInt64 {
+		return notFound(ctx, errValueExceedingInt64)
+	}
+
+	encodedBoxName := params.Name
+	boxNameBytes, err := apps.NewAppCallBytes(encodedBoxName)
+	if err != nil {
+		return badRequest(ctx, fmt.Sprintf("LookupApplicationBoxHistory received illegal box name (%s): %s", encodedBoxName, err.Error()))
+	}
+	boxName, err := boxNameBytes.Raw()
+	if err != nil {
+		return badRequest(ctx, err.Error())
+	}
+
+	if params.MinRound != nil && params.MaxRound != nil && *params.MaxRound < *params.MinRound {
+		return badRequest(ctx, errInvalidRoundMinMax)
+	}
+
+	q := idb.AppBoxHistoryQuery{
+		ApplicationID: applicationID,
+		BoxName:       boxName,
+		MinRound:      uintOrDefault(params.MinRound),
+		MaxRound:      uintOrDefault(params.MaxRound),
+		Limit:         min(uintOrDefaultValue(params.Limit, si.opts.DefaultBoxesLimit), si.opts.MaxBoxesLimit),
+	}
+	if params.Next != nil {
+		// The next token resumes after the round it encodes.
+		nextRound, _, err := idb.DecodeTxnRowNext(*params.Next)
+		if err != nil {
+			return badRequest(ctx, fmt.Sprintf("%s: %v", errUnableToParseNext, err))
+		}
+		q.MinRound = max(q.MinRound, nextRound+1)
+	}
+
+	var start uint64
+	err = callWithTimeout(ctx.Request().Context(), si.log, si.timeout, func(ctx context.Context) error {
+		var err error
+		start, err = si.db.AppBoxHistoryStart(ctx)
+		return err
+	})
+	if errors.Is(err, idb.ErrorNotInitialized) {
+		return notFound(ctx, errBoxHistoryNotEnabled)
+	}
+	if err != nil {
+		return indexerError(ctx, fmt.Errorf("%s: %w", errFailedSearchingBoxHistory, err))
+	}
+
+	changes, next, round, err := si.fetchAppBoxHistory(ctx.Request().Context(), q)
+	if err != nil {
+		msg := fmt.Sprintf("%s: appid=%d, boxName=%s", errFailedSearchingBoxHistory, applicationID, encodedBoxName)
+		return indexerError(ctx, fmt.Errorf("%s: %w", msg, err))
+	}
+
+	return ctx.JSON(http.StatusOK, generated.BoxHistoryResponse{
+		ApplicationId: applicationID,
+		Name:          boxName,
+		CurrentRound:  round,
+		StartRound:    start,
+		NextToken:     next,
+		Changes:       changes,
+	})
+}
+
 // SearchForApplicationBoxes returns box names for an app
 // (GET /v2/applications/{application-id}/boxes)
 func (si *ServerImplementation) SearchForApplicationBoxes(ctx echo.Context, applicationID uint64, params generated.SearchForApplicationBoxesParams) error {
@@ -763,6 +997,7 @@ func (si *ServerImplementation) LookupApplicationLogsByID(ctx echo.Context, appl
 		ApplicationId: uint64Ptr(applicationID),
 		Limit:         params.Limit,
 		Next:          params.Next,
+		Order:         (*generated.SearchForTransactionsParamsOrder)(params.Order),
 		Txid:          params.Txid,
 		MinRound:      params.MinRound,
 		MaxRound:      params.MaxRound,
@@ -817,6 +1052,155 @@ func (si *ServerImplementation) LookupApplicationLogsByID(ctx echo.Context, appl
 	return ctx.JSON(http.StatusOK, response)
 }
 
+// LookupApplicationEvents returns the ARC-28 events emitted by an application
+// (GET /v2/applications/{application-id}/events)
+func (si *ServerImplementation) LookupApplicationEvents(ctx echo.Context, applicationID uint64, params generated.LookupApplicationEventsParams) error {
+	if err := si.verifyHandler("LookupApplicationEvents", ctx); err != nil {
+		return badRequest(ctx, err.Error())
+	}
+	if uint64(applicationID) > math.MaxInt64 {
+		return notFound(ctx, errValueExceedingInt64)
+	}
+
+	events := si.opts.ContractEvents.events(applicationID)
+	if len(events) == 0 {
+		return notFound(ctx, errNoContractEvents)
+	}
+	if params.Name != nil {
+		var named []contractEvent
+		for _, event := range events {
+			if event.name == *params.Name {
+				named = append(named, event)
+			}
+		}
+		if len(named) == 0 {
+			return badRequest(ctx, errUnknownEventName)
+		}
+		events = named
+	}
+
+	searchParams := generated.SearchForTransactionsParams{
+		ApplicationId: uint64Ptr(applicationID),
+		Limit:         params.Limit,
+		Next:          params.Next,
+		MinRound:      params.MinRound,
+		MaxRound:      params.MaxRound,
+	}
+
+	filter, err := si.transactionParamsToTransactionFilter(searchParams)
+	if err != nil {
+		return badRequest(ctx, err.Error())
+	}
+	// Events are emitted in the logs of the application call, which may be
+	// an inner transaction.
+	filter.SkipInnerTransactionConversion = true
+	filter.RequireApplicationLogs = true
+
+	err = validateTransactionFilter(&filter)
+	if err != nil {
+		return badRequest(ctx, err.Error())
+	}
+
+	txns, next, round, err := si.fetchEventTransactions(ctx.Request().Context(), filter)
+	if err != nil {
+		return indexerError(ctx, fmt.Errorf("%s: %w", errTransactionSearch, err))
+	}
+
+	response := generated.ApplicationEventsResponse{
+		ApplicationId: applicationID,
+		CurrentRound:  round,
+		NextToken:     strPtr(next),
+		Events:        make([]generated.ApplicationEvent, 0),
+	}
+	for _, etxn := range txns {
+		txn := etxn.txn
+		if txn.Logs == nil {
+			continue
+		}
+		for i, logBytes := range *txn.Logs {
+			for _, event := range events {
+				args, ok := event.decode(logBytes)
+				if !ok {
+					continue
+				}
+				response.Events = append(response.Events, generated.ApplicationEvent{
+					Txid:             *txn.Id,
+					Round:            *txn.ConfirmedRound,
+					IntraRoundOffset: etxn.intra,
+					LogIndex:         uint64(i),
+					Name:             event.name,
+					Selector:         event.selector,
+					Args:             args,
+				})
+				break
+			}
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// LookupApplicationGlobalStateHistory returns the changes to one application global state key
+// (GET /v2/applications/{application-id}/global-state-history)
+func (si *ServerImplementation) LookupApplicationGlobalStateHistory(ctx echo.Context, applicationID uint64, params generated.LookupApplicationGlobalStateHistoryParams) error {
+	if err := si.verifyHandler("LookupApplicationGlobalStateHistory", ctx); err != nil {
+		return badRequest(ctx, err.Error())
+	}
+	if uint64(applicationID) > math.MaxInt64 {
+		return notFound(ctx, errValueExceedingInt64)
+	}
+
+	keyBytes, err := apps.NewAppCallBytes(params.Key)
+	if err != nil {
+		return badRequest(ctx, fmt.Sprintf("LookupApplicationGlobalStateHistory received illegal key (%s): %s", params.Key, err.Error()))
+	}
+	key, err := keyBytes.Raw()
+	if err != nil {
+		return badRequest(ctx, err.Error())
+	}
+
+	if params.MinRound != nil && params.MaxRound != nil && *params.MaxRound < *params.MinRound {
+		return badRequest(ctx, errInvalidRoundMinMax)
+	}
+
+	q := idb.AppGlobalStateHistoryQuery{
+		ApplicationID: applicationID,
+		Key:           key,
+		MinRound:      uintOrDefault(params.MinRound),
+		MaxRound:      uintOrDefault(params.MaxRound),
+		Limit:         min(uintOrDefaultValue(params.Limit, si.opts.DefaultTransactionsLimit), si.opts.MaxTransactionsLimit),
+	}
+	if params.Next != nil {
+		if _, _, err := idb.DecodeTxnRowNext(*params.Next); err != nil {
+			return badRequest(ctx, fmt.Sprintf("%s: %v", errUnableToParseNext, err))
+		}
+		q.NextToken = *params.Next
+	}
+
+	changes, next, round, err := si.fetchAppGlobalStateHistory(ctx.Request().Context(), q)
+	if err != nil {
+		return indexerError(ctx, fmt.Errorf("%s: %w", errFailedSearchingStateHistory, err))
+	}
+
+	var start uint64
+	err = callWithTimeout(ctx.Request().Context(), si.log, si.timeout, func(ctx context.Context) error {
+		var err error
+		start, err = si.db.AppGlobalStateHistoryStart(ctx)
+		return err
+	})
+	if err != nil {
+		return indexerError(ctx, fmt.Errorf("%s: %w", errFailedSearchingStateHistory, err))
+	}
+
+	return ctx.JSON(http.StatusOK, generated.GlobalStateHistoryResponse{
+		ApplicationId: applicationID,
+		CurrentRound:  round,
+		StartRound:    uint64PtrOrNil(start),
+		NextToken:     next,
+		Changes:       changes,
+	})
+}
+
 // LookupAssetByID looks up a particular asset
 // (GET /v2/assets/{asset-id})
 func (si *ServerImplementation) LookupAssetByID(ctx echo.Context, assetID uint64, params generated.LookupAssetByIDParams) error {
@@ -872,14 +1256,29 @@ func (si *ServerImplementation) LookupAssetBalances(ctx echo.Context, assetID ui
 		AmountLT:       params.CurrencyLessThan,
 		IncludeDeleted: boolOrDefault(params.IncludeAll),
 		Limit:          min(uintOrDefaultValue(params.Limit, si.opts.DefaultBalancesLimit), si.opts.MaxBalancesLimit),
+		OrderByAmount:  params.Order != nil && *params.Order == generated.LookupAssetBalancesParamsOrderAmountDesc,
 	}
 
 	if params.Next != nil {
-		addr, err := sdk.DecodeAddress(*params.Next)
-		if err != nil {
-			return badRequest(ctx, errUnableToParseNext)
+		if query.OrderByAmount {
+			cursor, err := decodeAmountNext(*params.Next)
+			if err != nil {
+				return badRequest(ctx, errUnableToParseNext)
+			}
+			query.PrevAmount = cursor.amount
+			query.PrevAddress = cursor.addr[:]
+		} else {
+			addr, err := sdk.DecodeAddress(*params.Next)
+			if err != nil {
+				return badRequest(ctx, errUnableToParseNext)
+			}
+			query.PrevAddress = addr[:]
 		}
-		query.PrevAddress = addr[:]
+	}
+
+	if format := exportFormatFromAccept(ctx.Request().Header.Get(echo.HeaderAccept)); format != exportNone {
+		query.Limit = si.exportLimit(params.Limit)
+		return si.exportAssetBalances(ctx, format, query)
 	}
 
 	balances, round, err := si.fetchAssetBalances(ctx.Request().Context(), query)
@@ -889,7 +1288,16 @@ func (si *ServerImplementation) LookupAssetBalances(ctx echo.Context, assetID ui
 
 	var next *string
 	if len(balances) > 0 {
-		next = strPtr(balances[len(balances)-1].Address)
+		last := balances[len(balances)-1]
+		if query.OrderByAmount {
+			addr, err := sdk.DecodeAddress(last.Address)
+			if err != nil {
+				return indexerError(ctx, fmt.Errorf("%s: %w", errFailedSearchingAssetBalances, err))
+			}
+			next = strPtr(amountCursor{amount: last.Amount, addr: addr}.encode())
+		} else {
+			next = strPtr(last.Address)
+		}
 	}
 
 	return ctx.JSON(http.StatusOK, generated.AssetBalancesResponse{
@@ -899,6 +1307,44 @@ func (si *ServerImplementation) LookupAssetBalances(ctx echo.Context, assetID ui
 	})
 }
 
+// LookupAssetSupply looks up the supply of a particular asset
+// (GET /v2/assets/{asset-id}/supply)
+func (si *ServerImplementation) LookupAssetSupply(ctx echo.Context, assetID uint64, params generated.LookupAssetSupplyParams) error {
+	if err := si.verifyHandler("LookupAssetSupply", ctx); err != nil {
+		return badRequest(ctx, err.Error())
+	}
+	if uint64(assetID) > math.MaxInt64 {
+		return notFound(ctx, errValueExceedingInt64)
+	}
+
+	query := idb.AssetSupplyQuery{AssetID: assetID}
+	if params.ExcludeAddress != nil {
+		if uint64(len(*params.ExcludeAddress)) > si.opts.MaxAccountListSize {
+			return badRequest(ctx, fmt.Sprintf("exclude-address list too long, max size is %d", si.opts.MaxAccountListSize))
+		}
+		for _, s := range *params.ExcludeAddress {
+			addr, err := sdk.DecodeAddress(s)
+			if err != nil {
+				return badRequest(ctx, fmt.Sprintf("%s: %v", errUnableToParseAddress, err))
+			}
+			query.Exclude = append(query.Exclude, addr[:])
+		}
+	}
+
+	supply, round, err := si.fetchAssetSupply(ctx.Request().Context(), query)
+	if err != nil {
+		return indexerError(ctx, fmt.Errorf("%s: %w", errFailedSearchingAssetSupply, err))
+	}
+	if supply == nil {
+		return notFound(ctx, fmt.Sprintf("%s: %d", errNoAssetsFound, assetID))
+	}
+
+	return ctx.JSON(http.StatusOK, generated.AssetSupplyResponse{
+		CurrentRound: round,
+		Supply:       *supply,
+	})
+}
+
 // LookupAssetTransactions looks up transactions associated with a particular asset
 // (GET /v2/assets/{asset-id}/transactions)
 func (si *ServerImplementation) LookupAssetTransactions(ctx echo.Context, assetID uint64, params generated.LookupAssetTransactionsParams) error {
@@ -913,6 +1359,7 @@ func (si *ServerImplementation) LookupAssetTransactions(ctx echo.Context, assetI
 		ApplicationId:       nil,
 		Limit:               params.Limit,
 		Next:                params.Next,
+		Order:               (*generated.SearchForTransactionsParamsOrder)(params.Order),
 		NotePrefix:          params.NotePrefix,
 		TxType:              (*generated.SearchForTransactionsParamsTxType)(params.TxType),
 		SigType:             (*generated.SearchForTransactionsParamsSigType)(params.SigType),
@@ -995,14 +1442,94 @@ func (si *ServerImplementation) LookupBlock(ctx echo.Context, roundNumber uint64
 	return ctx.JSON(http.StatusOK, generated.BlockResponse(blk))
 }
 
-// LookupTransaction searches for the requested transaction ID.
-func (si *ServerImplementation) LookupTransaction(ctx echo.Context, txid string) error {
-	if err := si.verifyHandler("LookupTransaction", ctx); err != nil {
+// WaitForRound waits for the database to account a round, then returns the latest round and its timestamp.
+// (GET /v2/status/wait-for-round/{round-number})
+func (si *ServerImplementation) WaitForRound(ctx echo.Context, roundNumber uint64) error {
+	if err := si.verifyHandler("WaitForRound", ctx); err != nil {
 		return badRequest(ctx, err.Error())
 	}
+	if roundNumber > math.MaxInt64 {
+		return notFound(ctx, errValueExceedingInt64)
+	}
+
+	// Leave time to look up the block header before the handler times out.
+	wait := maxWaitForRound
+	if si.timeout != 0 && si.timeout/2 < wait {
+		wait = si.timeout / 2
+	}
+	waitCtx, cancel := context.WithTimeout(ctx.Request().Context(), wait)
+	defer cancel()
+
+	current, err := si.waitForRound(waitCtx, roundNumber)
+	if err != nil && !(errors.Is(err, context.DeadlineExceeded) && ctx.Request().Context().Err() == nil) {
+		return indexerError(ctx, fmt.Errorf("%s: %w", errWaitingForRound, err))
+	}
+
+	var header sdk.BlockHeader
+	err = callWithTimeout(ctx.Request().Context(), si.log, si.timeout, func(ctx context.Context) error {
+		var err error
+		header, _, err = si.db.GetBlock(ctx, current, idb.GetBlockOptions{})
+		return err
+	})
+	if err != nil {
+		return indexerError(ctx, fmt.Errorf("%s '%d': %w", errLookingUpBlockForRound, current, err))
+	}
+
+	return ctx.JSON(http.StatusOK, generated.WaitForRoundResponse{
+		CurrentRound: current,
+		Timestamp:    uint64(header.TimeStamp),
+	})
+}
+
+// LookupRoundsAtTime returns the last round committed at or before the time, and the first round committed after it.
+// (GET /v2/rounds/at-time)
+func (si *ServerImplementation) LookupRoundsAtTime(ctx echo.Context, params generated.LookupRoundsAtTimeParams) error {
+	if err := si.verifyHandler("LookupRoundsAtTime", ctx); err != nil {
+		return badRequest(ctx, err.Error())
+	}
+
+	var rounds idb.RoundsAtTime
+	var round uint64
+	err := callWithTimeout(ctx.Request().Context(), si.log, si.timeout, func(ctx context.Context) error {
+		var err error
+		rounds, round, err = si.db.RoundsAtTime(ctx, params.Time)
+		return err
+	})
+	if err != nil {
+		return indexerError(ctx, fmt.Errorf("%s: %w", errLookingUpRoundsAtTime, err))
+	}
+
+	response := generated.RoundsAtTimeResponse{CurrentRound: round}
+	if rounds.Before != nil {
+		response.Round = uint64Ptr(rounds.Before.Round)
+		response.RoundTime = uint64Ptr(uint64(rounds.Before.RoundTime.Unix()))
+	}
+	if rounds.After != nil {
+		response.NextRound = uint64Ptr(rounds.After.Round)
+		response.NextRoundTime = uint64Ptr(uint64(rounds.After.RoundTime.Unix()))
+	}
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// LookupTransaction searches for the requested transaction ID.
+func (si *ServerImplementation) LookupTransaction(ctx echo.Context, txid string) error {
+	if err := si.verifyHandler("LookupTransaction", ctx); err != nil {
+		return badRequest(ctx, err.Error())
+	}
+
+	// Inner transactions are found through their root transaction.
+	rootTxid := txid
+	var innerPath []int
+	if strings.Contains(txid, innerTxnIDSeparator) {
+		var err error
+		rootTxid, innerPath, err = parseInnerTxnID(txid)
+		if err != nil {
+			return badRequest(ctx, err.Error())
+		}
+	}
 
 	filter, err := si.transactionParamsToTransactionFilter(generated.SearchForTransactionsParams{
-		Txid: strPtr(txid),
+		Txid: strPtr(rootTxid),
 	})
 	if err != nil {
 		return badRequest(ctx, err.Error())
@@ -1027,14 +1554,60 @@ func (si *ServerImplementation) LookupTransaction(ctx echo.Context, txid string)
 		return indexerError(ctx, fmt.Errorf("%s: %s", errMultipleTransactions, txid))
 	}
 
+	txn, ok := innerTxnAtPath(txns[0], innerPath)
+	if !ok {
+		return notFound(ctx, fmt.Sprintf("%s: %s", errNoTransactionFound, txid))
+	}
+
 	response := generated.TransactionResponse{
 		CurrentRound: round,
-		Transaction:  txns[0],
+		Transaction:  txn,
 	}
 
 	return ctx.JSON(http.StatusOK, response)
 }
 
+// LookupTransactionGroup returns the transactions of an atomic group
+// (GET /v2/groups/{group-id})
+func (si *ServerImplementation) LookupTransactionGroup(ctx echo.Context, groupID string, params generated.LookupTransactionGroupParams) error {
+	if err := si.verifyHandler("LookupTransactionGroup", ctx); err != nil {
+		return badRequest(ctx, err.Error())
+	}
+
+	group, err := base64.StdEncoding.DecodeString(groupID)
+	if err != nil {
+		group, err = base64.URLEncoding.DecodeString(groupID)
+	}
+	if err != nil {
+		return badRequest(ctx, fmt.Sprintf("%s: 'group-id'", errUnableToParseBase64))
+	}
+	if len(group) != len(sdk.Digest{}) {
+		return badRequest(ctx, fmt.Sprintf("%s: 'group-id'", errBadGroupIDLen))
+	}
+
+	q := idb.TransactionGroupQuery{GroupID: group}
+	if params.Txid != nil {
+		q.Txid = *params.Txid
+	}
+
+	txns, round, err := si.fetchTransactionGroup(ctx.Request().Context(), q)
+	if err != nil {
+		return indexerError(ctx, fmt.Errorf("%s: %w", errTransactionSearch, err))
+	}
+
+	if len(txns) == 0 {
+		return notFound(ctx, fmt.Sprintf("%s: %s", errNoTransactionGroupFound, groupID))
+	}
+
+	return ctx.JSON(http.StatusOK, generated.TransactionGroupResponse{
+		GroupId:      group,
+		Round:        *txns[0].ConfirmedRound,
+		RoundTime:    *txns[0].RoundTime,
+		CurrentRound: round,
+		Transactions: txns,
+	})
+}
+
 // SearchForBlockHeaders returns block headers matching the provided parameters
 // (GET /v2/blocks)
 func (si *ServerImplementation) SearchForBlockHeaders(ctx echo.Context, params generated.SearchForBlockHeadersParams) error {
@@ -1068,6 +1641,309 @@ func (si *ServerImplementation) SearchForBlockHeaders(ctx echo.Context, params g
 	return ctx.JSON(http.StatusOK, response)
 }
 
+// SearchForProposers returns the accounts which proposed the most blocks.
+// (GET /v2/proposers)
+func (si *ServerImplementation) SearchForProposers(ctx echo.Context, params generated.SearchForProposersParams) error {
+	if err := si.verifyHandler("SearchForProposers", ctx); err != nil {
+		return badRequest(ctx, err.Error())
+	}
+	if params.MinRound != nil && params.MaxRound != nil && *params.MaxRound < *params.MinRound {
+		return badRequest(ctx, errInvalidRoundMinMax)
+	}
+	if params.MinRound != nil && params.MaxRound != nil && si.opts.MaxProposerStatsRounds != 0 &&
+		*params.MaxRound-*params.MinRound >= si.opts.MaxProposerStatsRounds {
+		return badRequest(ctx, fmt.Sprintf("%s %d rounds", errProposerStatsRange, si.opts.MaxProposerStatsRounds))
+	}
+
+	// Every block since incentives were enabled is aggregated without a round
+	// range, so the range is bounded by the latest rounds.
+	query := idb.ProposerStatsQuery{
+		MinRound: uintOrDefault(params.MinRound),
+		MaxRound: uintOrDefault(params.MaxRound),
+		Rounds:   si.opts.MaxProposerStatsRounds,
+		Limit:    min(uintOrDefaultValue(params.Limit, si.opts.DefaultAccountsLimit), si.opts.MaxAccountsLimit),
+	}
+	proposers, round, err := si.fetchProposerStats(ctx.Request().Context(), query)
+	if err != nil {
+		return indexerError(ctx, fmt.Errorf("%s: %w", errFailedSearchingProposers, err))
+	}
+
+	return ctx.JSON(http.StatusOK, generated.ProposersResponse{
+		CurrentRound: round,
+		Proposers:    proposers,
+	})
+}
+
+// LookupProposerStats returns statistics of the blocks proposed by an account.
+// (GET /v2/proposers/{address}/stats)
+func (si *ServerImplementation) LookupProposerStats(ctx echo.Context, address string, params generated.LookupProposerStatsParams) error {
+	if err := si.verifyHandler("LookupProposerStats", ctx); err != nil {
+		return badRequest(ctx, err.Error())
+	}
+
+	addr, err := sdk.DecodeAddress(address)
+	if err != nil {
+		return badRequest(ctx, fmt.Sprintf("%s: %v", errUnableToParseAddress, err))
+	}
+	if params.MinRound != nil && params.MaxRound != nil && *params.MaxRound < *params.MinRound {
+		return badRequest(ctx, errInvalidRoundMinMax)
+	}
+
+	query := idb.ProposerStatsQuery{
+		Proposer: &addr,
+		MinRound: uintOrDefault(params.MinRound),
+		MaxRound: uintOrDefault(params.MaxRound),
+		Limit:    1,
+	}
+	proposers, round, err := si.fetchProposerStats(ctx.Request().Context(), query)
+	if err != nil {
+		return indexerError(ctx, fmt.Errorf("%s: %w", errFailedSearchingProposers, err))
+	}
+
+	// An account which did not propose a block has empty statistics.
+	stats := generated.ProposerStats{Address: addr.String()}
+	if len(proposers) > 0 {
+		stats = proposers[0]
+	}
+	return ctx.JSON(http.StatusOK, generated.ProposerStatsResponse{
+		CurrentRound: round,
+		Stats:        stats,
+	})
+}
+
+// fetchProposerStats aggregates the blocks proposed by accounts.
+func (si *ServerImplementation) fetchProposerStats(ctx context.Context, query idb.ProposerStatsQuery) ([]generated.ProposerStats, uint64 /*round*/, error) {
+	var round uint64
+	results := make([]generated.ProposerStats, 0)
+	err := callWithTimeout(ctx, si.log, si.timeout, func(ctx context.Context) error {
+		var rows <-chan idb.ProposerStatsRow
+		rows, round = si.db.ProposerStats(ctx, query)
+
+		for row := range rows {
+			if row.Error != nil {
+				return row.Error
+			}
+			results = append(results, generated.ProposerStats{
+				Address:        row.Proposer.String(),
+				BlocksProposed: row.BlocksProposed,
+				TotalPayout:    row.TotalPayout,
+				FeesCollected:  row.FeesCollected,
+				FirstRound:     uint64Ptr(row.FirstRound),
+				LastRound:      uint64Ptr(row.LastRound),
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	return results, round, nil
+}
+
+// SearchForTransactionStats returns network-wide transaction statistics per hour or day.
+// (GET /v2/stats/transactions)
+func (si *ServerImplementation) SearchForTransactionStats(ctx echo.Context, params generated.SearchForTransactionStatsParams) error {
+	if err := si.verifyHandler("SearchForTransactionStats", ctx); err != nil {
+		return badRequest(ctx, err.Error())
+	}
+
+	interval, ok := txnStatsIntervalMap[params.Interval]
+	if !ok {
+		return badRequest(ctx, fmt.Sprintf("%s: %s", errUnknownStatsInterval, params.Interval))
+	}
+	if params.MinRound != nil && params.MaxRound != nil && *params.MaxRound < *params.MinRound {
+		return badRequest(ctx, errInvalidRoundMinMax)
+	}
+
+	query := idb.TxnStatsQuery{
+		Interval: interval,
+		MinRound: uintOrDefault(params.MinRound),
+		MaxRound: uintOrDefault(params.MaxRound),
+		Limit:    min(uintOrDefaultValue(params.Limit, si.opts.DefaultBlocksLimit), si.opts.MaxBlocksLimit),
+	}
+	if params.Next != nil {
+		after, err := decodeTxnStatsNext(*params.Next)
+		if err != nil {
+			return badRequest(ctx, fmt.Sprintf("%s: %v", errUnableToParseNext, err))
+		}
+		query.AfterBucket = after
+	}
+
+	var start uint64
+	err := callWithTimeout(ctx.Request().Context(), si.log, si.timeout, func(ctx context.Context) error {
+		var err error
+		start, err = si.db.TxnStatsStart(ctx)
+		return err
+	})
+	if errors.Is(err, idb.ErrorNotInitialized) {
+		return notFound(ctx, errTxnStatsNotEnabled)
+	}
+	if err != nil {
+		return indexerError(ctx, fmt.Errorf("%s: %w", errFailedSearchingTxnStats, err))
+	}
+
+	stats, next, round, err := si.fetchTxnStats(ctx.Request().Context(), query)
+	if err != nil {
+		return indexerError(ctx, fmt.Errorf("%s: %w", errFailedSearchingTxnStats, err))
+	}
+
+	return ctx.JSON(http.StatusOK, generated.TransactionStatsResponse{
+		CurrentRound: round,
+		StartRound:   start,
+		NextToken:    strPtr(next),
+		Stats:        stats,
+	})
+}
+
+// fetchTxnStats queries the transaction statistics buckets, and computes the next token.
+func (si *ServerImplementation) fetchTxnStats(ctx context.Context, query idb.TxnStatsQuery) ([]generated.TransactionStats, string, uint64 /*round*/, error) {
+	var round uint64
+	var next string
+	results := make([]generated.TransactionStats, 0)
+	err := callWithTimeout(ctx, si.log, si.timeout, func(ctx context.Context) error {
+		var rows <-chan idb.TxnStatsRow
+		rows, round = si.db.TxnStats(ctx, query)
+
+		var last time.Time
+		for row := range rows {
+			if row.Error != nil {
+				return row.Error
+			}
+			results = append(results, txnStatsRowToStats(row))
+			last = row.Bucket
+		}
+		if len(results) > 0 && uint64(len(results)) == query.Limit {
+			next = encodeTxnStatsNext(last)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, "", 0, err
+	}
+	return results, next, round, nil
+}
+
+// SearchForFeeStats returns the distribution of the fees paid per round and over the rounds.
+// (GET /v2/stats/fees)
+func (si *ServerImplementation) SearchForFeeStats(ctx echo.Context, params generated.SearchForFeeStatsParams) error {
+	if err := si.verifyHandler("SearchForFeeStats", ctx); err != nil {
+		return badRequest(ctx, err.Error())
+	}
+	if params.MinRound != nil && params.MaxRound != nil && *params.MaxRound < *params.MinRound {
+		return badRequest(ctx, errInvalidRoundMinMax)
+	}
+	if params.MinRound != nil && params.MaxRound != nil && si.opts.MaxBlocksLimit != 0 &&
+		*params.MaxRound-*params.MinRound >= si.opts.MaxBlocksLimit {
+		return badRequest(ctx, fmt.Sprintf("%s %d rounds", errFeeStatsRange, si.opts.MaxBlocksLimit))
+	}
+
+	// The aggregate covers the whole round range, which is bounded by the
+	// latest rounds.
+	query := idb.FeeStatsQuery{
+		MinRound: uintOrDefault(params.MinRound),
+		MaxRound: uintOrDefault(params.MaxRound),
+		Rounds:   si.opts.MaxBlocksLimit,
+		Limit:    min(uintOrDefaultValue(params.Limit, si.opts.DefaultBlocksLimit), si.opts.MaxBlocksLimit),
+	}
+	if params.Next != nil {
+		// The next token resumes before the round it encodes.
+		nextRound, _, err := idb.DecodeTxnRowNext(*params.Next)
+		if err != nil {
+			return badRequest(ctx, fmt.Sprintf("%s: %v", errUnableToParseNext, err))
+		}
+		query.BeforeRound = nextRound
+	}
+
+	var start uint64
+	err := callWithTimeout(ctx.Request().Context(), si.log, si.timeout, func(ctx context.Context) error {
+		var err error
+		start, err = si.db.FeeStatsStart(ctx)
+		return err
+	})
+	if errors.Is(err, idb.ErrorNotInitialized) {
+		return notFound(ctx, errFeeStatsNotEnabled)
+	}
+	if err != nil {
+		return indexerError(ctx, fmt.Errorf("%s: %w", errFailedSearchingFeeStats, err))
+	}
+
+	rounds, aggregate, next, round, err := si.fetchFeeStats(ctx.Request().Context(), query)
+	if err != nil {
+		return indexerError(ctx, fmt.Errorf("%s: %w", errFailedSearchingFeeStats, err))
+	}
+
+	return ctx.JSON(http.StatusOK, generated.FeeStatsResponse{
+		CurrentRound: round,
+		StartRound:   start,
+		NextToken:    strPtr(next),
+		Rounds:       rounds,
+		Aggregate:    aggregate,
+	})
+}
+
+// fetchFeeStats computes the fee distribution of each round of the page, and
+// of all the rounds of the range.
+func (si *ServerImplementation) fetchFeeStats(ctx context.Context, query idb.FeeStatsQuery) ([]generated.RoundFeeStats, generated.FeeDistribution, string, uint64 /*round*/, error) {
+	var round uint64
+	var next string
+	results := make([]generated.RoundFeeStats, 0)
+	total := makeFeeHistogram()
+	err := callWithTimeout(ctx, si.log, si.timeout, func(ctx context.Context) error {
+		var rows <-chan idb.FeeStatsRow
+		rows, round = si.db.FeeStats(ctx, query)
+
+		for row := range rows {
+			if row.Error != nil {
+				return row.Error
+			}
+			minFee, err := minTxnFee(row.Protocol)
+			if err != nil {
+				return err
+			}
+			hist := makeFeeHistogram()
+			hist.add(row.Fees, row.Counts, minFee)
+
+			stats := generated.RoundFeeStats{
+				Round:         row.Round,
+				Timestamp:     uint64(row.RoundTime.Unix()),
+				Fees:          hist.distribution(),
+				FeesCollected: row.FeesCollected,
+			}
+			if row.PrevTxnCounter != nil && row.TxnCounter >= *row.PrevTxnCounter {
+				stats.TotalTxns = uint64Ptr(row.TxnCounter - *row.PrevTxnCounter)
+			}
+			if row.PrevRoundTime != nil && !row.RoundTime.Before(*row.PrevRoundTime) {
+				stats.BlockTime = uint64Ptr(uint64(row.RoundTime.Sub(*row.PrevRoundTime).Seconds()))
+			}
+			results = append(results, stats)
+		}
+		if len(results) > 0 && uint64(len(results)) == query.Limit {
+			next = idb.EncodeTxnRowNext(results[len(results)-1].Round, math.MaxUint32)
+		}
+
+		// The range is resolved at the round of the page, so that both cover
+		// the same rounds.
+		rangeQuery := query
+		rangeQuery.MinRound, rangeQuery.MaxRound = query.RoundRange(round)
+		counts, err := si.db.FeeCounts(ctx, rangeQuery)
+		if err != nil {
+			return err
+		}
+		for _, count := range counts {
+			minFee, err := minTxnFee(count.Protocol)
+			if err != nil {
+				return err
+			}
+			total.add([]uint64{count.Fee}, []uint64{count.Count}, minFee)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, generated.FeeDistribution{}, "", 0, err
+	}
+	return results, total.distribution(), next, round, nil
+}
+
 // fetchBlockHeaders is used to query the backend for block headers, and compute the next token
 func (si *ServerImplementation) fetchBlockHeaders(ctx context.Context, bf idb.BlockHeaderFilter) ([]generated.Block, string, uint64 /*round*/, error) {
 
@@ -1130,6 +2006,11 @@ func (si *ServerImplementation) SearchForTransactions(ctx echo.Context, params g
 		return badRequest(ctx, err.Error())
 	}
 
+	if format := exportFormatFromAccept(ctx.Request().Header.Get(echo.HeaderAccept)); format != exportNone {
+		filter.Limit = si.exportLimit(params.Limit)
+		return si.exportTransactions(ctx, format, filter)
+	}
+
 	// Fetch the transactions
 	txns, next, round, err := si.fetchTransactions(ctx.Request().Context(), filter)
 	if err != nil {
@@ -1145,6 +2026,71 @@ func (si *ServerImplementation) SearchForTransactions(ctx echo.Context, params g
 	return ctx.JSON(http.StatusOK, response)
 }
 
+// SubscribeTransactions pushes transactions matching the search parameters as server-sent events.
+// (GET /v2/transactions/subscribe)
+func (si *ServerImplementation) SubscribeTransactions(ctx echo.Context, params generated.SubscribeTransactionsParams) error {
+	if err := si.verifyHandler("SubscribeTransactions", ctx); err != nil {
+		return badRequest(ctx, err.Error())
+	}
+	if (params.AssetId != nil && uint64(*params.AssetId) > math.MaxInt64) ||
+		(params.ApplicationId != nil && uint64(*params.ApplicationId) > math.MaxInt64) ||
+		(params.Round != nil && *params.Round > math.MaxInt64) {
+		return notFound(ctx, errValueExceedingInt64)
+	}
+
+	filter, err := si.transactionParamsToTransactionFilter(subscribeParamsToSearchParams(params))
+	if err != nil {
+		return badRequest(ctx, err.Error())
+	}
+
+	err = validateTransactionFilter(&filter)
+	if err != nil {
+		return badRequest(ctx, err.Error())
+	}
+	if filter.Order == idb.SortDescending {
+		return badRequest(ctx, errSubscribeDescending)
+	}
+
+	// Clients reconnecting to the stream send the id of the last event they received.
+	if lastEventID := ctx.Request().Header.Get("Last-Event-ID"); lastEventID != "" {
+		filter.NextToken = lastEventID
+	}
+	if filter.NextToken != "" {
+		if _, _, err := idb.DecodeTxnRowNext(filter.NextToken); err != nil {
+			return badRequest(ctx, fmt.Sprintf("%s: %v", errUnableToParseNext, err))
+		}
+	}
+
+	health, err := si.db.Health(ctx.Request().Context())
+	if err != nil {
+		return indexerError(ctx, fmt.Errorf("%s: %w", errTransactionSearch, err))
+	}
+
+	// The stream stays open until the client goes away, so it must not be
+	// cut off by the server write timeout.
+	err = http.NewResponseController(ctx.Response()).SetWriteDeadline(time.Time{})
+	if err != nil && !errors.Is(err, http.ErrNotSupported) {
+		return indexerError(ctx, fmt.Errorf("%s: %w", errTransactionSearch, err))
+	}
+
+	ctx.Response().Header().Set(echo.HeaderContentType, "text/event-stream")
+	ctx.Response().Header().Set(echo.HeaderCacheControl, "no-cache")
+	ctx.Response().WriteHeader(http.StatusOK)
+	ctx.Response().Flush()
+
+	send := func(event string, id string, data interface{}) error {
+		return writeServerSentEvent(ctx.Response(), event, id, data)
+	}
+	err = si.streamTransactions(ctx.Request().Context(), filter, health.Round, send)
+	if err != nil && ctx.Request().Context().Err() == nil {
+		// The status code is already written, so report the error as an event.
+		_ = send("error", "", generated.ErrorResponse{
+			Message: fmt.Sprintf("%s: %v", errTransactionSearch, err),
+		})
+	}
+	return nil
+}
+
 ///////////////////
 // Error Helpers //
 ///////////////////
@@ -1234,12 +2180,70 @@ func (si *ServerImplementation) fetchApplicationBoxes(ctx context.Context, param
 	return
 }
 
-// fetchAppLocalStates fetches all generated.AppLocalState from a query
-func (si *ServerImplementation) fetchAppLocalStates(ctx context.Context, params idb.ApplicationQuery) ([]generated.ApplicationLocalState, uint64, error) {
+// fetchAppGlobalStateHistory fetches a page of global state changes, the next
+// token is only set when the page is full.
+func (si *ServerImplementation) fetchAppGlobalStateHistory(ctx context.Context, params idb.AppGlobalStateHistoryQuery) ([]generated.GlobalStateChange, *string, uint64 /*round*/, error) {
 	var round uint64
-	als := make([]generated.ApplicationLocalState, 0)
+	var next *string
+	changes := make([]generated.GlobalStateChange, 0)
 	err := callWithTimeout(ctx, si.log, si.timeout, func(ctx context.Context) error {
-		var results <-chan idb.AppLocalStateRow
+		var results <-chan idb.AppGlobalStateHistoryRow
+		results, round = si.db.AppGlobalStateHistory(ctx, params)
+
+		var last idb.AppGlobalStateHistoryRow
+		for result := range results {
+			if result.Error != nil {
+				return result.Error
+			}
+			changes = append(changes, globalStateHistoryRowToChange(result))
+			last = result
+		}
+
+		if params.Limit != 0 && uint64(len(changes)) >= params.Limit {
+			next = strPtr(idb.EncodeTxnRowNext(last.Round, last.Intra))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	return changes, next, round, nil
+}
+
+// fetchAppBoxHistory fetches a page of box changes, the next token is only set
+// when the page is full.
+func (si *ServerImplementation) fetchAppBoxHistory(ctx context.Context, params idb.AppBoxHistoryQuery) ([]generated.BoxHistoryEntry, *string, uint64 /*round*/, error) {
+	var round uint64
+	var next *string
+	changes := make([]generated.BoxHistoryEntry, 0)
+	err := callWithTimeout(ctx, si.log, si.timeout, func(ctx context.Context) error {
+		var results <-chan idb.AppBoxHistoryRow
+		results, round = si.db.AppBoxHistory(ctx, params)
+
+		for result := range results {
+			if result.Error != nil {
+				return result.Error
+			}
+			changes = append(changes, boxHistoryRowToEntry(result))
+		}
+
+		if params.Limit != 0 && uint64(len(changes)) >= params.Limit {
+			next = strPtr(idb.EncodeTxnRowNext(changes[len(changes)-1].Round, math.MaxUint32))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	return changes, next, round, nil
+}
+
+// fetchAppLocalStates fetches all generated.AppLocalState from a query
+func (si *ServerImplementation) fetchAppLocalStates(ctx context.Context, params idb.ApplicationQuery) ([]generated.ApplicationLocalState, uint64, error) {
+	var round uint64
+	als := make([]generated.ApplicationLocalState, 0)
+	err := callWithTimeout(ctx, si.log, si.timeout, func(ctx context.Context) error {
+		var results <-chan idb.AppLocalStateRow
 		results, round = si.db.AppLocalState(ctx, params)
 
 		for result := range results {
@@ -1313,47 +2317,112 @@ func (si *ServerImplementation) fetchAssets(ctx context.Context, options idb.Ass
 	return assets, round, nil
 }
 
-// fetchAssetBalances fetches all balances from a query and converts them into
-// generated.MiniAssetHolding objects
-func (si *ServerImplementation) fetchAssetBalances(ctx context.Context, options idb.AssetBalanceQuery) ([]generated.MiniAssetHolding, uint64 /*round*/, error) {
+// fetchAssetSupply fetches the supply of an asset, it returns nil when the
+// asset does not exist.
+func (si *ServerImplementation) fetchAssetSupply(ctx context.Context, query idb.AssetSupplyQuery) (*generated.AssetSupply, uint64 /*round*/, error) {
 	var round uint64
-	balances := make([]generated.MiniAssetHolding, 0)
+	var supply *generated.AssetSupply
 	err := callWithTimeout(ctx, si.log, si.timeout, func(ctx context.Context) error {
-		var assetbalchan <-chan idb.AssetBalanceRow
-		assetbalchan, round = si.db.AssetBalances(ctx, options)
+		var rows <-chan idb.AssetSupplyRow
+		rows, round = si.db.AssetSupply(ctx, query)
 
-		for row := range assetbalchan {
+		for row := range rows {
 			if row.Error != nil {
 				return row.Error
 			}
 
-			addr := sdk.Address{}
-			if len(row.Address) != len(addr) {
+			creator := sdk.Address{}
+			if len(row.Creator) != len(creator) {
 				return fmt.Errorf(errInvalidCreatorAddress)
 			}
-			copy(addr[:], row.Address[:])
+			copy(creator[:], row.Creator[:])
 
-			bal := generated.MiniAssetHolding{
-				Address:         addr.String(),
-				Amount:          row.Amount,
-				IsFrozen:        row.Frozen,
-				OptedInAtRound:  row.CreatedRound,
-				OptedOutAtRound: row.ClosedRound,
-				Deleted:         row.Deleted,
+			// Holdings can't exceed the total, saturate anyway rather than wrap around.
+			circulating := row.Params.Total
+			for _, amount := range []uint64{row.ReserveAmount, row.ExcludedAmount} {
+				circulating -= min(amount, circulating)
 			}
 
-			balances = append(balances, bal)
+			supply = &generated.AssetSupply{
+				AssetId:           row.AssetID,
+				Total:             row.Params.Total,
+				Decimals:          uint64(row.Params.Decimals),
+				Creator:           creator.String(),
+				CreatorAmount:     row.CreatorAmount,
+				Reserve:           addrPtr(row.Params.Reserve),
+				ReserveAmount:     row.ReserveAmount,
+				FrozenAmount:      row.FrozenAmount,
+				ExcludedAmount:    row.ExcludedAmount,
+				CirculatingSupply: circulating,
+			}
 		}
-
 		return nil
 	})
 	if err != nil {
 		return nil, 0, err
 	}
+	return supply, round, nil
+}
+
+// fetchAssetBalances fetches all balances from a query and converts them into
+// generated.MiniAssetHolding objects
+func (si *ServerImplementation) fetchAssetBalances(ctx context.Context, options idb.AssetBalanceQuery) ([]generated.MiniAssetHolding, uint64 /*round*/, error) {
+	var round uint64
+	balances := make([]generated.MiniAssetHolding, 0)
+	err := callWithTimeout(ctx, si.log, si.timeout, func(ctx context.Context) error {
+		var err error
+		round, err = si.eachAssetBalance(ctx, options, func(bal generated.MiniAssetHolding) error {
+			balances = append(balances, bal)
+			return nil
+		})
+		return err
+	})
+	if err != nil {
+		return nil, 0, err
+	}
 
 	return balances, round, nil
 }
 
+// eachAssetBalance queries for asset balances and calls yield with each of
+// them, converted into generated.MiniAssetHolding objects, in order.
+func (si *ServerImplementation) eachAssetBalance(ctx context.Context, options idb.AssetBalanceQuery, yield func(bal generated.MiniAssetHolding) error) (uint64 /*round*/, error) {
+	assetbalchan, round := si.db.AssetBalances(ctx, options)
+
+	// Make sure assetbalchan is empty at the end of processing.
+	defer func() {
+		for range assetbalchan {
+		}
+	}()
+
+	for row := range assetbalchan {
+		if row.Error != nil {
+			return 0, row.Error
+		}
+
+		addr := sdk.Address{}
+		if len(row.Address) != len(addr) {
+			return 0, fmt.Errorf(errInvalidCreatorAddress)
+		}
+		copy(addr[:], row.Address[:])
+
+		bal := generated.MiniAssetHolding{
+			Address:         addr.String(),
+			Amount:          row.Amount,
+			IsFrozen:        row.Frozen,
+			OptedInAtRound:  row.CreatedRound,
+			OptedOutAtRound: row.ClosedRound,
+			Deleted:         row.Deleted,
+		}
+
+		if err := yield(bal); err != nil {
+			return 0, err
+		}
+	}
+
+	return round, nil
+}
+
 // fetchAssetHoldings fetches all balances from a query and converts them into
 // generated.AssetHolding objects
 func (si *ServerImplementation) fetchAssetHoldings(ctx context.Context, options idb.AssetBalanceQuery) ([]generated.AssetHolding, uint64 /*round*/, error) {
@@ -1494,7 +2563,7 @@ func (si *ServerImplementation) fetchBlock(ctx context.Context, round uint64, op
 
 		results := make([]generated.Transaction, 0)
 		for _, txrow := range transactions {
-			tx, err := txnRowToTransaction(txrow)
+			tx, err := txnRowToTransaction(txrow, si.opts.ContractEvents)
 			if err != nil {
 				return err
 			}
@@ -1511,88 +2580,571 @@ func (si *ServerImplementation) fetchBlock(ctx context.Context, round uint64, op
 	return ret, nil
 }
 
+// removeDeletedResources drops the resources which a rewound account no
+// longer had at the requested round.
+func removeDeletedResources(account *generated.Account) {
+	if account.Assets != nil {
+		assets := make([]generated.AssetHolding, 0, len(*account.Assets))
+		for _, holding := range *account.Assets {
+			if holding.Deleted == nil || !*holding.Deleted {
+				assets = append(assets, holding)
+			}
+		}
+		account.Assets = &assets
+	}
+	if account.CreatedAssets != nil {
+		createdAssets := make([]generated.Asset, 0, len(*account.CreatedAssets))
+		for _, asset := range *account.CreatedAssets {
+			if asset.Deleted == nil || !*asset.Deleted {
+				createdAssets = append(createdAssets, asset)
+			}
+		}
+		account.CreatedAssets = &createdAssets
+	}
+	if account.AppsLocalState != nil {
+		localStates := make([]generated.ApplicationLocalState, 0, len(*account.AppsLocalState))
+		for _, state := range *account.AppsLocalState {
+			if state.Deleted == nil || !*state.Deleted {
+				localStates = append(localStates, state)
+			}
+		}
+		account.AppsLocalState = &localStates
+	}
+	if account.CreatedApps != nil {
+		createdApps := make([]generated.Application, 0, len(*account.CreatedApps))
+		for _, app := range *account.CreatedApps {
+			if app.Deleted == nil || !*app.Deleted {
+				createdApps = append(createdApps, app)
+			}
+		}
+		account.CreatedApps = &createdApps
+	}
+}
+
 // fetchAccounts queries for accounts and converts them into generated.Account
 // objects, optionally rewinding their value back to a particular round.
 func (si *ServerImplementation) fetchAccounts(ctx context.Context, options idb.AccountQueryOptions, atRound *uint64) ([]generated.Account, uint64 /*round*/, error) {
 	var round uint64
 	accounts := make([]generated.Account, 0)
 	err := callWithTimeout(ctx, si.log, si.timeout, func(ctx context.Context) error {
-		var accountchan <-chan idb.AccountRow
-		accountchan, round = si.db.GetAccounts(ctx, options)
+		var err error
+		round, err = si.eachAccount(ctx, options, atRound, func(account generated.Account) error {
+			accounts = append(accounts, account)
+			return nil
+		})
+		return err
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	return accounts, round, nil
+}
+
+// eachAccount queries for accounts, optionally rewinding their value back to a
+// particular round, and calls yield with each of them in order.
+func (si *ServerImplementation) eachAccount(ctx context.Context, options idb.AccountQueryOptions, atRound *uint64, yield func(account generated.Account) error) (uint64 /*round*/, error) {
+	accountchan, round := si.db.GetAccounts(ctx, options)
+
+	// Make sure accountchan is empty at the end of processing.
+	defer func() {
+		for range accountchan {
+		}
+	}()
+
+	if (atRound != nil) && (*atRound > round) {
+		return 0, fmt.Errorf("%s: the requested round %d > the current round %d",
+			errRewindingAccount, *atRound, round)
+	}
+
+	for row := range accountchan {
+		if row.Error != nil {
+			return 0, row.Error
+		}
+
+		// Compute for a given round if requested.
+		var account generated.Account
+		if atRound != nil {
+			acct, err := accounting.AccountAtRound(ctx, row.Account, *atRound, si.db)
+			if err != nil {
+				// Ignore the error if this is an account search rewind error
+				_, isSpecialAccountRewindError := err.(*accounting.SpecialAccountRewindError)
+				if len(options.EqualToAddress) != 0 || !isSpecialAccountRewindError {
+					return 0, fmt.Errorf("%s: %v", errRewindingAccount, err)
+				}
+				// If we didn't return, continue to the next account
+				continue
+			}
+			if !options.IncludeDeleted {
+				removeDeletedResources(&acct)
+			}
+			account = acct
+		} else {
+			account = row.Account
+		}
+
+		// match the algod equivalent which includes pending rewards
+		account.Rewards += account.PendingRewards
+		if err := yield(account); err != nil {
+			return 0, err
+		}
+	}
+	return round, nil
+}
+
+// maxTransactionsForRewind is the maximum number of transactions unwound to
+// reach the first balance history entry of a request.
+const maxTransactionsForRewind = 100000
+
+// balanceHistoryQuery holds the parameters of a balance history request.
+type balanceHistoryQuery struct {
+	addr     sdk.Address
+	assetID  *uint64
+	minRound *uint64
+	maxRound *uint64
+	limit    uint64
+	next     *historyCursor
+}
+
+// accountBalances are the balances of an account at a point of its history.
+type accountBalances struct {
+	microAlgos  uint64
+	assetAmount uint64
+}
+
+// currentBalances returns the current balances of the account.
+func (si *ServerImplementation) currentBalances(ctx context.Context, addr sdk.Address, assetID *uint64) (accountBalances, uint64 /*round*/, error) {
+	accountchan, round := si.db.GetAccounts(ctx, idb.AccountQueryOptions{
+		EqualToAddress: addr[:],
+		IncludeDeleted: true,
+		Limit:          1,
+	})
+	var balances accountBalances
+	for row := range accountchan {
+		if row.Error != nil {
+			return accountBalances{}, 0, row.Error
+		}
+		balances.microAlgos = row.Account.AmountWithoutPendingRewards
+	}
+
+	if assetID != nil {
+		assetchan, _ := si.db.AssetBalances(ctx, idb.AssetBalanceQuery{
+			Address:        addr[:],
+			AssetID:        assetID,
+			IncludeDeleted: true,
+			Limit:          1,
+		})
+		for row := range assetchan {
+			if row.Error != nil {
+				return accountBalances{}, 0, row.Error
+			}
+			balances.assetAmount = row.Amount
+		}
+	}
+	return balances, round, nil
+}
 
-		// Make sure accountchan is empty at the end of processing.
+// fetchBalanceHistory walks the account transactions from newest to oldest,
+// starting with the current balances and unwinding each transaction to
+// compute the balance after the one before it. The transactions newer than
+// max-round or the next token are unwound too, up to maxTransactionsForRewind.
+func (si *ServerImplementation) fetchBalanceHistory(ctx context.Context, q balanceHistoryQuery) ([]generated.BalanceHistoryEntry, *string, uint64 /*round*/, error) {
+	var round uint64
+	var next *string
+	results := make([]generated.BalanceHistoryEntry, 0)
+	err := callWithTimeout(ctx, si.log, si.timeout, func(ctx context.Context) error {
+		balances, accountRound, err := si.currentBalances(ctx, q.addr, q.assetID)
+		if err != nil {
+			return err
+		}
+
+		tf := idb.TransactionFilter{
+			Address:                        q.addr[:],
+			MaxRound:                       accountRound,
+			SkipInnerTransactionConversion: true,
+		}
+		if q.minRound != nil {
+			tf.MinRound = *q.minRound
+		}
+
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		var txnchan <-chan idb.TxnRow
+		txnchan, round = si.db.Transactions(ctx, tf)
+
+		// Make sure txnchan is empty at the end of processing.
 		defer func() {
-			for range accountchan {
+			cancel()
+			for range txnchan {
 			}
 		}()
 
-		if (atRound != nil) && (*atRound > round) {
-			return fmt.Errorf("%s: the requested round %d > the current round %d",
-				errRewindingAccount, *atRound, round)
+		if round < accountRound {
+			return fmt.Errorf("transactions round %d is behind the account round %d", round, accountRound)
 		}
 
-		for row := range accountchan {
+		var cursor historyCursor
+		rewound := 0
+		for row := range txnchan {
 			if row.Error != nil {
 				return row.Error
 			}
+			if row.Txn == nil {
+				return fmt.Errorf("%s: %d:%d", errUnableToDecodeTransaction, row.Round, row.Intra)
+			}
 
-			// Compute for a given round if requested.
-			var account generated.Account
-			if atRound != nil {
-				acct, err := accounting.AccountAtRound(ctx, row.Account, *atRound, si.db)
-				if err != nil {
-					// Ignore the error if this is an account search rewind error
-					_, isSpecialAccountRewindError := err.(*accounting.SpecialAccountRewindError)
-					if len(options.EqualToAddress) != 0 || !isSpecialAccountRewindError {
-						return fmt.Errorf("%s: %v", errRewindingAccount, err)
-					}
-					// If we didn't return, continue to the next account
-					continue
+			entry := generated.BalanceHistoryEntry{
+				Round:     row.Round,
+				Timestamp: uint64(row.RoundTime.Unix()),
+				Amount:    balances.microAlgos,
+			}
+			change := accounting.TxnBalanceChange(row, q.addr)
+			changed := change.MicroAlgosIn != change.MicroAlgosOut
+			balances.microAlgos += change.MicroAlgosOut
+			balances.microAlgos -= change.MicroAlgosIn
+			if q.assetID != nil {
+				entry.AssetAmount = uint64Ptr(balances.assetAmount)
+				if change.AssetID == *q.assetID {
+					changed = changed || change.AssetIn != change.AssetOut
+					balances.assetAmount += change.AssetOut
+					balances.assetAmount -= change.AssetIn
 				}
-				account = acct
-			} else {
-				account = row.Account
 			}
 
-			// match the algod equivalent which includes pending rewards
-			account.Rewards += account.PendingRewards
-			accounts = append(accounts, account)
+			if (q.next != nil && !q.next.after(row)) || (q.maxRound != nil && row.Round > *q.maxRound) {
+				// newer than the starting point
+				rewound++
+				if rewound > maxTransactionsForRewind {
+					return balanceHistoryRewindError{}
+				}
+				continue
+			}
+			cursor = historyCursor{round: row.Round, intra: uint64(row.Intra)}
+
+			if !changed {
+				continue
+			}
+			results = append(results, entry)
+			if uint64(len(results)) >= q.limit {
+				break
+			}
+		}
+
+		if len(results) > 0 {
+			next = strPtr(cursor.encode())
 		}
 		return nil
 	})
 	if err != nil {
-		return nil, 0, err
+		return nil, nil, 0, err
 	}
-	return accounts, round, nil
+	return results, next, round, nil
 }
 
-// fetchTransactions is used to query the backend for transactions, and compute the next token
-// If returnInnerTxnOnly is false, then the root txn is returned for a inner txn match.
-func (si *ServerImplementation) fetchTransactions(ctx context.Context, filter idb.TransactionFilter) ([]generated.Transaction, string, uint64 /*round*/, error) {
+// balanceHistoryRewindError is returned when reaching the first balance
+// history entry requires unwinding more than maxTransactionsForRewind.
+type balanceHistoryRewindError struct{}
+
+func (balanceHistoryRewindError) Error() string {
+	return fmt.Sprintf("%s: the maximum is %d", errBalanceHistoryRewind, maxTransactionsForRewind)
+}
+
+// authHistoryQuery holds the parameters of an auth address history request.
+type authHistoryQuery struct {
+	addr     sdk.Address
+	minRound *uint64
+	maxRound *uint64
+	limit    uint64
+	next     *historyCursor
+}
+
+// fetchAuthHistory walks the rekeys and close-outs sent by the account from
+// newest to oldest. The auth address before a change is the one set by the
+// change preceding it, so each entry is completed by the next row.
+func (si *ServerImplementation) fetchAuthHistory(ctx context.Context, q authHistoryQuery) ([]generated.AuthHistoryEntry, *string, uint64 /*round*/, error) {
+	var round uint64
+	var next *string
+	changes := make([]generated.AuthHistoryEntry, 0)
+	err := callWithTimeout(ctx, si.log, si.timeout, func(ctx context.Context) error {
+		// The sender signs for itself when the auth address is zero.
+		authAddrString := func(authAddr sdk.Address) string {
+			if authAddr.IsZero() {
+				return q.addr.String()
+			}
+			return authAddr.String()
+		}
+
+		tf := idb.TransactionFilter{
+			Address:                        q.addr[:],
+			AddressRole:                    idb.AddressRoleSender,
+			RequireAuthAddrChange:          true,
+			SkipInnerTransactionConversion: true,
+		}
+		if q.maxRound != nil {
+			tf.MaxRound = *q.maxRound
+		}
+		if q.next != nil && (tf.MaxRound == 0 || q.next.round < tf.MaxRound) {
+			tf.MaxRound = q.next.round
+		}
+
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		var txnchan <-chan idb.TxnRow
+		txnchan, round = si.db.Transactions(ctx, tf)
+
+		// Make sure txnchan is empty at the end of processing.
+		defer func() {
+			cancel()
+			for range txnchan {
+			}
+		}()
+
+		// pending is the newer change waiting for its previous auth address.
+		var pending *generated.AuthHistoryEntry
+		var cursor historyCursor
+		complete := func(previous sdk.Address) {
+			pending.PreviousAuthAddress = authAddrString(previous)
+			// Only close-outs of a rekeyed account change the auth address.
+			if pending.CloseOut && pending.PreviousAuthAddress == pending.NewAuthAddress {
+				return
+			}
+			changes = append(changes, *pending)
+			cursor = historyCursor{round: pending.Round, intra: pending.IntraRoundOffset}
+		}
+
+		for row := range txnchan {
+			if row.Error != nil {
+				return row.Error
+			}
+			if q.next != nil && !q.next.after(row) {
+				// newer than the starting point
+				continue
+			}
+			if row.Txn == nil {
+				return fmt.Errorf("%s: %d:%d", errUnableToDecodeTransaction, row.Round, row.Intra)
+			}
+			authAddr, closed, ok := accounting.TxnAuthAddrChange(row.Txn)
+			if !ok {
+				continue
+			}
+
+			if pending != nil {
+				complete(authAddr)
+				pending = nil
+				if uint64(len(changes)) >= q.limit {
+					break
+				}
+			}
+			if q.minRound != nil && row.Round < *q.minRound {
+				// only needed to complete the last change
+				break
+			}
+
+			pending = &generated.AuthHistoryEntry{
+				Round:            row.Round,
+				Timestamp:        uint64(row.RoundTime.Unix()),
+				IntraRoundOffset: uint64(row.Intra),
+				Txid:             txnRowTxid(row),
+				NewAuthAddress:   authAddrString(authAddr),
+				CloseOut:         closed,
+			}
+		}
+		if pending != nil {
+			// The oldest change, the account signed for itself before it.
+			complete(sdk.Address{})
+		}
+
+		if len(changes) > 0 {
+			next = strPtr(cursor.encode())
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	return changes, next, round, nil
+}
+
+// participationHistoryQuery holds the parameters of a participation history request.
+type participationHistoryQuery struct {
+	addr     sdk.Address
+	minRound *uint64
+	maxRound *uint64
+	atRound  *uint64
+	limit    uint64
+	next     *historyCursor
+}
+
+// scanTransactions calls fn for each transaction row until it returns false,
+// and returns the round of the results.
+func (si *ServerImplementation) scanTransactions(ctx context.Context, tf idb.TransactionFilter, fn func(row idb.TxnRow) (bool, error)) (uint64, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	txnchan, round := si.db.Transactions(ctx, tf)
+
+	// Make sure txnchan is empty at the end of processing.
+	defer func() {
+		cancel()
+		for range txnchan {
+		}
+	}()
+
+	for row := range txnchan {
+		if row.Error != nil {
+			return 0, row.Error
+		}
+		if row.Txn == nil {
+			return 0, fmt.Errorf("%s: %d:%d", errUnableToDecodeTransaction, row.Round, row.Intra)
+		}
+		more, err := fn(row)
+		if err != nil || !more {
+			return round, err
+		}
+	}
+	return round, nil
+}
+
+// fetchParticipationHistory lists the keyreg transactions sent by the
+// account from newest to oldest, and finds the registration in effect at the
+// requested round.
+func (si *ServerImplementation) fetchParticipationHistory(ctx context.Context, q participationHistoryQuery) (generated.ParticipationHistoryResponse, error) {
+	response := generated.ParticipationHistoryResponse{
+		Registrations: make([]generated.ParticipationRegistration, 0),
+	}
+	err := callWithTimeout(ctx, si.log, si.timeout, func(ctx context.Context) error {
+		tf := idb.TransactionFilter{
+			Address:                        q.addr[:],
+			AddressRole:                    idb.AddressRoleSender,
+			TypeEnum:                       idb.TypeEnumKeyreg,
+			SkipInnerTransactionConversion: true,
+		}
+		if q.minRound != nil {
+			tf.MinRound = *q.minRound
+		}
+		if q.maxRound != nil {
+			tf.MaxRound = *q.maxRound
+		}
+		if q.next != nil && (tf.MaxRound == 0 || q.next.round < tf.MaxRound) {
+			tf.MaxRound = q.next.round
+		}
+
+		var cursor historyCursor
+		var err error
+		response.CurrentRound, err = si.scanTransactions(ctx, tf, func(row idb.TxnRow) (bool, error) {
+			if q.next != nil && !q.next.after(row) {
+				// newer than the starting point
+				return true, nil
+			}
+			response.Registrations = append(response.Registrations, txnRowToRegistration(row))
+			cursor = historyCursor{round: row.Round, intra: uint64(row.Intra)}
+			return uint64(len(response.Registrations)) < q.limit, nil
+		})
+		if err != nil {
+			return err
+		}
+		if len(response.Registrations) > 0 {
+			response.NextToken = strPtr(cursor.encode())
+		}
+
+		atRound := response.CurrentRound
+		if q.atRound != nil && *q.atRound < atRound {
+			atRound = *q.atRound
+		}
+		response.ActiveRegistration, err = si.activeRegistration(ctx, q.addr, atRound)
+		return err
+	})
+	if err != nil {
+		return generated.ParticipationHistoryResponse{}, err
+	}
+	return response, nil
+}
+
+// activeRegistration returns the most recent keyreg at or before the round,
+// unless the account was closed after it.
+func (si *ServerImplementation) activeRegistration(ctx context.Context, addr sdk.Address, round uint64) (*generated.ParticipationRegistration, error) {
+	var keyreg *idb.TxnRow
+	tf := idb.TransactionFilter{
+		Address:                        addr[:],
+		AddressRole:                    idb.AddressRoleSender,
+		TypeEnum:                       idb.TypeEnumKeyreg,
+		MaxRound:                       round,
+		SkipInnerTransactionConversion: true,
+		Limit:                          1,
+	}
+	_, err := si.scanTransactions(ctx, tf, func(row idb.TxnRow) (bool, error) {
+		keyreg = &row
+		return false, nil
+	})
+	if err != nil || keyreg == nil {
+		return nil, err
+	}
+
+	keyregPos := historyCursor{round: keyreg.Round, intra: uint64(keyreg.Intra)}
+	closed := false
+	tf = idb.TransactionFilter{
+		Address:                        addr[:],
+		AddressRole:                    idb.AddressRoleSender,
+		TypeEnum:                       idb.TypeEnumPay,
+		RequireAuthAddrChange:          true,
+		MinRound:                       keyreg.Round,
+		MaxRound:                       round,
+		SkipInnerTransactionConversion: true,
+	}
+	_, err = si.scanTransactions(ctx, tf, func(row idb.TxnRow) (bool, error) {
+		if keyregPos.after(row) {
+			// before the keyreg, newest first so there is nothing left
+			return false, nil
+		}
+		closed = !row.Txn.Txn.CloseRemainderTo.IsZero()
+		return !closed, nil
+	})
+	if err != nil || closed {
+		return nil, err
+	}
+
+	registration := txnRowToRegistration(*keyreg)
+	return &registration, nil
+}
+
+// fetchTransactionGroup fetches the root transactions of a group, the inner
+// transactions are part of their root transaction.
+func (si *ServerImplementation) fetchTransactionGroup(ctx context.Context, q idb.TransactionGroupQuery) ([]generated.Transaction, uint64 /*round*/, error) {
 	var round uint64
-	var nextToken string
 	results := make([]generated.Transaction, 0)
 	err := callWithTimeout(ctx, si.log, si.timeout, func(ctx context.Context) error {
 		var txchan <-chan idb.TxnRow
-		txchan, round = si.db.Transactions(ctx, filter)
+		txchan, round = si.db.TransactionGroup(ctx, q)
 
-		rootTxnDedupeMap := make(map[string]struct{})
-		var lastTxrow idb.TxnRow
 		for txrow := range txchan {
-			tx, err := txnRowToTransaction(txrow)
+			tx, err := txnRowToTransaction(txrow, si.opts.ContractEvents)
 			if err != nil {
 				return err
 			}
+			results = append(results, tx)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	return results, round, nil
+}
 
-			// The root txn only needs to be added once, so remove duplicates unless
-			// we are including inner transactions (which use the root txid).
-			if _, ok := rootTxnDedupeMap[*tx.Id]; ok && !filter.SkipInnerTransactionConversion {
-				continue
-			}
-
-			rootTxnDedupeMap[*tx.Id] = struct{}{}
+// fetchTransactions is used to query the backend for transactions, and compute the next token
+// If returnInnerTxnOnly is false, then the root txn is returned for a inner txn match.
+func (si *ServerImplementation) fetchTransactions(ctx context.Context, filter idb.TransactionFilter) ([]generated.Transaction, string, uint64 /*round*/, error) {
+	var round uint64
+	var nextToken string
+	results := make([]generated.Transaction, 0)
+	err := callWithTimeout(ctx, si.log, si.timeout, func(ctx context.Context) error {
+		var lastTxrow idb.TxnRow
+		var err error
+		round, err = si.eachTransaction(ctx, filter, func(txrow idb.TxnRow, tx generated.Transaction) error {
 			results = append(results, tx)
 			lastTxrow = txrow
+			return nil
+		})
+		if err != nil {
+			return err
 		}
 
 		// No next token if there were no results.
@@ -1600,9 +3152,48 @@ func (si *ServerImplementation) fetchTransactions(ctx context.Context, filter id
 			return nil
 		}
 
-		// The sort order depends on whether the address filter is used.
+		nextToken, err = lastTxrow.Next(!filter.Descending())
+
+		return err
+	})
+	if err != nil {
+		return nil, "", 0, err
+	}
+
+	return results, nextToken, round, nil
+}
+
+// eventTransaction is a transaction which may have emitted events, along with
+// the intra round offset of its own row. The transaction of an inner row has
+// the offset of its root transaction.
+type eventTransaction struct {
+	intra uint64
+	txn   generated.Transaction
+}
+
+// fetchEventTransactions is like fetchTransactions, but keeps the intra round
+// offset of each row.
+func (si *ServerImplementation) fetchEventTransactions(ctx context.Context, filter idb.TransactionFilter) ([]eventTransaction, string, uint64 /*round*/, error) {
+	var round uint64
+	var nextToken string
+	results := make([]eventTransaction, 0)
+	err := callWithTimeout(ctx, si.log, si.timeout, func(ctx context.Context) error {
+		var lastTxrow idb.TxnRow
 		var err error
-		nextToken, err = lastTxrow.Next(filter.Address == nil)
+		round, err = si.eachTransaction(ctx, filter, func(txrow idb.TxnRow, tx generated.Transaction) error {
+			results = append(results, eventTransaction{intra: uint64(txrow.Intra), txn: tx})
+			lastTxrow = txrow
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		// No next token if there were no results.
+		if len(results) == 0 {
+			return nil
+		}
+		nextToken, err = lastTxrow.Next(!filter.Descending())
 
 		return err
 	})
@@ -1613,6 +3204,176 @@ func (si *ServerImplementation) fetchTransactions(ctx context.Context, filter id
 	return results, nextToken, round, nil
 }
 
+// eachTransaction queries the backend for transactions and calls yield with
+// each of them, in order, until the results or the context are exhausted.
+func (si *ServerImplementation) eachTransaction(ctx context.Context, filter idb.TransactionFilter, yield func(txrow idb.TxnRow, tx generated.Transaction) error) (uint64 /*round*/, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	txchan, round := si.db.Transactions(ctx, filter)
+
+	// Stop the query and make sure txchan is empty at the end of processing.
+	defer func() {
+		cancel()
+		for range txchan {
+		}
+	}()
+
+	var prevID string
+	for txrow := range txchan {
+		tx, err := txnRowToTransaction(txrow, si.opts.ContractEvents)
+		if err != nil {
+			return 0, err
+		}
+
+		// The root txn only needs to be added once, so remove duplicates unless
+		// we are including inner transactions (which use the root txid). The
+		// matching inner transactions of a root txn are adjacent, so only the
+		// previous one is compared.
+		if *tx.Id == prevID && !filter.SkipInnerTransactionConversion {
+			continue
+		}
+
+		prevID = *tx.Id
+		if err := yield(txrow, tx); err != nil {
+			return 0, err
+		}
+	}
+
+	return round, nil
+}
+
+// subscribedTransaction is a transaction pushed to a subscriber along with the
+// ascending next token which resumes the subscription after it.
+type subscribedTransaction struct {
+	txn  generated.Transaction
+	next string
+}
+
+// subscriptionRoundEvent is pushed to a subscriber after all transactions up
+// to the round have been sent.
+type subscriptionRoundEvent struct {
+	CurrentRound uint64 `json:"current-round"`
+}
+
+// streamTransactions sends the transactions matching the filter, oldest to
+// newest, until the filter round range is exhausted or the context is done.
+// Without a round range or next token it starts with the round after current.
+func (si *ServerImplementation) streamTransactions(ctx context.Context, filter idb.TransactionFilter, current uint64, send func(event string, id string, data interface{}) error) error {
+	// next is the first round which hasn't been sent, last is zero when the
+	// subscription does not end.
+	next := current + 1
+	var last uint64
+	if filter.Round != nil {
+		next, last = *filter.Round, *filter.Round
+	}
+	if filter.MinRound != 0 {
+		next = filter.MinRound
+	}
+	if filter.MaxRound != 0 {
+		last = filter.MaxRound
+	}
+
+	token := filter.NextToken
+	if token != "" {
+		var err error
+		next, _, err = idb.DecodeTxnRowNext(token)
+		if err != nil {
+			return fmt.Errorf("%s: %w", errUnableToParseNext, err)
+		}
+	}
+
+	// Address queries are newest first by default.
+	filter.Order = idb.SortAscending
+	filter.Round = nil
+	filter.NextToken = ""
+	for last == 0 || next <= last {
+		if current < next {
+			var err error
+			current, err = si.waitForRound(ctx, next)
+			if err != nil {
+				return err
+			}
+		}
+
+		upto := current
+		if last != 0 && last < upto {
+			upto = last
+		}
+
+		if err := si.streamTransactionRange(ctx, filter, next, upto, token, send); err != nil {
+			return err
+		}
+
+		// Everything up to and including upto has been sent.
+		token = idb.EncodeTxnRowNext(upto, math.MaxUint32)
+		if err := send("round", token, subscriptionRoundEvent{CurrentRound: upto}); err != nil {
+			return err
+		}
+		next = upto + 1
+	}
+	return nil
+}
+
+// streamTransactionRange sends the transactions between two rounds one page
+// at a time, using the next token to resume each page.
+func (si *ServerImplementation) streamTransactionRange(ctx context.Context, filter idb.TransactionFilter, from, upto uint64, token string, send func(event string, id string, data interface{}) error) error {
+	filter.MinRound = from
+	filter.MaxRound = upto
+	filter.NextToken = token
+	for {
+		txns, more, err := si.fetchSubscribedTransactions(ctx, filter)
+		if err != nil {
+			return err
+		}
+		for _, txn := range txns {
+			if err := send("transaction", txn.next, txn.txn); err != nil {
+				return err
+			}
+			filter.NextToken = txn.next
+		}
+		if !more {
+			return nil
+		}
+	}
+}
+
+// fetchSubscribedTransactions queries one page of transactions for a subscription,
+// and reports whether the page was full.
+func (si *ServerImplementation) fetchSubscribedTransactions(ctx context.Context, filter idb.TransactionFilter) ([]subscribedTransaction, bool /*more*/, error) {
+	var more bool
+	results := make([]subscribedTransaction, 0)
+	err := callWithTimeout(ctx, si.log, si.timeout, func(ctx context.Context) error {
+		txchan, _ := si.db.Transactions(ctx, filter)
+
+		var count uint64
+		rootTxnDedupeMap := make(map[string]struct{})
+		for txrow := range txchan {
+			count++
+			tx, err := txnRowToTransaction(txrow, si.opts.ContractEvents)
+			if err != nil {
+				return err
+			}
+
+			// The root txn only needs to be sent once.
+			if _, ok := rootTxnDedupeMap[*tx.Id]; ok {
+				continue
+			}
+			rootTxnDedupeMap[*tx.Id] = struct{}{}
+
+			next, err := txrow.Next(true)
+			if err != nil {
+				return err
+			}
+			results = append(results, subscribedTransaction{txn: tx, next: next})
+		}
+		more = filter.Limit != 0 && count >= filter.Limit
+		return nil
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	return results, more, nil
+}
+
 //////////////////////
 // Helper functions //
 //////////////////////
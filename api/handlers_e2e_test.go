@@ -1221,6 +1221,60 @@ func TestAccountsOnlineOnlyParam(t *testing.T) {
 	}
 }
 
+// TestAccountsOrderByBalance pages through `GET /v2/accounts?order=balance-desc`.
+func TestAccountsOrderByBalance(t *testing.T) {
+	db, shutdownFunc := setupIdb(t, test.MakeGenesis())
+	defer shutdownFunc()
+
+	api := testServerImplementation(db)
+	api.EnableAddressSearchRoundRewind = true
+	e := echo.New()
+
+	order := generated.SearchForAccountsParamsOrderBalanceDesc
+	params := generated.SearchForAccountsParams{Order: &order, Limit: uint64Ptr(2)}
+	var accounts []generated.Account
+	for {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetPath("/v2/accounts")
+		require.NoError(t, api.SearchForAccounts(c, params))
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		var response generated.AccountsResponse
+		require.NoError(t, json.Decode(rec.Body.Bytes(), &response))
+		if len(response.Accounts) == 0 {
+			break
+		}
+		accounts = append(accounts, response.Accounts...)
+		params.Next = response.NextToken
+	}
+
+	// Every account is returned once, ties are ordered by descending address.
+	require.Len(t, accounts, len(test.MakeGenesis().Allocation))
+	for i := 1; i < len(accounts); i++ {
+		prev, cur := accounts[i-1], accounts[i]
+		require.GreaterOrEqual(t, prev.AmountWithoutPendingRewards, cur.AmountWithoutPendingRewards)
+		if prev.AmountWithoutPendingRewards == cur.AmountWithoutPendingRewards {
+			prevAddr, err := sdk.DecodeAddress(prev.Address)
+			require.NoError(t, err)
+			curAddr, err := sdk.DecodeAddress(cur.Address)
+			require.NoError(t, err)
+			require.Equal(t, 1, bytes.Compare(prevAddr[:], curAddr[:]))
+		}
+	}
+	assert.Equal(t, test.RewardAddr.String(), accounts[len(accounts)-1].Address)
+
+	// Balances can't be ordered when rewinding.
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetPath("/v2/accounts")
+	require.NoError(t, api.SearchForAccounts(c, generated.SearchForAccountsParams{Order: &order, Round: uint64Ptr(0)}))
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+	require.Contains(t, rec.Body.String(), errBalanceOrderRewind)
+}
+
 func TestAccountClearsNonUTF8(t *testing.T) {
 	db, shutdownFunc := setupIdb(t, test.MakeGenesis())
 	defer shutdownFunc()
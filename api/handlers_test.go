@@ -14,6 +14,7 @@ import (
 	"time"
 
 	"github.com/labstack/echo/v4"
+	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -25,10 +26,12 @@ import (
 
 	sdkcrypto "github.com/algorand/go-algorand-sdk/v2/crypto"
 	"github.com/algorand/go-algorand-sdk/v2/encoding/msgpack"
+	"github.com/algorand/go-algorand-sdk/v2/protocol"
 	sdk "github.com/algorand/go-algorand-sdk/v2/types"
 )
 
 func TestTransactionParamToTransactionFilter(t *testing.T) {
+	optIn := sdk.OptInOC
 	tests := []struct {
 		name          string
 		params        generated.SearchForTransactionsParams
@@ -71,6 +74,44 @@ func TestTransactionParamToTransactionFilter(t *testing.T) {
 			idb.TransactionFilter{NotePrefix: []byte("SomeData"), Limit: defaultOpts.DefaultTransactionsLimit},
 			nil,
 		},
+		{
+			"Method selector",
+			generated.SearchForTransactionsParams{MethodSelector: strPtr(base64.StdEncoding.EncodeToString([]byte{1, 2, 3, 4}))},
+			idb.TransactionFilter{MethodSelector: []byte{1, 2, 3, 4}, Limit: defaultOpts.DefaultTransactionsLimit},
+			nil,
+		},
+		{
+			"Method selector length",
+			generated.SearchForTransactionsParams{MethodSelector: strPtr(base64.StdEncoding.EncodeToString([]byte{1, 2, 3}))},
+			idb.TransactionFilter{},
+			[]string{errBadMethodSelectorLen},
+		},
+		{
+			"Application call fields",
+			generated.SearchForTransactionsParams{
+				OnCompletion:   (*generated.SearchForTransactionsParamsOnCompletion)(strPtr("optin")),
+				ForeignAppId:   uint64Ptr(5),
+				ForeignAssetId: uint64Ptr(6),
+				BoxReference:   strPtr("str:box"),
+			},
+			idb.TransactionFilter{
+				OnCompletion:   &optIn,
+				ForeignAppID:   uint64Ptr(5),
+				ForeignAssetID: uint64Ptr(6),
+				BoxReference:   []byte("box"),
+				Limit:          defaultOpts.DefaultTransactionsLimit,
+			},
+			nil,
+		},
+		{
+			"Invalid application call fields",
+			generated.SearchForTransactionsParams{
+				OnCompletion: (*generated.SearchForTransactionsParamsOnCompletion)(strPtr("optout")),
+				BoxReference: strPtr("box"),
+			},
+			idb.TransactionFilter{},
+			[]string{errUnknownOnCompletion, errUnableToParseBoxName},
+		},
 		{
 			"Enum fields",
 			generated.SearchForTransactionsParams{TxType: (*generated.SearchForTransactionsParamsTxType)(strPtr("pay")), SigType: (*generated.SearchForTransactionsParamsSigType)(strPtr("lsig"))},
@@ -193,6 +234,24 @@ func TestTransactionParamToTransactionFilter(t *testing.T) {
 			filter:        idb.TransactionFilter{AddressRole: 64, Limit: defaultOpts.DefaultTransactionsLimit},
 			errorContains: nil,
 		},
+		{
+			name:          "Bitmask application-account",
+			params:        generated.SearchForTransactionsParams{AddressRole: (*generated.SearchForTransactionsParamsAddressRole)(strPtr("application-account"))},
+			filter:        idb.TransactionFilter{AddressRole: 128, Limit: defaultOpts.DefaultTransactionsLimit},
+			errorContains: nil,
+		},
+		{
+			name:          "Bitmask inner-receiver",
+			params:        generated.SearchForTransactionsParams{AddressRole: (*generated.SearchForTransactionsParamsAddressRole)(strPtr("inner-receiver"))},
+			filter:        idb.TransactionFilter{AddressRole: 256, Limit: defaultOpts.DefaultTransactionsLimit},
+			errorContains: nil,
+		},
+		{
+			name:          "Bitmask heartbeat",
+			params:        generated.SearchForTransactionsParams{AddressRole: (*generated.SearchForTransactionsParamsAddressRole)(strPtr("heartbeat"))},
+			filter:        idb.TransactionFilter{AddressRole: 512, Limit: defaultOpts.DefaultTransactionsLimit},
+			errorContains: nil,
+		},
 		{
 			name:          "Currency to Algos when no asset-id",
 			params:        generated.SearchForTransactionsParams{CurrencyGreaterThan: uint64Ptr(10), CurrencyLessThan: uint64Ptr(20)},
@@ -211,6 +270,18 @@ func TestTransactionParamToTransactionFilter(t *testing.T) {
 			filter:        idb.TransactionFilter{TypeEnum: idb.TypeEnumAssetTransfer, AssetAmountGT: uint64Ptr(10), Limit: defaultOpts.DefaultTransactionsLimit},
 			errorContains: nil,
 		},
+		{
+			name:          "Descending order",
+			params:        generated.SearchForTransactionsParams{Order: (*generated.SearchForTransactionsParamsOrder)(strPtr("desc"))},
+			filter:        idb.TransactionFilter{Order: idb.SortDescending, Limit: defaultOpts.DefaultTransactionsLimit},
+			errorContains: nil,
+		},
+		{
+			name:          "Unknown order error",
+			params:        generated.SearchForTransactionsParams{Order: (*generated.SearchForTransactionsParamsOrder)(strPtr("sideways"))},
+			filter:        idb.TransactionFilter{},
+			errorContains: []string{errUnknownSortOrder},
+		},
 	}
 
 	for _, test := range tests {
@@ -230,6 +301,73 @@ func TestTransactionParamToTransactionFilter(t *testing.T) {
 	}
 }
 
+func TestBlockParamsToBlockFilterOrder(t *testing.T) {
+	next := func(round uint64) *string {
+		s, err := idb.BlockRow{BlockHeader: sdk.BlockHeader{Round: sdk.Round(round)}}.Next()
+		require.NoError(t, err)
+		return &s
+	}
+	order := func(o string) *generated.SearchForBlockHeadersParamsOrder {
+		return (*generated.SearchForBlockHeadersParamsOrder)(&o)
+	}
+
+	tests := []struct {
+		name     string
+		params   generated.SearchForBlockHeadersParams
+		order    idb.SortOrder
+		minRound *uint64
+		maxRound *uint64
+	}{
+		{
+			name:     "Ascending next",
+			params:   generated.SearchForBlockHeadersParams{Next: next(10)},
+			minRound: uint64Ptr(11),
+		},
+		{
+			name:     "Descending next",
+			params:   generated.SearchForBlockHeadersParams{Order: order("desc"), Next: next(10)},
+			order:    idb.SortDescending,
+			maxRound: uint64Ptr(9),
+		},
+		{
+			name:     "Descending next below max-round",
+			params:   generated.SearchForBlockHeadersParams{Order: order("desc"), Next: next(10), MinRound: uint64Ptr(2), MaxRound: uint64Ptr(20)},
+			order:    idb.SortDescending,
+			minRound: uint64Ptr(2),
+			maxRound: uint64Ptr(9),
+		},
+		{
+			name:     "Descending next above max-round",
+			params:   generated.SearchForBlockHeadersParams{Order: order("desc"), Next: next(10), MaxRound: uint64Ptr(5)},
+			order:    idb.SortDescending,
+			maxRound: uint64Ptr(5),
+		},
+		{
+			name:     "Descending next after round 0",
+			params:   generated.SearchForBlockHeadersParams{Order: order("desc"), Next: next(0)},
+			order:    idb.SortDescending,
+			minRound: uint64Ptr(1),
+			maxRound: uint64Ptr(0),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			si := testServerImplementation(nil)
+			filter, err := si.blockParamsToBlockFilter(test.params)
+			require.NoError(t, err)
+			assert.Equal(t, test.order, filter.Order)
+			assert.Equal(t, test.minRound, filter.MinRound)
+			assert.Equal(t, test.maxRound, filter.MaxRound)
+		})
+	}
+
+	si := testServerImplementation(nil)
+	_, err := si.blockParamsToBlockFilter(generated.SearchForBlockHeadersParams{Order: order("sideways")})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), errUnknownSortOrder)
+}
+
 func TestValidateTransactionFilter(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -317,6 +455,20 @@ func TestValidateTransactionFilter(t *testing.T) {
 			},
 			errorContains: []string{errValueExceedingInt64},
 		},
+		{
+			name: "foreign-app-id > math.MaxInt64",
+			filter: idb.TransactionFilter{
+				ForeignAppID: uint64Ptr(math.MaxInt64 + 1),
+			},
+			errorContains: []string{errValueExceedingInt64},
+		},
+		{
+			name: "foreign-asset-id > math.MaxInt64",
+			filter: idb.TransactionFilter{
+				ForeignAssetID: uint64Ptr(math.MaxInt64 + 1),
+			},
+			errorContains: []string{errValueExceedingInt64},
+		},
 		{
 			name: "offset > math.MaxInt64",
 			filter: idb.TransactionFilter{
@@ -853,177 +1005,1424 @@ func TestFetchAccountsRewindRoundTooLarge(t *testing.T) {
 	assert.True(t, strings.HasPrefix(err.Error(), errRewindingAccount), err.Error())
 }
 
-func TestLookupApplicationLogsByID(t *testing.T) {
+func TestFetchBalanceHistory(t *testing.T) {
+	var addr sdk.Address
+	addr[0] = 1
+	var other sdk.Address
+	other[0] = 2
+
+	payment := func(round uint64, intra int, sender, receiver sdk.Address, amount uint64) idb.TxnRow {
+		return idb.TxnRow{
+			Round:     round,
+			Intra:     intra,
+			RoundTime: time.Unix(int64(round*10), 0),
+			Txn: &sdk.SignedTxnWithAD{
+				SignedTxn: sdk.SignedTxn{
+					Txn: sdk.Transaction{
+						Type: sdk.PaymentTx,
+						Header: sdk.Header{
+							Sender: sender,
+							Fee:    1000,
+						},
+						PaymentTxnFields: sdk.PaymentTxnFields{
+							Receiver: receiver,
+							Amount:   sdk.MicroAlgos(amount),
+						},
+					},
+				},
+			},
+		}
+	}
+
+	// newest first, like the address query in postgres.
+	rows := []idb.TxnRow{
+		payment(9, 0, addr, other, 5000),
+		payment(7, 1, other, addr, 20000),
+		payment(3, 0, other, addr, 100000),
+	}
+
+	accountRow := idb.AccountRow{Account: generated.Account{AmountWithoutPendingRewards: 114000}}
+
 	mockIndexer := &mocks.IndexerDb{}
+	mockIndexer.On("GetAccounts", mock.Anything, mock.Anything).Return(
+		func(ctx context.Context, opts idb.AccountQueryOptions) <-chan idb.AccountRow {
+			ch := make(chan idb.AccountRow, 1)
+			ch <- accountRow
+			close(ch)
+			return ch
+		}, uint64(10))
+	mockIndexer.On("Transactions", mock.Anything, mock.Anything).Return(
+		func(ctx context.Context, tf idb.TransactionFilter) <-chan idb.TxnRow {
+			ch := make(chan idb.TxnRow, len(rows))
+			for _, row := range rows {
+				if row.Round <= tf.MaxRound {
+					ch <- row
+				}
+			}
+			close(ch)
+			return ch
+		}, uint64(10))
+
 	si := testServerImplementation(mockIndexer)
-	si.EnableAddressSearchRoundRewind = true
 
-	txnBytes := loadResourceFileOrPanic("test_resources/app_call_logs.txn")
-	var stxn sdk.SignedTxnWithAD
-	err := msgpack.Decode(txnBytes, &stxn)
-	assert.NoError(t, err)
+	balances, next, round, err := si.fetchBalanceHistory(context.Background(), balanceHistoryQuery{addr: addr, limit: 2})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(10), round)
+	require.Len(t, balances, 2)
+	assert.Equal(t, generated.BalanceHistoryEntry{Round: 9, Timestamp: 90, Amount: 114000}, balances[0])
+	assert.Equal(t, generated.BalanceHistoryEntry{Round: 7, Timestamp: 70, Amount: 120000}, balances[1])
+	require.NotNil(t, next)
+
+	// The next token only holds the position, the balances are recomputed.
+	cursor, err := decodeHistoryNext(*next)
+	require.NoError(t, err)
+	assert.Equal(t, historyCursor{round: 7, intra: 1}, cursor)
+	balances, next, _, err = si.fetchBalanceHistory(context.Background(), balanceHistoryQuery{addr: addr, limit: 2, next: &cursor})
+	require.NoError(t, err)
+	require.Len(t, balances, 1)
+	assert.Equal(t, generated.BalanceHistoryEntry{Round: 3, Timestamp: 30, Amount: 100000}, balances[0])
+	require.NotNil(t, next)
+
+	cursor, err = decodeHistoryNext(*next)
+	require.NoError(t, err)
+	assert.Equal(t, historyCursor{round: 3, intra: 0}, cursor)
+
+	balances, _, _, err = si.fetchBalanceHistory(context.Background(), balanceHistoryQuery{addr: addr, limit: 2, maxRound: uint64Ptr(8)})
+	require.NoError(t, err)
+	require.Len(t, balances, 2)
+	assert.Equal(t, generated.BalanceHistoryEntry{Round: 7, Timestamp: 70, Amount: 120000}, balances[0])
+}
 
-	roundTime := time.Now()
-	ch := make(chan idb.TxnRow, 1)
-	ch <- idb.TxnRow{
-		Round:     1,
-		Intra:     2,
-		RoundTime: roundTime,
-		Txn:       &stxn,
-		AssetID:   0,
-		Extra: idb.TxnExtra{
-			AssetCloseAmount: 0,
-		},
-		Error: nil,
+func TestSubscribeTransactions(t *testing.T) {
+	var sender sdk.Address
+	sender[0] = 1
+
+	row := func(round uint64, intra int) idb.TxnRow {
+		return idb.TxnRow{
+			Round:     round,
+			Intra:     intra,
+			RoundTime: time.Unix(int64(round*10), 0),
+			Txn: &sdk.SignedTxnWithAD{
+				SignedTxn: sdk.SignedTxn{
+					Txn: sdk.Transaction{
+						Type: sdk.PaymentTx,
+						Header: sdk.Header{
+							Sender:     sender,
+							FirstValid: sdk.Round(round),
+							LastValid:  sdk.Round(round + 10),
+						},
+					},
+				},
+			},
+		}
 	}
+	rows := []idb.TxnRow{row(5, 0), row(6, 3)}
 
-	close(ch)
-	var outCh <-chan idb.TxnRow = ch
-	var round uint64 = 1
-	mockIndexer.On("Transactions", mock.Anything, mock.Anything).Return(outCh, round)
+	mockIndexer := &mocks.IndexerDb{}
+	mockIndexer.On("Health", mock.Anything).Return(idb.Health{Round: 10}, nil)
+	mockIndexer.On("Transactions", mock.Anything, mock.Anything).Return(
+		func(ctx context.Context, tf idb.TransactionFilter) <-chan idb.TxnRow {
+			ch := make(chan idb.TxnRow, len(rows))
+			for _, row := range rows {
+				if row.Round >= tf.MinRound && row.Round <= tf.MaxRound {
+					ch <- row
+				}
+			}
+			close(ch)
+			return ch
+		}, uint64(10))
+
+	si := testServerImplementation(mockIndexer)
 
-	appIdx := stxn.Txn.ApplicationID
 	e := echo.New()
 	req := httptest.NewRequest(http.MethodGet, "/", nil)
 	rec := httptest.NewRecorder()
 	c := e.NewContext(req, rec)
-	c.SetPath("/v2/applications/:appIdx/logs")
-	c.SetParamNames("appIdx")
-	c.SetParamValues(fmt.Sprintf("%d", appIdx))
 
-	params := generated.LookupApplicationLogsByIDParams{}
-	err = si.LookupApplicationLogsByID(c, 444, params)
-	assert.NoError(t, err)
+	params := generated.SubscribeTransactionsParams{MinRound: uint64Ptr(5), MaxRound: uint64Ptr(7)}
+	require.NoError(t, si.SubscribeTransactions(c, params))
 	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "text/event-stream", rec.Header().Get(echo.HeaderContentType))
 
-	var response generated.ApplicationLogsResponse
-	err = json.Unmarshal(rec.Body.Bytes(), &response)
-	assert.NoError(t, err)
+	events := strings.Split(strings.TrimSpace(rec.Body.String()), "\n\n")
+	require.Len(t, events, 3)
+	assert.True(t, strings.HasPrefix(events[0], "id: "+idb.EncodeTxnRowNext(5, 0)+"\nevent: transaction\n"), events[0])
+	assert.True(t, strings.HasPrefix(events[1], "id: "+idb.EncodeTxnRowNext(6, 3)+"\nevent: transaction\n"), events[1])
+	assert.Equal(t, "id: "+idb.EncodeTxnRowNext(7, math.MaxUint32)+"\nevent: round\ndata: {\"current-round\":7}", events[2])
 
-	assert.Equal(t, uint64(appIdx), response.ApplicationId)
-	assert.NotNil(t, response.LogData)
-	ld := *response.LogData
-	assert.Equal(t, 1, len(ld))
-	assert.Equal(t, sdkcrypto.TransactionIDString(stxn.Txn), ld[0].Txid)
-	assert.Equal(t, len(stxn.ApplyData.EvalDelta.Logs), len(ld[0].Logs))
-	for i, log := range ld[0].Logs {
-		assert.Equal(t, []byte(stxn.ApplyData.EvalDelta.Logs[i]), log)
-	}
+	var txn generated.Transaction
+	require.NoError(t, json.Unmarshal([]byte(strings.SplitN(events[1], "data: ", 2)[1]), &txn))
+	assert.Equal(t, uint64(6), *txn.ConfirmedRound)
 }
 
-func TestLookupApplicationLogsByIDWithLimit(t *testing.T) {
-	mockIndexer := &mocks.IndexerDb{}
-	si := testServerImplementation(mockIndexer)
-	si.EnableAddressSearchRoundRewind = true
-
-	txnBytes := loadResourceFileOrPanic("test_resources/app_call_logs.txn")
-	var stxn sdk.SignedTxnWithAD
-	err := msgpack.Decode(txnBytes, &stxn)
-	assert.NoError(t, err)
-
-	roundTime := time.Now()
-	ch := make(chan idb.TxnRow, 1)
-	ch <- idb.TxnRow{
-		Round:     1,
-		Intra:     2,
-		RoundTime: roundTime,
-		Txn:       &stxn,
-		AssetID:   0,
-		Extra: idb.TxnExtra{
-			AssetCloseAmount: 0,
-		},
-		Error: nil,
-	}
+func TestSubscribeTransactionsAddress(t *testing.T) {
+	var sender sdk.Address
+	sender[0] = 1
 
-	close(ch)
-	var outCh <-chan idb.TxnRow = ch
-	var round uint64 = 1
+	mockIndexer := &mocks.IndexerDb{}
+	mockIndexer.On("Health", mock.Anything).Return(idb.Health{Round: 10}, nil)
+	mockIndexer.On("Transactions", mock.Anything, mock.Anything).Return(
+		func(ctx context.Context, tf idb.TransactionFilter) <-chan idb.TxnRow {
+			ch := make(chan idb.TxnRow)
+			close(ch)
+			return ch
+		}, uint64(10))
 
-	// Verify that the filter has RequireApplicationLogs set to true
-	mockIndexer.On("Transactions", mock.Anything, mock.MatchedBy(func(filter idb.TransactionFilter) bool {
-		return filter.RequireApplicationLogs == true && filter.Limit == 1
-	})).Return(outCh, round)
+	si := testServerImplementation(mockIndexer)
 
-	appIdx := stxn.Txn.ApplicationID
 	e := echo.New()
 	req := httptest.NewRequest(http.MethodGet, "/", nil)
 	rec := httptest.NewRecorder()
 	c := e.NewContext(req, rec)
-	c.SetPath("/v2/applications/:appIdx/logs")
-	c.SetParamNames("appIdx")
-	c.SetParamValues(fmt.Sprintf("%d", appIdx))
 
-	// Test with limit=1
-	params := generated.LookupApplicationLogsByIDParams{Limit: uint64Ptr(1)}
-	err = si.LookupApplicationLogsByID(c, uint64(appIdx), params)
-	assert.NoError(t, err)
+	params := generated.SubscribeTransactionsParams{Address: strPtr(sender.String()), MinRound: uint64Ptr(1), MaxRound: uint64Ptr(1000)}
+	require.NoError(t, si.SubscribeTransactions(c, params))
 	assert.Equal(t, http.StatusOK, rec.Code)
 
-	var response generated.ApplicationLogsResponse
-	err = json.Unmarshal(rec.Body.Bytes(), &response)
-	assert.NoError(t, err)
+	// The whole range is a single ascending query.
+	mockIndexer.AssertNumberOfCalls(t, "Transactions", 1)
+	tf := mockIndexer.Calls[1].Arguments.Get(1).(idb.TransactionFilter)
+	assert.Equal(t, uint64(1), tf.MinRound)
+	assert.Equal(t, uint64(1000), tf.MaxRound)
+	assert.Equal(t, idb.SortAscending, tf.Order)
+	assert.False(t, tf.Descending())
+
+	// A malformed token is rejected before the stream starts.
+	req = httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("Last-Event-ID", "not-a-token")
+	rec = httptest.NewRecorder()
+	c = e.NewContext(req, rec)
+	require.NoError(t, si.SubscribeTransactions(c, params))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	// Subscriptions can't be newest first.
+	rec = httptest.NewRecorder()
+	c = e.NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec)
+	params.Order = (*generated.SubscribeTransactionsParamsOrder)(strPtr("desc"))
+	require.NoError(t, si.SubscribeTransactions(c, params))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), errSubscribeDescending)
+}
 
-	assert.Equal(t, uint64(appIdx), response.ApplicationId)
-	assert.NotNil(t, response.LogData)
-	ld := *response.LogData
-	assert.Equal(t, 1, len(ld))
-	assert.Equal(t, sdkcrypto.TransactionIDString(stxn.Txn), ld[0].Txid)
-	assert.Equal(t, len(stxn.ApplyData.EvalDelta.Logs), len(ld[0].Logs))
-	for i, log := range ld[0].Logs {
-		assert.Equal(t, []byte(stxn.ApplyData.EvalDelta.Logs[i]), log)
+func TestSubscribeParamsToSearchParams(t *testing.T) {
+	si := testServerImplementation(&mocks.IndexerDb{})
+
+	params := generated.SubscribeTransactionsParams{
+		MethodSelector: strPtr("AQIDBA=="),
+		OnCompletion:   (*generated.SubscribeTransactionsParamsOnCompletion)(strPtr("optin")),
+		ForeignAppId:   uint64Ptr(2),
+		ForeignAssetId: uint64Ptr(3),
+		BoxReference:   strPtr("str:box"),
 	}
+	filter, err := si.transactionParamsToTransactionFilter(subscribeParamsToSearchParams(params))
+	require.NoError(t, err)
+
+	assert.Equal(t, []byte{1, 2, 3, 4}, filter.MethodSelector)
+	require.NotNil(t, filter.OnCompletion)
+	assert.Equal(t, sdk.OptInOC, *filter.OnCompletion)
+	assert.Equal(t, uint64Ptr(2), filter.ForeignAppID)
+	assert.Equal(t, uint64Ptr(3), filter.ForeignAssetID)
+	assert.Equal(t, []byte("box"), filter.BoxReference)
 }
 
-func TestTimeouts(t *testing.T) {
-	// function pointers to execute the different DB operations. We really only
-	// care that they timeout with WaitUntil, but the return arguments need to
-	// be correct to avoid a panic.
-	mostMockFunctions := func(method string) func(mockIndexer *mocks.IndexerDb, timeout <-chan time.Time) {
-		return func(mockIndexer *mocks.IndexerDb, timeout <-chan time.Time) {
-			mockIndexer.
-				On(method, mock.Anything, mock.Anything, mock.Anything).
-				WaitUntil(timeout).
-				Return(nil, uint64(0))
+func TestFetchAuthHistory(t *testing.T) {
+	var addr, authA, authB, other sdk.Address
+	addr[0] = 1
+	authA[0] = 2
+	authB[0] = 3
+	other[0] = 4
+
+	payment := func(round uint64, intra int, rekeyTo, closeTo sdk.Address) idb.TxnRow {
+		return idb.TxnRow{
+			Round:     round,
+			Intra:     intra,
+			RoundTime: time.Unix(int64(round*10), 0),
+			Txn: &sdk.SignedTxnWithAD{
+				SignedTxn: sdk.SignedTxn{
+					Txn: sdk.Transaction{
+						Type: sdk.PaymentTx,
+						Header: sdk.Header{
+							Sender:  addr,
+							RekeyTo: rekeyTo,
+						},
+						PaymentTxnFields: sdk.PaymentTxnFields{
+							Receiver:         other,
+							CloseRemainderTo: closeTo,
+						},
+					},
+				},
+			},
 		}
 	}
-	transactionFunc := mostMockFunctions("Transactions")
-	applicationsFunc := mostMockFunctions("Applications")
-	accountsFunc := mostMockFunctions("GetAccounts")
-	assetsFunc := mostMockFunctions("Assets")
-	balancesFunc := mostMockFunctions("AssetBalances")
-	blockFunc := func(mockIndexer *mocks.IndexerDb, timeout <-chan time.Time) {
-		mockIndexer.
-			On("GetBlock", mock.Anything, mock.Anything, mock.Anything).
-			WaitUntil(timeout).
-			Return(sdk.BlockHeader{}, nil, nil)
+	inner := payment(7, 1, authB, sdk.Address{})
+	inner.Extra.RootIntra = idb.OptionalUint{Present: true, Value: 0}
+	inner.Extra.RootTxid = "root"
+
+	// newest first, like the address query in postgres.
+	rows := []idb.TxnRow{
+		payment(9, 0, sdk.Address{}, other),
+		inner,
+		payment(5, 0, authA, sdk.Address{}),
+		// the account was not rekeyed, the close-out does not change the auth address
+		payment(2, 0, sdk.Address{}, other),
 	}
-	healthFunc := func(mockIndexer *mocks.IndexerDb, timeout <-chan time.Time) {
-		mockIndexer.
-			On("Health", mock.Anything, mock.Anything, mock.Anything).
-			WaitUntil(timeout).
-			Return(idb.Health{}, nil)
+
+	mockIndexer := &mocks.IndexerDb{}
+	mockIndexer.On("Transactions", mock.Anything, mock.Anything).Return(
+		func(ctx context.Context, tf idb.TransactionFilter) <-chan idb.TxnRow {
+			ch := make(chan idb.TxnRow, len(rows))
+			for _, row := range rows {
+				if tf.MaxRound == 0 || row.Round <= tf.MaxRound {
+					ch <- row
+				}
+			}
+			close(ch)
+			return ch
+		}, uint64(10))
+
+	si := testServerImplementation(mockIndexer)
+
+	changes, next, round, err := si.fetchAuthHistory(context.Background(), authHistoryQuery{addr: addr, limit: 2})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(10), round)
+	require.Len(t, changes, 2)
+	assert.Equal(t, generated.AuthHistoryEntry{
+		Round:               9,
+		Timestamp:           90,
+		Txid:                sdkcrypto.TransactionIDString(rows[0].Txn.Txn),
+		PreviousAuthAddress: authB.String(),
+		NewAuthAddress:      addr.String(),
+		CloseOut:            true,
+	}, changes[0])
+	assert.Equal(t, generated.AuthHistoryEntry{
+		Round:               7,
+		Timestamp:           70,
+		IntraRoundOffset:    1,
+		Txid:                "root",
+		PreviousAuthAddress: authA.String(),
+		NewAuthAddress:      authB.String(),
+	}, changes[1])
+	require.NotNil(t, next)
+
+	cursor, err := decodeHistoryNext(*next)
+	require.NoError(t, err)
+	changes, _, _, err = si.fetchAuthHistory(context.Background(), authHistoryQuery{addr: addr, limit: 2, next: &cursor})
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+	assert.Equal(t, addr.String(), changes[0].PreviousAuthAddress)
+	assert.Equal(t, authA.String(), changes[0].NewAuthAddress)
+
+	// The change before min-round completes the oldest change without being returned.
+	changes, _, _, err = si.fetchAuthHistory(context.Background(), authHistoryQuery{addr: addr, limit: 5, minRound: uint64Ptr(6)})
+	require.NoError(t, err)
+	require.Len(t, changes, 2)
+	assert.Equal(t, authA.String(), changes[1].PreviousAuthAddress)
+}
+
+func TestFetchParticipationHistory(t *testing.T) {
+	var addr, other sdk.Address
+	addr[0] = 1
+	other[0] = 2
+
+	row := func(round uint64, txn sdk.Transaction) idb.TxnRow {
+		txn.Sender = addr
+		return idb.TxnRow{
+			Round:     round,
+			RoundTime: time.Unix(int64(round*10), 0),
+			Txn:       &sdk.SignedTxnWithAD{SignedTxn: sdk.SignedTxn{Txn: txn}},
+		}
+	}
+	online := sdk.Transaction{
+		Type:   sdk.KeyRegistrationTx,
+		Header: sdk.Header{Fee: 2_000_000},
+		KeyregTxnFields: sdk.KeyregTxnFields{
+			VotePK:          sdk.VotePK{1},
+			SelectionPK:     sdk.VRFPK{2},
+			VoteFirst:       1,
+			VoteLast:        100,
+			VoteKeyDilution: 10,
+		},
+	}
+	offline := sdk.Transaction{Type: sdk.KeyRegistrationTx}
+	closeOut := sdk.Transaction{
+		Type:             sdk.PaymentTx,
+		PaymentTxnFields: sdk.PaymentTxnFields{Receiver: other, CloseRemainderTo: other},
 	}
 
-	// Call each of the handlers and let the database timeout.
+	// newest first, like the address query in postgres.
+	keyregs := []idb.TxnRow{row(8, offline), row(5, online), row(2, online)}
+	closes := []idb.TxnRow{row(6, closeOut)}
+
+	mockIndexer := &mocks.IndexerDb{}
+	mockIndexer.On("Transactions", mock.Anything, mock.Anything).Return(
+		func(ctx context.Context, tf idb.TransactionFilter) <-chan idb.TxnRow {
+			rows := closes
+			if tf.TypeEnum == idb.TypeEnumKeyreg {
+				rows = keyregs
+			}
+			ch := make(chan idb.TxnRow, len(rows))
+			for _, row := range rows {
+				if (tf.MaxRound == 0 || row.Round <= tf.MaxRound) && row.Round >= tf.MinRound {
+					ch <- row
+				}
+			}
+			close(ch)
+			return ch
+		}, uint64(10))
+
+	si := testServerImplementation(mockIndexer)
+
+	response, err := si.fetchParticipationHistory(context.Background(), participationHistoryQuery{addr: addr, limit: 2})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(10), response.CurrentRound)
+	require.Len(t, response.Registrations, 2)
+	assert.Equal(t, generated.Offline, response.Registrations[0].Status)
+	assert.Nil(t, response.Registrations[0].Participation)
+	assert.Equal(t, generated.Online, response.Registrations[1].Status)
+	assert.True(t, response.Registrations[1].IncentiveEligible)
+	require.NotNil(t, response.Registrations[1].Participation)
+	assert.Equal(t, uint64(100), response.Registrations[1].Participation.VoteLastValid)
+	require.NotNil(t, response.NextToken)
+	require.NotNil(t, response.ActiveRegistration)
+	assert.Equal(t, uint64(8), response.ActiveRegistration.Round)
+
+	cursor, err := decodeHistoryNext(*response.NextToken)
+	require.NoError(t, err)
+	response, err = si.fetchParticipationHistory(context.Background(), participationHistoryQuery{addr: addr, limit: 2, next: &cursor, atRound: uint64Ptr(5)})
+	require.NoError(t, err)
+	require.Len(t, response.Registrations, 1)
+	assert.Equal(t, uint64(2), response.Registrations[0].Round)
+	require.NotNil(t, response.ActiveRegistration)
+	assert.Equal(t, uint64(5), response.ActiveRegistration.Round)
+
+	// The account was closed after the registration.
+	response, err = si.fetchParticipationHistory(context.Background(), participationHistoryQuery{addr: addr, limit: 2, atRound: uint64Ptr(7)})
+	require.NoError(t, err)
+	assert.Nil(t, response.ActiveRegistration)
+}
+
+func TestSearchForProposersRoundRange(t *testing.T) {
 	testcases := []struct {
-		name        string
-		errString   string
-		mockCall    func(mockIndexer *mocks.IndexerDb, timeout <-chan time.Time)
-		callHandler func(ctx echo.Context, si ServerImplementation) error
+		name     string
+		params   generated.SearchForProposersParams
+		expected *idb.ProposerStatsQuery
 	}{
 		{
-			name:      "SearchForTransactions",
-			errString: errTransactionSearch,
-			mockCall:  transactionFunc,
-			callHandler: func(ctx echo.Context, si ServerImplementation) error {
-				return si.SearchForTransactions(ctx, generated.SearchForTransactionsParams{})
-			},
+			name:     "latest rounds",
+			params:   generated.SearchForProposersParams{},
+			expected: &idb.ProposerStatsQuery{Rounds: 100, Limit: 100},
 		},
 		{
-			name:      "LookupAccountTransactions",
-			errString: errTransactionSearch,
-			mockCall:  transactionFunc,
-			callHandler: func(ctx echo.Context, si ServerImplementation) error {
+			name:     "round range",
+			params:   generated.SearchForProposersParams{MinRound: uint64Ptr(1), MaxRound: uint64Ptr(100)},
+			expected: &idb.ProposerStatsQuery{MinRound: 1, MaxRound: 100, Rounds: 100, Limit: 100},
+		},
+		{
+			name:   "round range too wide",
+			params: generated.SearchForProposersParams{MinRound: uint64Ptr(1), MaxRound: uint64Ptr(101)},
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			ch := make(chan idb.ProposerStatsRow)
+			close(ch)
+			var outCh <-chan idb.ProposerStatsRow = ch
+
+			mockIndexer := &mocks.IndexerDb{}
+			mockIndexer.On("ProposerStats", mock.Anything, mock.Anything).Return(outCh, uint64(10))
+			si := testServerImplementation(mockIndexer)
+			si.opts.MaxProposerStatsRounds = 100
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			require.NoError(t, si.SearchForProposers(c, tc.params))
+			if tc.expected == nil {
+				require.Equal(t, http.StatusBadRequest, rec.Code)
+				assert.Contains(t, rec.Body.String(), errProposerStatsRange)
+				mockIndexer.AssertNotCalled(t, "ProposerStats", mock.Anything, mock.Anything)
+				return
+			}
+			require.Equal(t, http.StatusOK, rec.Code)
+			query := mockIndexer.Calls[0].Arguments.Get(1).(idb.ProposerStatsQuery)
+			assert.Equal(t, *tc.expected, query)
+		})
+	}
+}
+
+func TestLookupProposerStats(t *testing.T) {
+	var addr sdk.Address
+	addr[0] = 1
+
+	testcases := []struct {
+		name     string
+		rows     []idb.ProposerStatsRow
+		expected generated.ProposerStats
+	}{
+		{
+			name: "proposer",
+			rows: []idb.ProposerStatsRow{{Proposer: addr, BlocksProposed: 2, TotalPayout: 3000, FeesCollected: 10, FirstRound: 4, LastRound: 9}},
+			expected: generated.ProposerStats{
+				Address:        addr.String(),
+				BlocksProposed: 2,
+				TotalPayout:    3000,
+				FeesCollected:  10,
+				FirstRound:     uint64Ptr(4),
+				LastRound:      uint64Ptr(9),
+			},
+		},
+		{
+			name:     "no blocks proposed",
+			expected: generated.ProposerStats{Address: addr.String()},
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			ch := make(chan idb.ProposerStatsRow, len(tc.rows))
+			for _, row := range tc.rows {
+				ch <- row
+			}
+			close(ch)
+			var outCh <-chan idb.ProposerStatsRow = ch
+
+			mockIndexer := &mocks.IndexerDb{}
+			mockIndexer.On("ProposerStats", mock.Anything, mock.Anything).Return(outCh, uint64(10))
+			si := testServerImplementation(mockIndexer)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			err := si.LookupProposerStats(c, addr.String(), generated.LookupProposerStatsParams{MinRound: uint64Ptr(1)})
+			require.NoError(t, err)
+			require.Equal(t, http.StatusOK, rec.Code)
+
+			var response generated.ProposerStatsResponse
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+			assert.Equal(t, uint64(10), response.CurrentRound)
+			assert.Equal(t, tc.expected, response.Stats)
+
+			query := mockIndexer.Calls[0].Arguments.Get(1).(idb.ProposerStatsQuery)
+			assert.Equal(t, idb.ProposerStatsQuery{Proposer: &addr, MinRound: 1, Limit: 1}, query)
+		})
+	}
+}
+
+func TestSearchForFeeStats(t *testing.T) {
+	start := time.Unix(1700000000, 0).UTC()
+	proto := string(protocol.ConsensusFuture)
+	rows := []idb.FeeStatsRow{
+		{Round: 7, RoundTime: start.Add(9 * time.Second), Protocol: proto, Fees: []uint64{1000, 2000}, Counts: []uint64{1, 1}, TxnCounter: 130},
+		{Round: 5, RoundTime: start.Add(3 * time.Second), Protocol: proto, Fees: []uint64{0, 1000, 5000}, Counts: []uint64{2, 16, 2}, TxnCounter: 125, FeesCollected: 26000,
+			PrevTxnCounter: uint64Ptr(100), PrevRoundTime: &start},
+	}
+	ch := make(chan idb.FeeStatsRow, len(rows))
+	for _, row := range rows {
+		ch <- row
+	}
+	close(ch)
+	var outCh <-chan idb.FeeStatsRow = ch
+
+	// The aggregate includes a round which isn't in the page.
+	counts := []idb.FeeCountRow{
+		{Protocol: proto, Fee: 0, Count: 2},
+		{Protocol: proto, Fee: 1000, Count: 17},
+		{Protocol: proto, Fee: 2000, Count: 1},
+		{Protocol: proto, Fee: 5000, Count: 2},
+		{Protocol: proto, Fee: 3000, Count: 2},
+	}
+
+	mockIndexer := &mocks.IndexerDb{}
+	mockIndexer.On("FeeStatsStart", mock.Anything).Return(uint64(3), nil)
+	mockIndexer.On("FeeStats", mock.Anything, mock.Anything).Return(outCh, uint64(10))
+	mockIndexer.On("FeeCounts", mock.Anything, mock.Anything).Return(counts, nil)
+	si := testServerImplementation(mockIndexer)
+	si.opts.MaxBlocksLimit = 5
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	err := si.SearchForFeeStats(c, generated.SearchForFeeStatsParams{MinRound: uint64Ptr(4), Limit: uint64Ptr(2)})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var response generated.FeeStatsResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Equal(t, uint64(10), response.CurrentRound)
+	assert.Equal(t, uint64(3), response.StartRound)
+
+	expected := []generated.RoundFeeStats{
+		{
+			// No header deltas, the header of round 6 is missing.
+			Round:     7,
+			Timestamp: uint64(start.Unix()) + 9,
+			Fees: generated.FeeDistribution{
+				TxnCount:         2,
+				MinFee:           1000,
+				MedianFee:        1000,
+				P95Fee:           2000,
+				MaxFee:           2000,
+				AboveMinFeeShare: 0.5,
+			},
+		},
+		{
+			Round:     5,
+			Timestamp: uint64(start.Unix()) + 3,
+			Fees: generated.FeeDistribution{
+				TxnCount:         20,
+				MinFee:           0,
+				MedianFee:        1000,
+				P95Fee:           5000,
+				MaxFee:           5000,
+				AboveMinFeeShare: 0.1,
+			},
+			TotalTxns:     uint64Ptr(25),
+			FeesCollected: 26000,
+			BlockTime:     uint64Ptr(3),
+		},
+	}
+	assert.Equal(t, expected, response.Rounds)
+	expectedAggregate := generated.FeeDistribution{
+		TxnCount:         24,
+		MinFee:           0,
+		MedianFee:        1000,
+		P95Fee:           5000,
+		MaxFee:           5000,
+		AboveMinFeeShare: 5.0 / 24,
+	}
+	assert.Equal(t, expectedAggregate, response.Aggregate)
+
+	// The page is full, the next token resumes before the last round.
+	require.NotNil(t, response.NextToken)
+	assert.Equal(t, idb.EncodeTxnRowNext(5, math.MaxUint32), *response.NextToken)
+
+	query := mockIndexer.Calls[1].Arguments.Get(1).(idb.FeeStatsQuery)
+	assert.Equal(t, idb.FeeStatsQuery{MinRound: 4, Rounds: 5, Limit: 2}, query)
+
+	// The aggregate covers the rounds from min-round, bounded by the limit.
+	query = mockIndexer.Calls[2].Arguments.Get(1).(idb.FeeStatsQuery)
+	assert.Equal(t, idb.FeeStatsQuery{MinRound: 4, MaxRound: 8, Rounds: 5, Limit: 2}, query)
+}
+
+func TestSearchForFeeStatsErrors(t *testing.T) {
+	testcases := []struct {
+		name   string
+		params generated.SearchForFeeStatsParams
+		start  error
+		status int
+		errMsg string
+	}{
+		{
+			name:   "round range too wide",
+			params: generated.SearchForFeeStatsParams{MinRound: uint64Ptr(1), MaxRound: uint64Ptr(6)},
+			status: http.StatusBadRequest,
+			errMsg: errFeeStatsRange,
+		},
+		{
+			name:   "bad next token",
+			params: generated.SearchForFeeStatsParams{Next: strPtr("not a token")},
+			status: http.StatusBadRequest,
+			errMsg: errUnableToParseNext,
+		},
+		{
+			name:   "not enabled",
+			start:  fmt.Errorf("FeeStatsStart() err: %w", idb.ErrorNotInitialized),
+			status: http.StatusNotFound,
+			errMsg: errFeeStatsNotEnabled,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockIndexer := &mocks.IndexerDb{}
+			mockIndexer.On("FeeStatsStart", mock.Anything).Return(uint64(0), tc.start)
+			si := testServerImplementation(mockIndexer)
+			si.opts.MaxBlocksLimit = 5
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			require.NoError(t, si.SearchForFeeStats(c, tc.params))
+			require.Equal(t, tc.status, rec.Code)
+			assert.Contains(t, rec.Body.String(), tc.errMsg)
+			mockIndexer.AssertNotCalled(t, "FeeStats", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestLookupRoundsAtTime(t *testing.T) {
+	at := time.Unix(1700000000, 0).UTC()
+	testcases := []struct {
+		name     string
+		rounds   idb.RoundsAtTime
+		expected generated.RoundsAtTimeResponse
+	}{
+		{
+			name: "between rounds",
+			rounds: idb.RoundsAtTime{
+				Before: &idb.RoundTime{Round: 5, RoundTime: at.Add(-time.Second)},
+				After:  &idb.RoundTime{Round: 6, RoundTime: at.Add(2 * time.Second)},
+			},
+			expected: generated.RoundsAtTimeResponse{
+				CurrentRound:  10,
+				Round:         uint64Ptr(5),
+				RoundTime:     uint64Ptr(1700000000 - 1),
+				NextRound:     uint64Ptr(6),
+				NextRoundTime: uint64Ptr(1700000000 + 2),
+			},
+		},
+		{
+			name:     "before the first round",
+			rounds:   idb.RoundsAtTime{After: &idb.RoundTime{Round: 0, RoundTime: at}},
+			expected: generated.RoundsAtTimeResponse{CurrentRound: 10, NextRound: uint64Ptr(0), NextRoundTime: uint64Ptr(1700000000)},
+		},
+		{
+			name:     "after the latest round",
+			rounds:   idb.RoundsAtTime{Before: &idb.RoundTime{Round: 10, RoundTime: at}},
+			expected: generated.RoundsAtTimeResponse{CurrentRound: 10, Round: uint64Ptr(10), RoundTime: uint64Ptr(1700000000)},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockIndexer := &mocks.IndexerDb{}
+			mockIndexer.On("RoundsAtTime", mock.Anything, at).Return(tc.rounds, uint64(10), nil)
+			si := testServerImplementation(mockIndexer)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			err := si.LookupRoundsAtTime(c, generated.LookupRoundsAtTimeParams{Time: at})
+			require.NoError(t, err)
+			require.Equal(t, http.StatusOK, rec.Code)
+
+			var response generated.RoundsAtTimeResponse
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+			assert.Equal(t, tc.expected, response)
+		})
+	}
+}
+
+func TestLookupAssetBalancesOrderByAmount(t *testing.T) {
+	var addr1, addr2 sdk.Address
+	addr1[0] = 1
+	addr2[0] = 2
+	rows := []idb.AssetBalanceRow{
+		{Address: addr1[:], AssetID: 7, Amount: 500},
+		{Address: addr2[:], AssetID: 7, Amount: 300},
+	}
+	ch := make(chan idb.AssetBalanceRow, len(rows))
+	for _, row := range rows {
+		ch <- row
+	}
+	close(ch)
+	var outCh <-chan idb.AssetBalanceRow = ch
+
+	mockIndexer := &mocks.IndexerDb{}
+	mockIndexer.On("AssetBalances", mock.Anything, mock.Anything).Return(outCh, uint64(10))
+	si := testServerImplementation(mockIndexer)
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	var prev sdk.Address
+	prev[0] = 3
+	next := amountCursor{amount: 900, addr: prev}.encode()
+	order := generated.LookupAssetBalancesParamsOrderAmountDesc
+	err := si.LookupAssetBalances(c, 7, generated.LookupAssetBalancesParams{Order: &order, Next: &next, Limit: uint64Ptr(2)})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var response generated.AssetBalancesResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	require.Len(t, response.Balances, 2)
+	assert.Equal(t, addr1.String(), response.Balances[0].Address)
+
+	// The next token resumes after the smallest holding returned.
+	require.NotNil(t, response.NextToken)
+	cursor, err := decodeAmountNext(*response.NextToken)
+	require.NoError(t, err)
+	assert.Equal(t, amountCursor{amount: 300, addr: addr2}, cursor)
+
+	query := mockIndexer.Calls[0].Arguments.Get(1).(idb.AssetBalanceQuery)
+	assert.True(t, query.OrderByAmount)
+	assert.Equal(t, uint64(900), query.PrevAmount)
+	assert.Equal(t, prev[:], query.PrevAddress)
+}
+
+func TestLookupAssetSupply(t *testing.T) {
+	var creator, reserve, excluded sdk.Address
+	creator[0] = 1
+	reserve[0] = 2
+	excluded[0] = 3
+	row := idb.AssetSupplyRow{
+		AssetID:        7,
+		Creator:        creator[:],
+		Params:         sdk.AssetParams{Total: 1000, Decimals: 2, Reserve: reserve},
+		ReserveAmount:  600,
+		CreatorAmount:  100,
+		FrozenAmount:   50,
+		ExcludedAmount: 150,
+	}
+	excludeList := []string{excluded.String()}
+	badList := []string{"not an address"}
+
+	testcases := []struct {
+		name     string
+		rows     []idb.AssetSupplyRow
+		exclude  *[]string
+		code     int
+		expected generated.AssetSupply
+		errMsg   string
+	}{
+		{
+			name:    "supply",
+			rows:    []idb.AssetSupplyRow{row},
+			exclude: &excludeList,
+			code:    http.StatusOK,
+			expected: generated.AssetSupply{
+				AssetId:           7,
+				Total:             1000,
+				Decimals:          2,
+				Creator:           creator.String(),
+				CreatorAmount:     100,
+				Reserve:           strPtr(reserve.String()),
+				ReserveAmount:     600,
+				FrozenAmount:      50,
+				ExcludedAmount:    150,
+				CirculatingSupply: 250,
+			},
+		},
+		{
+			name:   "asset not found",
+			code:   http.StatusNotFound,
+			errMsg: errNoAssetsFound,
+		},
+		{
+			name:    "bad exclude address",
+			exclude: &badList,
+			code:    http.StatusBadRequest,
+			errMsg:  errUnableToParseAddress,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			ch := make(chan idb.AssetSupplyRow, len(tc.rows))
+			for _, row := range tc.rows {
+				ch <- row
+			}
+			close(ch)
+			var outCh <-chan idb.AssetSupplyRow = ch
+
+			mockIndexer := &mocks.IndexerDb{}
+			mockIndexer.On("AssetSupply", mock.Anything, mock.Anything).Return(outCh, uint64(10))
+			si := testServerImplementation(mockIndexer)
+			si.opts.MaxAccountListSize = 10
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			err := si.LookupAssetSupply(c, 7, generated.LookupAssetSupplyParams{ExcludeAddress: tc.exclude})
+			require.NoError(t, err)
+			require.Equal(t, tc.code, rec.Code)
+			if tc.code != http.StatusOK {
+				assert.Contains(t, rec.Body.String(), tc.errMsg)
+				return
+			}
+
+			var response generated.AssetSupplyResponse
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+			assert.Equal(t, uint64(10), response.CurrentRound)
+			assert.Equal(t, tc.expected, response.Supply)
+
+			query := mockIndexer.Calls[0].Arguments.Get(1).(idb.AssetSupplyQuery)
+			assert.Equal(t, idb.AssetSupplyQuery{AssetID: 7, Exclude: [][]byte{excluded[:]}}, query)
+		})
+	}
+}
+
+func TestSearchForTransactionStats(t *testing.T) {
+	bucket := time.Date(2024, 6, 1, 13, 0, 0, 0, time.UTC)
+	rows := []idb.TxnStatsRow{
+		{
+			Bucket:    bucket,
+			MinRound:  100,
+			MaxRound:  120,
+			Txns:      map[idb.TxnTypeEnum]uint64{idb.TypeEnumPay: 5, idb.TypeEnumApplication: 3},
+			InnerTxns: 2,
+			Fees:      8000,
+			Senders:   4,
+		},
+		{Bucket: bucket.Add(time.Hour), MinRound: 121, MaxRound: 130},
+	}
+	ch := make(chan idb.TxnStatsRow, len(rows))
+	for _, row := range rows {
+		ch <- row
+	}
+	close(ch)
+	var outCh <-chan idb.TxnStatsRow = ch
+
+	mockIndexer := &mocks.IndexerDb{}
+	mockIndexer.On("TxnStatsStart", mock.Anything).Return(uint64(90), nil)
+	mockIndexer.On("TxnStats", mock.Anything, mock.Anything).Return(outCh, uint64(130))
+	si := testServerImplementation(mockIndexer)
+	si.opts.MaxBlocksLimit = 2
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	next := encodeTxnStatsNext(bucket.Add(-time.Hour))
+	params := generated.SearchForTransactionStatsParams{
+		Interval: generated.Hour,
+		MinRound: uint64Ptr(100),
+		Limit:    uint64Ptr(10),
+		Next:     &next,
+	}
+	err := si.SearchForTransactionStats(c, params)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var response generated.TransactionStatsResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Equal(t, uint64(130), response.CurrentRound)
+	assert.Equal(t, uint64(90), response.StartRound)
+	require.Len(t, response.Stats, 2)
+	expected := generated.TransactionStats{
+		Bucket:        uint64(bucket.Unix()),
+		MinRound:      100,
+		MaxRound:      120,
+		TxnCounts:     generated.TransactionTypeCounts{Pay: 5, Appl: 3},
+		InnerTxns:     2,
+		TotalFees:     8000,
+		ActiveSenders: 4,
+	}
+	assert.Equal(t, expected, response.Stats[0])
+
+	// The page is full, the next token resumes after the last bucket.
+	require.NotNil(t, response.NextToken)
+	after, err := decodeTxnStatsNext(*response.NextToken)
+	require.NoError(t, err)
+	assert.Equal(t, bucket.Add(time.Hour), after)
+
+	query := mockIndexer.Calls[1].Arguments.Get(1).(idb.TxnStatsQuery)
+	expectedQuery := idb.TxnStatsQuery{
+		Interval:    idb.TxnStatsHour,
+		MinRound:    100,
+		AfterBucket: bucket.Add(-time.Hour),
+		Limit:       2,
+	}
+	assert.Equal(t, expectedQuery, query)
+}
+
+func TestSearchForTransactionStatsNotEnabled(t *testing.T) {
+	mockIndexer := &mocks.IndexerDb{}
+	mockIndexer.On("TxnStatsStart", mock.Anything).Return(uint64(0), fmt.Errorf("TxnStatsStart() err: %w", idb.ErrorNotInitialized))
+	si := testServerImplementation(mockIndexer)
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	err := si.SearchForTransactionStats(c, generated.SearchForTransactionStatsParams{Interval: generated.Hour})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusNotFound, rec.Code)
+	assert.Contains(t, rec.Body.String(), errTxnStatsNotEnabled)
+	mockIndexer.AssertNotCalled(t, "TxnStats", mock.Anything, mock.Anything)
+}
+
+func TestSearchForTransactionStatsBadRequest(t *testing.T) {
+	bad := "not a token"
+	testcases := []struct {
+		name   string
+		params generated.SearchForTransactionStatsParams
+		errMsg string
+	}{
+		{
+			name:   "unknown interval",
+			params: generated.SearchForTransactionStatsParams{Interval: "week"},
+			errMsg: errUnknownStatsInterval,
+		},
+		{
+			name:   "inverted rounds",
+			params: generated.SearchForTransactionStatsParams{Interval: generated.Day, MinRound: uint64Ptr(10), MaxRound: uint64Ptr(5)},
+			errMsg: errInvalidRoundMinMax,
+		},
+		{
+			name:   "bad next token",
+			params: generated.SearchForTransactionStatsParams{Interval: generated.Day, Next: &bad},
+			errMsg: errUnableToParseNext,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			si := testServerImplementation(&mocks.IndexerDb{})
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			err := si.SearchForTransactionStats(c, tc.params)
+			require.NoError(t, err)
+			require.Equal(t, http.StatusBadRequest, rec.Code)
+			assert.Contains(t, rec.Body.String(), tc.errMsg)
+		})
+	}
+}
+
+func TestLookupApplicationLogsByID(t *testing.T) {
+	mockIndexer := &mocks.IndexerDb{}
+	si := testServerImplementation(mockIndexer)
+	si.EnableAddressSearchRoundRewind = true
+
+	txnBytes := loadResourceFileOrPanic("test_resources/app_call_logs.txn")
+	var stxn sdk.SignedTxnWithAD
+	err := msgpack.Decode(txnBytes, &stxn)
+	assert.NoError(t, err)
+
+	roundTime := time.Now()
+	ch := make(chan idb.TxnRow, 1)
+	ch <- idb.TxnRow{
+		Round:     1,
+		Intra:     2,
+		RoundTime: roundTime,
+		Txn:       &stxn,
+		AssetID:   0,
+		Extra: idb.TxnExtra{
+			AssetCloseAmount: 0,
+		},
+		Error: nil,
+	}
+
+	close(ch)
+	var outCh <-chan idb.TxnRow = ch
+	var round uint64 = 1
+	mockIndexer.On("Transactions", mock.Anything, mock.Anything).Return(outCh, round)
+
+	appIdx := stxn.Txn.ApplicationID
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetPath("/v2/applications/:appIdx/logs")
+	c.SetParamNames("appIdx")
+	c.SetParamValues(fmt.Sprintf("%d", appIdx))
+
+	params := generated.LookupApplicationLogsByIDParams{}
+	err = si.LookupApplicationLogsByID(c, 444, params)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var response generated.ApplicationLogsResponse
+	err = json.Unmarshal(rec.Body.Bytes(), &response)
+	assert.NoError(t, err)
+
+	assert.Equal(t, uint64(appIdx), response.ApplicationId)
+	assert.NotNil(t, response.LogData)
+	ld := *response.LogData
+	assert.Equal(t, 1, len(ld))
+	assert.Equal(t, sdkcrypto.TransactionIDString(stxn.Txn), ld[0].Txid)
+	assert.Equal(t, len(stxn.ApplyData.EvalDelta.Logs), len(ld[0].Logs))
+	for i, log := range ld[0].Logs {
+		assert.Equal(t, []byte(stxn.ApplyData.EvalDelta.Logs[i]), log)
+	}
+}
+
+func TestLookupApplicationLogsByIDWithLimit(t *testing.T) {
+	mockIndexer := &mocks.IndexerDb{}
+	si := testServerImplementation(mockIndexer)
+	si.EnableAddressSearchRoundRewind = true
+
+	txnBytes := loadResourceFileOrPanic("test_resources/app_call_logs.txn")
+	var stxn sdk.SignedTxnWithAD
+	err := msgpack.Decode(txnBytes, &stxn)
+	assert.NoError(t, err)
+
+	roundTime := time.Now()
+	ch := make(chan idb.TxnRow, 1)
+	ch <- idb.TxnRow{
+		Round:     1,
+		Intra:     2,
+		RoundTime: roundTime,
+		Txn:       &stxn,
+		AssetID:   0,
+		Extra: idb.TxnExtra{
+			AssetCloseAmount: 0,
+		},
+		Error: nil,
+	}
+
+	close(ch)
+	var outCh <-chan idb.TxnRow = ch
+	var round uint64 = 1
+
+	// Verify that the filter has RequireApplicationLogs set to true
+	mockIndexer.On("Transactions", mock.Anything, mock.MatchedBy(func(filter idb.TransactionFilter) bool {
+		return filter.RequireApplicationLogs == true && filter.Limit == 1
+	})).Return(outCh, round)
+
+	appIdx := stxn.Txn.ApplicationID
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetPath("/v2/applications/:appIdx/logs")
+	c.SetParamNames("appIdx")
+	c.SetParamValues(fmt.Sprintf("%d", appIdx))
+
+	// Test with limit=1
+	params := generated.LookupApplicationLogsByIDParams{Limit: uint64Ptr(1)}
+	err = si.LookupApplicationLogsByID(c, uint64(appIdx), params)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var response generated.ApplicationLogsResponse
+	err = json.Unmarshal(rec.Body.Bytes(), &response)
+	assert.NoError(t, err)
+
+	assert.Equal(t, uint64(appIdx), response.ApplicationId)
+	assert.NotNil(t, response.LogData)
+	ld := *response.LogData
+	assert.Equal(t, 1, len(ld))
+	assert.Equal(t, sdkcrypto.TransactionIDString(stxn.Txn), ld[0].Txid)
+	assert.Equal(t, len(stxn.ApplyData.EvalDelta.Logs), len(ld[0].Logs))
+	for i, log := range ld[0].Logs {
+		assert.Equal(t, []byte(stxn.ApplyData.EvalDelta.Logs[i]), log)
+	}
+}
+
+func TestLookupApplicationGlobalStateHistory(t *testing.T) {
+	mockIndexer := &mocks.IndexerDb{}
+	si := testServerImplementation(mockIndexer)
+
+	ch := make(chan idb.AppGlobalStateHistoryRow, 3)
+	ch <- idb.AppGlobalStateHistoryRow{
+		Round: 5,
+		Intra: 1,
+		Txid:  "TXID1",
+		Delta: sdk.ValueDelta{Action: sdk.SetUintAction, Uint: 1},
+	}
+	ch <- idb.AppGlobalStateHistoryRow{
+		Round:    7,
+		Intra:    0,
+		Txid:     "TXID2",
+		Delta:    sdk.ValueDelta{Action: sdk.SetBytesAction, Bytes: "abc"},
+		Previous: &sdk.TealValue{Type: sdk.TealUintType, Uint: 1},
+	}
+	close(ch)
+	var outCh <-chan idb.AppGlobalStateHistoryRow = ch
+	mockIndexer.On("AppGlobalStateHistory", mock.Anything, mock.MatchedBy(func(q idb.AppGlobalStateHistoryQuery) bool {
+		return q.ApplicationID == 10 && string(q.Key) == "counter" && q.Limit == 2 && q.MinRound == 3
+	})).Return(outCh, uint64(8))
+	mockIndexer.On("AppGlobalStateHistoryStart", mock.Anything).Return(uint64(4), nil)
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	params := generated.LookupApplicationGlobalStateHistoryParams{
+		Key:      "str:counter",
+		Limit:    uint64Ptr(2),
+		MinRound: uint64Ptr(3),
+	}
+	require.NoError(t, si.LookupApplicationGlobalStateHistory(c, 10, params))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var response generated.GlobalStateHistoryResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Equal(t, uint64(10), response.ApplicationId)
+	assert.Equal(t, uint64(8), response.CurrentRound)
+	assert.Equal(t, uint64Ptr(4), response.StartRound)
+	require.NotNil(t, response.NextToken)
+	assert.Equal(t, idb.EncodeTxnRowNext(7, 0), *response.NextToken)
+
+	expected := []generated.GlobalStateChange{
+		{
+			Round:            5,
+			Txid:             "TXID1",
+			IntraRoundOffset: 1,
+			Action:           uint64(sdk.SetUintAction),
+			NewValue:         &generated.TealValue{Type: uint64(sdk.TealUintType), Uint: 1},
+		},
+		{
+			Round:            7,
+			Txid:             "TXID2",
+			IntraRoundOffset: 0,
+			Action:           uint64(sdk.SetBytesAction),
+			OldValue:         &generated.TealValue{Type: uint64(sdk.TealUintType), Uint: 1},
+			NewValue:         &generated.TealValue{Type: uint64(sdk.TealBytesType), Bytes: "YWJj"},
+		},
+	}
+	assert.Equal(t, expected, response.Changes)
+}
+
+func TestLookupApplicationGlobalStateHistoryBadKey(t *testing.T) {
+	si := testServerImplementation(&mocks.IndexerDb{})
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	params := generated.LookupApplicationGlobalStateHistoryParams{Key: "counter"}
+	require.NoError(t, si.LookupApplicationGlobalStateHistory(c, 10, params))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestLookupApplicationBoxHistory(t *testing.T) {
+	mockIndexer := &mocks.IndexerDb{}
+	si := testServerImplementation(mockIndexer)
+
+	ch := make(chan idb.AppBoxHistoryRow, 2)
+	ch <- idb.AppBoxHistoryRow{Round: 5, Value: []byte("value")}
+	ch <- idb.AppBoxHistoryRow{Round: 7}
+	close(ch)
+	var outCh <-chan idb.AppBoxHistoryRow = ch
+	// The next token resumes after round 4.
+	mockIndexer.On("AppBoxHistoryStart", mock.Anything).Return(uint64(2), nil)
+	mockIndexer.On("AppBoxHistory", mock.Anything, mock.MatchedBy(func(q idb.AppBoxHistoryQuery) bool {
+		return q.ApplicationID == 10 && string(q.BoxName) == "box" && q.Limit == 2 && q.MinRound == 5
+	})).Return(outCh, uint64(8))
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	params := generated.LookupApplicationBoxHistoryParams{
+		Name:     "str:box",
+		Limit:    uint64Ptr(2),
+		MinRound: uint64Ptr(3),
+		Next:     strPtr(idb.EncodeTxnRowNext(4, math.MaxUint32)),
+	}
+	require.NoError(t, si.LookupApplicationBoxHistory(c, 10, params))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var response generated.BoxHistoryResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Equal(t, uint64(10), response.ApplicationId)
+	assert.Equal(t, []byte("box"), response.Name)
+	assert.Equal(t, uint64(8), response.CurrentRound)
+	assert.Equal(t, uint64(2), response.StartRound)
+	require.NotNil(t, response.NextToken)
+	assert.Equal(t, idb.EncodeTxnRowNext(7, math.MaxUint32), *response.NextToken)
+
+	value := []byte("value")
+	expected := []generated.BoxHistoryEntry{
+		{Round: 5, Value: &value},
+		{Round: 7, Deleted: true},
+	}
+	assert.Equal(t, expected, response.Changes)
+}
+
+func TestLookupApplicationBoxHistoryNotEnabled(t *testing.T) {
+	mockIndexer := &mocks.IndexerDb{}
+	mockIndexer.On("AppBoxHistoryStart", mock.Anything).Return(uint64(0), fmt.Errorf("AppBoxHistoryStart() err: %w", idb.ErrorNotInitialized))
+	si := testServerImplementation(mockIndexer)
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	require.NoError(t, si.LookupApplicationBoxHistory(c, 10, generated.LookupApplicationBoxHistoryParams{Name: "str:box"}))
+	require.Equal(t, http.StatusNotFound, rec.Code)
+	assert.Contains(t, rec.Body.String(), errBoxHistoryNotEnabled)
+	mockIndexer.AssertNotCalled(t, "AppBoxHistory", mock.Anything, mock.Anything)
+}
+
+func TestLookupTransactionGroup(t *testing.T) {
+	mockIndexer := &mocks.IndexerDb{}
+	si := testServerImplementation(mockIndexer)
+
+	txnBytes := loadResourceFileOrPanic("test_resources/app_call_logs.txn")
+	var stxn sdk.SignedTxnWithAD
+	require.NoError(t, msgpack.Decode(txnBytes, &stxn))
+
+	group := sdk.Digest{0xfb, 0xff}
+	ch := make(chan idb.TxnRow, 1)
+	ch <- idb.TxnRow{
+		Round:     3,
+		Intra:     2,
+		RoundTime: time.Unix(1000, 0),
+		Txn:       &stxn,
+	}
+	close(ch)
+	var outCh <-chan idb.TxnRow = ch
+	mockIndexer.On("TransactionGroup", mock.Anything, idb.TransactionGroupQuery{GroupID: group[:], Txid: "TXID"}).
+		Return(outCh, uint64(5))
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	// The URL-safe alphabet is accepted.
+	groupID := base64.URLEncoding.EncodeToString(group[:])
+	require.NoError(t, si.LookupTransactionGroup(c, groupID, generated.LookupTransactionGroupParams{Txid: strPtr("TXID")}))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var response generated.TransactionGroupResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Equal(t, group[:], response.GroupId)
+	assert.Equal(t, uint64(3), response.Round)
+	assert.Equal(t, uint64(1000), response.RoundTime)
+	assert.Equal(t, uint64(5), response.CurrentRound)
+	require.Len(t, response.Transactions, 1)
+	assert.Equal(t, sdkcrypto.TransactionIDString(stxn.Txn), *response.Transactions[0].Id)
+}
+
+func TestLookupTransactionGroupErrors(t *testing.T) {
+	mockIndexer := &mocks.IndexerDb{}
+	si := testServerImplementation(mockIndexer)
+
+	ch := make(chan idb.TxnRow)
+	close(ch)
+	var outCh <-chan idb.TxnRow = ch
+	mockIndexer.On("TransactionGroup", mock.Anything, mock.Anything).Return(outCh, uint64(5))
+
+	testcases := []struct {
+		name    string
+		groupID string
+		code    int
+	}{
+		{"bad encoding", "not base64!", http.StatusBadRequest},
+		{"bad length", base64.StdEncoding.EncodeToString([]byte("short")), http.StatusBadRequest},
+		{"not found", base64.StdEncoding.EncodeToString(make([]byte, 32)), http.StatusNotFound},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			require.NoError(t, si.LookupTransactionGroup(c, tc.groupID, generated.LookupTransactionGroupParams{}))
+			assert.Equal(t, tc.code, rec.Code)
+		})
+	}
+}
+
+func TestLookupInnerTransaction(t *testing.T) {
+	txnBytes := loadResourceFileOrPanic("test_resources/app_call_inner.txn")
+	var stxn sdk.SignedTxnWithAD
+	require.NoError(t, msgpack.Decode(txnBytes, &stxn))
+	rootTxid := sdkcrypto.TransactionIDString(stxn.Txn)
+
+	testcases := []struct {
+		name  string
+		txid  string
+		code  int
+		inner int
+	}{
+		{"inner", rootTxid + "/inner/1", http.StatusOK, 1},
+		{"out of range", rootTxid + "/inner/3", http.StatusNotFound, 0},
+		{"nested out of range", rootTxid + "/inner/1/0", http.StatusNotFound, 0},
+		{"bad path", rootTxid + "/inner/x", http.StatusBadRequest, 0},
+		{"empty path", rootTxid + "/inner/", http.StatusBadRequest, 0},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockIndexer := &mocks.IndexerDb{}
+			si := testServerImplementation(mockIndexer)
+
+			ch := make(chan idb.TxnRow, 1)
+			ch <- idb.TxnRow{Round: 1, Intra: 2, RoundTime: time.Now(), Txn: &stxn}
+			close(ch)
+			var outCh <-chan idb.TxnRow = ch
+			mockIndexer.On("Transactions", mock.Anything, mock.MatchedBy(func(filter idb.TransactionFilter) bool {
+				return filter.Txid == rootTxid
+			})).Return(outCh, uint64(1))
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			require.NoError(t, si.LookupTransaction(c, tc.txid))
+			require.Equal(t, tc.code, rec.Code)
+			if tc.code != http.StatusOK {
+				return
+			}
+
+			var response generated.TransactionResponse
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+			expected := (*loadTransactionFromFile("test_resources/app_call_inner.response").InnerTxns)[tc.inner]
+			require.NotNil(t, response.Transaction.InnerId)
+			assert.Equal(t, tc.txid, *response.Transaction.InnerId)
+			assert.Equal(t, *expected.InnerId, *response.Transaction.InnerId)
+			assert.Equal(t, expected.TxType, response.Transaction.TxType)
+			assert.Equal(t, expected.IntraRoundOffset, response.Transaction.IntraRoundOffset)
+		})
+	}
+}
+
+func TestTimeouts(t *testing.T) {
+	// function pointers to execute the different DB operations. We really only
+	// care that they timeout with WaitUntil, but the return arguments need to
+	// be correct to avoid a panic.
+	mostMockFunctions := func(method string) func(mockIndexer *mocks.IndexerDb, timeout <-chan time.Time) {
+		return func(mockIndexer *mocks.IndexerDb, timeout <-chan time.Time) {
+			mockIndexer.
+				On(method, mock.Anything, mock.Anything, mock.Anything).
+				WaitUntil(timeout).
+				Return(nil, uint64(0))
+		}
+	}
+	transactionFunc := mostMockFunctions("Transactions")
+	applicationsFunc := mostMockFunctions("Applications")
+	accountsFunc := mostMockFunctions("GetAccounts")
+	assetsFunc := mostMockFunctions("Assets")
+	balancesFunc := mostMockFunctions("AssetBalances")
+	blockFunc := func(mockIndexer *mocks.IndexerDb, timeout <-chan time.Time) {
+		mockIndexer.
+			On("GetBlock", mock.Anything, mock.Anything, mock.Anything).
+			WaitUntil(timeout).
+			Return(sdk.BlockHeader{}, nil, nil)
+	}
+	healthFunc := func(mockIndexer *mocks.IndexerDb, timeout <-chan time.Time) {
+		mockIndexer.
+			On("Health", mock.Anything, mock.Anything, mock.Anything).
+			WaitUntil(timeout).
+			Return(idb.Health{}, nil)
+	}
+
+	// Call each of the handlers and let the database timeout.
+	testcases := []struct {
+		name        string
+		errString   string
+		mockCall    func(mockIndexer *mocks.IndexerDb, timeout <-chan time.Time)
+		callHandler func(ctx echo.Context, si ServerImplementation) error
+	}{
+		{
+			name:      "SearchForTransactions",
+			errString: errTransactionSearch,
+			mockCall:  transactionFunc,
+			callHandler: func(ctx echo.Context, si ServerImplementation) error {
+				return si.SearchForTransactions(ctx, generated.SearchForTransactionsParams{})
+			},
+		},
+		{
+			name:      "LookupAccountTransactions",
+			errString: errTransactionSearch,
+			mockCall:  transactionFunc,
+			callHandler: func(ctx echo.Context, si ServerImplementation) error {
 				return si.LookupAccountTransactions(ctx, "MONEYMBRSMUAM2NGL6PCEQEDVHFWAQB6DU47NUS6P5DJM4OJFN7E7DSVBA", generated.LookupAccountTransactionsParams{})
 			},
 		},
@@ -1505,6 +2904,34 @@ func TestBigNumbers(t *testing.T) {
 				return si.LookupAccountCreatedAssets(ctx, "10", generated.LookupAccountCreatedAssetsParams{AssetId: uint64Ptr(uint64(math.MaxInt64 + 1))})
 			},
 		},
+		{
+			name:      "LookupAccountBalanceHistory",
+			errString: errValueExceedingInt64,
+			callHandler: func(ctx echo.Context, si ServerImplementation) error {
+				return si.LookupAccountBalanceHistory(ctx, "10", generated.LookupAccountBalanceHistoryParams{AssetId: uint64Ptr(uint64(math.MaxInt64 + 1))})
+			},
+		},
+		{
+			name:      "LookupApplicationGlobalStateHistory",
+			errString: errValueExceedingInt64,
+			callHandler: func(ctx echo.Context, si ServerImplementation) error {
+				return si.LookupApplicationGlobalStateHistory(ctx, math.MaxInt64+1, generated.LookupApplicationGlobalStateHistoryParams{Key: "str:counter"})
+			},
+		},
+		{
+			name:      "LookupApplicationBoxHistory",
+			errString: errValueExceedingInt64,
+			callHandler: func(ctx echo.Context, si ServerImplementation) error {
+				return si.LookupApplicationBoxHistory(ctx, math.MaxInt64+1, generated.LookupApplicationBoxHistoryParams{Name: "str:box"})
+			},
+		},
+		{
+			name:      "LookupApplicationEvents",
+			errString: errValueExceedingInt64,
+			callHandler: func(ctx echo.Context, si ServerImplementation) error {
+				return si.LookupApplicationEvents(ctx, math.MaxInt64+1, generated.LookupApplicationEventsParams{})
+			},
+		},
 	}
 
 	for _, tc := range testcases {
@@ -1618,3 +3045,185 @@ func TestFetchBlock(t *testing.T) {
 		})
 	}
 }
+
+func TestExportFormatFromAccept(t *testing.T) {
+	testcases := []struct {
+		accept   string
+		expected exportFormat
+	}{
+		{"", exportNone},
+		{"application/json", exportNone},
+		{"*/*", exportNone},
+		{"application/x-ndjson", exportNDJSON},
+		{"text/csv", exportCSV},
+		{"text/csv; charset=utf-8", exportCSV},
+		{"text/html, text/csv;q=0.9, */*;q=0.8", exportCSV},
+		{"application/json, text/csv", exportNone},
+		{"text/csv;q=0, application/x-ndjson", exportNDJSON},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.accept, func(t *testing.T) {
+			assert.Equal(t, tc.expected, exportFormatFromAccept(tc.accept))
+		})
+	}
+}
+
+func TestLookupAssetBalancesExport(t *testing.T) {
+	var addr1, addr2 sdk.Address
+	addr1[0] = 1
+	addr2[0] = 2
+	rows := []idb.AssetBalanceRow{
+		{Address: addr1[:], AssetID: 7, Amount: 500, CreatedRound: uint64Ptr(3)},
+		{Address: addr2[:], AssetID: 7, Amount: 300, Frozen: true, CreatedRound: uint64Ptr(4)},
+	}
+
+	testcases := []struct {
+		accept      string
+		contentType string
+		expected    string
+	}{
+		{
+			accept:      "application/x-ndjson",
+			contentType: "application/x-ndjson",
+			expected: fmt.Sprintf(`{"address":"%s","amount":500,"is-frozen":false,"opted-in-at-round":3}
+{"address":"%s","amount":300,"is-frozen":true,"opted-in-at-round":4}
+`, addr1, addr2),
+		},
+		{
+			accept:      "text/csv",
+			contentType: "text/csv; charset=utf-8",
+			expected: fmt.Sprintf(`address,amount,is-frozen,opted-in-at-round,opted-out-at-round,deleted
+%s,500,false,3,,false
+%s,300,true,4,,false
+`, addr1, addr2),
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.accept, func(t *testing.T) {
+			ch := make(chan idb.AssetBalanceRow, len(rows))
+			for _, row := range rows {
+				ch <- row
+			}
+			close(ch)
+			var outCh <-chan idb.AssetBalanceRow = ch
+
+			mockIndexer := &mocks.IndexerDb{}
+			mockIndexer.On("AssetBalances", mock.Anything, mock.Anything).Return(outCh, uint64(10))
+			si := testServerImplementation(mockIndexer)
+			si.opts.MaxExportLimit = 100000
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			req.Header.Set(echo.HeaderAccept, tc.accept)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			require.NoError(t, si.LookupAssetBalances(c, 7, generated.LookupAssetBalancesParams{}))
+			require.Equal(t, http.StatusOK, rec.Code)
+			assert.Equal(t, tc.contentType, rec.Header().Get(echo.HeaderContentType))
+			assert.Equal(t, tc.expected, rec.Body.String())
+
+			// Exports aren't paginated with the regular limits.
+			query := mockIndexer.Calls[0].Arguments.Get(1).(idb.AssetBalanceQuery)
+			assert.Equal(t, uint64(100000), query.Limit)
+		})
+	}
+}
+
+func TestSearchForTransactionsExportCSV(t *testing.T) {
+	var sender, receiver sdk.Address
+	sender[0] = 1
+	receiver[0] = 2
+	stxn := sdk.SignedTxnWithAD{
+		SignedTxn: sdk.SignedTxn{
+			Txn: sdk.Transaction{
+				Type: sdk.PaymentTx,
+				Header: sdk.Header{
+					Sender: sender,
+					Fee:    1000,
+					Note:   []byte("invoice 17"),
+				},
+				PaymentTxnFields: sdk.PaymentTxnFields{
+					Receiver: receiver,
+					Amount:   250000,
+				},
+			},
+		},
+	}
+
+	ch := make(chan idb.TxnRow, 1)
+	ch <- idb.TxnRow{Round: 5, Intra: 2, RoundTime: time.Unix(1700000000, 0), Txn: &stxn}
+	close(ch)
+	var outCh <-chan idb.TxnRow = ch
+
+	mockIndexer := &mocks.IndexerDb{}
+	mockIndexer.On("Transactions", mock.Anything, mock.Anything).Return(outCh, uint64(10))
+	si := testServerImplementation(mockIndexer)
+	si.opts.MaxExportLimit = 100000
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set(echo.HeaderAccept, "text/csv")
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	require.NoError(t, si.SearchForTransactions(c, generated.SearchForTransactionsParams{Limit: uint64Ptr(20)}))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	txid := sdkcrypto.TransactionIDString(stxn.Txn)
+	note := base64.StdEncoding.EncodeToString([]byte("invoice 17"))
+	expected := "id,confirmed-round,round-time,intra-round-offset,tx-type,sender,receiver,amount,asset-id,application-id,close-to,close-amount,fee,group,note\n" +
+		fmt.Sprintf("%s,5,1700000000,2,pay,%s,%s,250000,,,,,1000,,%s\n", txid, sender, receiver, note)
+	assert.Equal(t, expected, rec.Body.String())
+
+	filter := mockIndexer.Calls[0].Arguments.Get(1).(idb.TransactionFilter)
+	assert.Equal(t, uint64(20), filter.Limit)
+}
+
+func TestSearchForAccountsExportErrors(t *testing.T) {
+	t.Run("before the first row", func(t *testing.T) {
+		ch := make(chan idb.AccountRow, 1)
+		ch <- idb.AccountRow{Error: fmt.Errorf("database is gone")}
+		close(ch)
+		var outCh <-chan idb.AccountRow = ch
+
+		mockIndexer := &mocks.IndexerDb{}
+		mockIndexer.On("GetAccounts", mock.Anything, mock.Anything).Return(outCh, uint64(10))
+		si := testServerImplementation(mockIndexer)
+
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set(echo.HeaderAccept, "application/x-ndjson")
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		require.NoError(t, si.SearchForAccounts(c, generated.SearchForAccountsParams{}))
+		require.Equal(t, http.StatusInternalServerError, rec.Code)
+		assert.Contains(t, rec.Body.String(), "database is gone")
+	})
+
+	t.Run("after the first row", func(t *testing.T) {
+		ch := make(chan idb.AccountRow, 2)
+		ch <- idb.AccountRow{Account: generated.Account{Address: "A", Status: "Offline"}}
+		ch <- idb.AccountRow{Error: fmt.Errorf("database is gone")}
+		close(ch)
+		var outCh <-chan idb.AccountRow = ch
+
+		mockIndexer := &mocks.IndexerDb{}
+		mockIndexer.On("GetAccounts", mock.Anything, mock.Anything).Return(outCh, uint64(10))
+		si := testServerImplementation(mockIndexer)
+		si.log, _ = test.NewNullLogger()
+
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set(echo.HeaderAccept, "text/csv")
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		// The response can't be completed, so the connection is aborted.
+		assert.PanicsWithValue(t, http.ErrAbortHandler, func() {
+			_ = si.SearchForAccounts(c, generated.SearchForAccountsParams{})
+		})
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+}
@@ -0,0 +1,83 @@
+package middlewares
+
+import (
+	"errors"
+	"fmt"
+	"mime"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/algorand/go-codec/codec"
+
+	"github.com/algorand/go-algorand-sdk/v2/encoding/msgpack"
+)
+
+// formatParameter is the query parameter selecting the response encoding,
+// either "json" or "msgpack". It takes precedence over the Accept header.
+const formatParameter = "format"
+
+// MakeMsgpack constructs the msgpack middleware function. Handlers keep
+// calling ctx.JSON, responses are encoded with canonical msgpack instead when
+// the client asks for it with the format parameter or the Accept header.
+func MakeMsgpack() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			if !wantsMsgpack(ctx) {
+				return next(ctx)
+			}
+
+			mc := &msgpackContext{Context: ctx}
+			err := next(mc)
+
+			// Errors returned by the handler would otherwise be written as JSON
+			// by the echo error handler.
+			var he *echo.HTTPError
+			if errors.As(err, &he) && !ctx.Response().Committed {
+				message := he.Message
+				if m, ok := message.(error); ok {
+					message = m.Error()
+				}
+				return mc.JSON(he.Code, echo.Map{"message": fmt.Sprint(message)})
+			}
+			return err
+		}
+	}
+}
+
+// wantsMsgpack returns true if the format parameter is msgpack, or if there is
+// no format parameter and msgpack is the first supported media type in the
+// Accept header.
+func wantsMsgpack(ctx echo.Context) bool {
+	if format := ctx.QueryParam(formatParameter); format != "" {
+		return format == "msgpack"
+	}
+
+	for _, part := range strings.Split(ctx.Request().Header.Get(echo.HeaderAccept), ",") {
+		mediaType, _, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil {
+			continue
+		}
+		switch mediaType {
+		case echo.MIMEApplicationMsgpack:
+			return true
+		case echo.MIMEApplicationJSON, "application/*", "*/*":
+			return false
+		}
+	}
+	return false
+}
+
+// msgpackContext is an echo.Context writing JSON responses as msgpack.
+type msgpackContext struct {
+	echo.Context
+}
+
+// JSON encodes the response with the same canonical msgpack settings used by
+// the SDK, which honor the field names and omitempty of the json tags.
+func (mc *msgpackContext) JSON(code int, i interface{}) error {
+	res := mc.Response()
+	res.Header().Set(echo.HeaderContentType, echo.MIMEApplicationMsgpack)
+	res.WriteHeader(code)
+	return codec.NewEncoder(res, msgpack.CodecHandle).Encode(i)
+}
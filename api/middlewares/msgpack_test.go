@@ -0,0 +1,93 @@
+package middlewares
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/indexer/v3/api/generated/v2"
+
+	"github.com/algorand/go-algorand-sdk/v2/encoding/msgpack"
+)
+
+func TestMakeMsgpack(t *testing.T) {
+	response := generated.AssetBalancesResponse{
+		Balances: []generated.MiniAssetHolding{
+			{Address: "A", Amount: 5, IsFrozen: true},
+		},
+		CurrentRound: 10,
+		NextToken:    nil,
+	}
+
+	handler := func(c echo.Context) error {
+		return c.JSON(http.StatusOK, response)
+	}
+
+	testcases := []struct {
+		name    string
+		target  string
+		accept  string
+		msgpack bool
+	}{
+		{name: "default", target: "/"},
+		{name: "accept msgpack", target: "/", accept: "application/msgpack", msgpack: true},
+		{name: "accept json first", target: "/", accept: "application/json, application/msgpack"},
+		{name: "format parameter", target: "/?format=msgpack", msgpack: true},
+		{name: "format parameter overrides accept", target: "/?format=json", accept: "application/msgpack"},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, tc.target, nil)
+			if tc.accept != "" {
+				req.Header.Set(echo.HeaderAccept, tc.accept)
+			}
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			require.NoError(t, MakeMsgpack()(handler)(c))
+			require.Equal(t, http.StatusOK, rec.Code)
+
+			if !tc.msgpack {
+				assert.Equal(t, echo.MIMEApplicationJSONCharsetUTF8, rec.Header().Get(echo.HeaderContentType))
+				return
+			}
+			assert.Equal(t, echo.MIMEApplicationMsgpack, rec.Header().Get(echo.HeaderContentType))
+
+			// The encoding is canonical and round-trips with the SDK decoder.
+			assert.Equal(t, msgpack.Encode(response), rec.Body.Bytes())
+			var decoded generated.AssetBalancesResponse
+			require.NoError(t, msgpack.Decode(rec.Body.Bytes(), &decoded))
+			assert.Equal(t, response, decoded)
+
+			// Field names and omitempty come from the json tags.
+			var fields map[string]interface{}
+			require.NoError(t, msgpack.Decode(rec.Body.Bytes(), &fields))
+			assert.Contains(t, fields, "current-round")
+			assert.NotContains(t, fields, "next-token")
+		})
+	}
+}
+
+func TestMakeMsgpackHTTPError(t *testing.T) {
+	handler := func(c echo.Context) error {
+		return echo.NewHTTPError(http.StatusInternalServerError, DBUnavailableError)
+	}
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/?format=msgpack", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	require.NoError(t, MakeMsgpack()(handler)(c))
+	require.Equal(t, http.StatusInternalServerError, rec.Code)
+	assert.Equal(t, echo.MIMEApplicationMsgpack, rec.Header().Get(echo.HeaderContentType))
+
+	var decoded generated.ErrorResponse
+	require.NoError(t, msgpack.Decode(rec.Body.Bytes(), &decoded))
+	assert.Equal(t, DBUnavailableError, decoded.Message)
+}
@@ -0,0 +1,142 @@
+package api
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/algorand/indexer/v3/idb"
+)
+
+// roundPollInterval is how often the database is checked while handlers are
+// waiting for a round, in case a round notification was missed.
+const roundPollInterval = time.Second
+
+// maxWaitForRound is the longest WaitForRound blocks, like algod's wait-for-block-after.
+const maxWaitForRound = time.Minute
+
+// roundNotifier wakes up handlers waiting for a new round. All handlers share
+// a single database subscription, and a single poll of the database while
+// someone is waiting in case a round notification was missed.
+type roundNotifier struct {
+	mu      sync.Mutex
+	round   uint64
+	waiters int
+	changed chan struct{}
+}
+
+// makeRoundNotifier subscribes to the rounds committed to the database until
+// the context is done.
+func makeRoundNotifier(ctx context.Context, db idb.IndexerDb) *roundNotifier {
+	n := &roundNotifier{changed: make(chan struct{})}
+	rounds := db.SubscribeRounds(ctx)
+	go func() {
+		ticker := time.NewTicker(roundPollInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case round, ok := <-rounds:
+				if !ok {
+					// Only the poll is left.
+					rounds = nil
+					continue
+				}
+				n.notify(round)
+			case <-ticker.C:
+				if !n.waiting() {
+					continue
+				}
+				health, err := db.Health(ctx)
+				if err == nil {
+					n.notify(health.Round)
+				}
+			}
+		}
+	}()
+	return n
+}
+
+// notify records the latest round and wakes up everyone waiting.
+func (n *roundNotifier) notify(round uint64) {
+	if n == nil {
+		return
+	}
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if round <= n.round {
+		return
+	}
+	n.round = round
+	close(n.changed)
+	n.changed = make(chan struct{})
+}
+
+// next returns the latest round notified, and a channel which is closed on
+// the next round notification.
+func (n *roundNotifier) next() (uint64, <-chan struct{}) {
+	if n == nil {
+		return 0, nil
+	}
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.round, n.changed
+}
+
+// waiting returns true if any handler is waiting for a round.
+func (n *roundNotifier) waiting() bool {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.waiters > 0
+}
+
+// addWaiter registers a handler waiting for a round until done is called.
+func (n *roundNotifier) addWaiter() (done func()) {
+	if n == nil {
+		return func() {}
+	}
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.waiters++
+	return func() {
+		n.mu.Lock()
+		defer n.mu.Unlock()
+		n.waiters--
+	}
+}
+
+// waitForRound blocks until the database has accounted the round or the context
+// is done, and returns the latest round seen. The rounds come from the shared
+// notifier, the database is only queried until the notifier knows a round.
+func (si *ServerImplementation) waitForRound(ctx context.Context, round uint64) (uint64, error) {
+	done := si.rounds.addWaiter()
+	defer done()
+
+	// Get the notification channel before checking the database so that
+	// a round committed in between is not missed.
+	latest, changed := si.rounds.next()
+	if latest == 0 {
+		health, err := si.db.Health(ctx)
+		if ctx.Err() != nil {
+			return 0, ctx.Err()
+		}
+		if err != nil {
+			return 0, err
+		}
+		latest = health.Round
+		si.rounds.notify(latest)
+	}
+
+	for latest < round {
+		select {
+		case <-ctx.Done():
+			return latest, ctx.Err()
+		case <-changed:
+		}
+		var notified uint64
+		notified, changed = si.rounds.next()
+		latest = max(latest, notified)
+	}
+	return latest, nil
+}
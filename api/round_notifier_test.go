@@ -0,0 +1,126 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/indexer/v3/api/generated/v2"
+	"github.com/algorand/indexer/v3/idb"
+	"github.com/algorand/indexer/v3/idb/mocks"
+
+	sdk "github.com/algorand/go-algorand-sdk/v2/types"
+)
+
+func TestRoundNotifier(t *testing.T) {
+	ch := make(chan uint64)
+	var rounds <-chan uint64 = ch
+	db := &mocks.IndexerDb{}
+	db.On("SubscribeRounds", mock.Anything).Return(rounds)
+
+	n := makeRoundNotifier(context.Background(), db)
+	round, changed := n.next()
+	assert.Equal(t, uint64(0), round)
+
+	select {
+	case <-changed:
+		t.Fatal("notified before a round was committed")
+	default:
+	}
+
+	ch <- 5
+	select {
+	case <-changed:
+	case <-time.After(time.Second):
+		t.Fatal("not notified after a round was committed")
+	}
+	round, next := n.next()
+	assert.Equal(t, uint64(5), round)
+	assert.NotEqual(t, changed, next)
+
+	// Older rounds are ignored.
+	n.notify(4)
+	round, _ = n.next()
+	assert.Equal(t, uint64(5), round)
+}
+
+func TestRoundNotifierPoll(t *testing.T) {
+	ch := make(chan uint64)
+	var rounds <-chan uint64 = ch
+	db := &mocks.IndexerDb{}
+	db.On("SubscribeRounds", mock.Anything).Return(rounds)
+	db.On("Health", mock.Anything).Return(idb.Health{Round: 7}, nil)
+
+	n := makeRoundNotifier(context.Background(), db)
+	_, changed := n.next()
+
+	// The database is only polled while someone is waiting.
+	time.Sleep(roundPollInterval + 100*time.Millisecond)
+	db.AssertNotCalled(t, "Health", mock.Anything)
+
+	done := n.addWaiter()
+	defer done()
+	select {
+	case <-changed:
+	case <-time.After(2 * roundPollInterval):
+		t.Fatal("not notified after polling the database")
+	}
+	round, _ := n.next()
+	assert.Equal(t, uint64(7), round)
+}
+
+func TestWaitForRound(t *testing.T) {
+	ch := make(chan uint64, 1)
+	var rounds <-chan uint64 = ch
+	db := &mocks.IndexerDb{}
+	db.On("SubscribeRounds", mock.Anything).Return(rounds)
+	db.On("Health", mock.Anything).Return(idb.Health{Round: 5}, nil)
+	db.On("GetBlock", mock.Anything, uint64(6), mock.Anything).
+		Return(sdk.BlockHeader{Round: 6, TimeStamp: 1234}, nil, nil)
+
+	si := testServerImplementation(db)
+	si.rounds = makeRoundNotifier(context.Background(), db)
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	ch <- 6
+	require.NoError(t, si.WaitForRound(c, 6))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var response generated.WaitForRoundResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Equal(t, generated.WaitForRoundResponse{CurrentRound: 6, Timestamp: 1234}, response)
+}
+
+func TestWaitForRoundTimeout(t *testing.T) {
+	db := &mocks.IndexerDb{}
+	db.On("Health", mock.Anything).Return(idb.Health{Round: 5}, nil)
+	db.On("GetBlock", mock.Anything, uint64(5), mock.Anything).
+		Return(sdk.BlockHeader{Round: 5, TimeStamp: 1000}, nil, nil)
+
+	si := testServerImplementation(db)
+	si.timeout = 2 * time.Second
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	require.NoError(t, si.WaitForRound(c, 10))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var response generated.WaitForRoundResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Equal(t, generated.WaitForRoundResponse{CurrentRound: 5, Timestamp: 1000}, response)
+}
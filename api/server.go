@@ -56,6 +56,10 @@ type ExtraOptions struct {
 	// Zero means unlimited.
 	MaxAccountListSize uint64
 
+	// ContractEvents are the ARC-28 events decoded by the /v2/applications/{application-id}/events endpoint,
+	// and the ARC-4 methods used to decode application calls.
+	ContractEvents *ContractEvents
+
 	/////////////////////
 	// Limit Constants //
 	/////////////////////
@@ -87,6 +91,18 @@ type ExtraOptions struct {
 	// Boxes
 	MaxBoxesLimit     uint64
 	DefaultBoxesLimit uint64
+
+	// Exports
+	//
+	// MaxExportLimit is the maximum number of rows streamed by an NDJSON or CSV
+	// export, which is also the default when no limit is provided. Zero means unlimited.
+	MaxExportLimit uint64
+
+	// Proposers
+	//
+	// MaxProposerStatsRounds is the widest round range aggregated by /v2/proposers,
+	// which aggregates the latest rounds when no min-round is provided. Zero means unlimited.
+	MaxProposerStatsRounds uint64
 }
 
 func (e ExtraOptions) handlerTimeout() time.Duration {
@@ -131,6 +147,8 @@ func Serve(ctx context.Context, serveAddr string, db idb.IndexerDb, dataError fu
 
 	middleware := make([]echo.MiddlewareFunc, 0)
 
+	// First, so that errors of the other middlewares are also encoded as msgpack.
+	middleware = append(middleware, middlewares.MakeMsgpack())
 	middleware = append(middleware, middlewares.MakeMigrationMiddleware(db))
 
 	if len(options.Tokens) > 0 {
@@ -148,6 +166,10 @@ func Serve(ctx context.Context, serveAddr string, db idb.IndexerDb, dataError fu
 		log.Fatal(err)
 	}
 
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
 	api := ServerImplementation{
 		EnableAddressSearchRoundRewind: options.DeveloperMode,
 		db:                             db,
@@ -156,14 +178,12 @@ func Serve(ctx context.Context, serveAddr string, db idb.IndexerDb, dataError fu
 		log:                            log,
 		disabledParams:                 disabledMap,
 		opts:                           options,
+		rounds:                         makeRoundNotifier(ctx, db),
 	}
 
 	generated.RegisterHandlers(e, &api, middleware...)
 	common.RegisterHandlers(e, &api)
 
-	if ctx == nil {
-		ctx = context.Background()
-	}
 	getctx := func(l net.Listener) context.Context {
 		return ctx
 	}
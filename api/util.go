@@ -2,9 +2,12 @@ package api
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"time"
 
+	"github.com/labstack/echo/v4"
 	log "github.com/sirupsen/logrus"
 )
 
@@ -72,3 +75,22 @@ func callWithTimeout(ctx context.Context, log *log.Logger, timeout time.Duration
 		return timeoutCtx.Err()
 	}
 }
+
+// writeServerSentEvent writes a single JSON encoded event to a text/event-stream
+// response and flushes it to the client. The id is omitted when empty.
+func writeServerSentEvent(w *echo.Response, event string, id string, data interface{}) error {
+	b, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	if id != "" {
+		if _, err := fmt.Fprintf(w, "id: %s\n", id); err != nil {
+			return err
+		}
+	}
+	if _, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event, b); err != nil {
+		return err
+	}
+	w.Flush()
+	return nil
+}
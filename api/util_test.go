@@ -54,7 +54,7 @@ func TestCallWithTimeoutExitsWhenHandlerFinishes(t *testing.T) {
 func TestInvalidTxnRow(t *testing.T) {
 	stxn := sdk.SignedTxnWithAD{}
 	invalidRow := idb.TxnRow{Txn: &stxn, RootTxn: &stxn}
-	_, err := txnRowToTransaction(invalidRow)
+	_, err := txnRowToTransaction(invalidRow, nil)
 	require.Error(t, err)
 	require.ErrorContains(t, err, "Txn and RootTxn should be mutually exclusive")
 }
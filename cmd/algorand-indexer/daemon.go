@@ -48,12 +48,15 @@ type daemonConfig struct {
 	defaultBalancesLimit             uint32
 	maxApplicationsLimit             uint32
 	defaultApplicationsLimit         uint32
+	maxExportLimit                   uint32
+	maxProposerStatsRounds           uint32
 	enableAllParameters              bool
 	indexerDataDir                   string
 	cpuProfile                       string
 	pidFilePath                      string
 	configFile                       string
 	suppliedAPIConfigFile            string
+	contractEventsFile               string
 }
 
 // DaemonCmd creates the main cobra command, initializes flags, and viper aliases
@@ -83,6 +86,7 @@ func DaemonCmd() *cobra.Command {
 
 	cfg.flags.StringVar(&cfg.suppliedAPIConfigFile, "api-config-file", "", "supply an API config file to enable/disable parameters")
 	cfg.flags.BoolVar(&cfg.enableAllParameters, "enable-all-parameters", false, "override default configuration and enable all parameters. Can't be used with --api-config-file")
+	cfg.flags.StringVar(&cfg.contractEventsFile, "contract-events-file", "", "supply a file registering the ARC-28 events and ARC-4 methods of applications, used to decode application logs and calls")
 	cfg.flags.Uint32VarP(&cfg.maxAPIResourcesPerAccount, "max-api-resources-per-account", "", 1000, "set the maximum total number of resources (created assets, created apps, asset holdings, and application local state) per account that will be allowed in REST API lookupAccountByID and searchForAccounts responses before returning a 400 Bad Request. Set zero for no limit")
 	cfg.flags.Uint32VarP(&cfg.maxAccountListSize, "max-account-list-size", "", 50, "set the maximum number of items for query parameters that accept account lists. Set zero for no limit")
 	cfg.flags.Uint32VarP(&cfg.maxBlocksLimit, "max-blocks-limit", "", 1000, "set the maximum allowed Limit parameter for querying blocks")
@@ -99,6 +103,8 @@ func DaemonCmd() *cobra.Command {
 	cfg.flags.Uint32VarP(&cfg.defaultApplicationsLimit, "default-applications-limit", "", 100, "set the default Limit parameter for querying applications, if none is provided")
 	cfg.flags.Uint32VarP(&cfg.maxBoxesLimit, "max-boxes-limit", "", 10000, "set the maximum allowed Limit parameter for searching an app's boxes")
 	cfg.flags.Uint32VarP(&cfg.defaultBoxesLimit, "default-boxes-limit", "", 1000, "set the default allowed Limit parameter for searching an app's boxes")
+	cfg.flags.Uint32VarP(&cfg.maxExportLimit, "max-export-limit", "", 1000000, "set the maximum number of rows streamed by NDJSON and CSV exports, also used when no Limit parameter is provided. Set zero for no limit")
+	cfg.flags.Uint32VarP(&cfg.maxProposerStatsRounds, "max-proposer-stats-rounds", "", 100000, "set the maximum number of rounds aggregated when searching for proposers, the latest rounds are aggregated when no MinRound parameter is provided. Set zero for no limit")
 
 	cfg.flags.StringVarP(&cfg.indexerDataDir, "data-dir", "i", "", "path to indexer data dir, or $INDEXER_DATA")
 
@@ -346,6 +352,8 @@ func makeOptions(daemonConfig *daemonConfig) (options api.ExtraOptions) {
 	options.DefaultApplicationsLimit = uint64(daemonConfig.defaultApplicationsLimit)
 	options.MaxBoxesLimit = uint64(daemonConfig.maxBoxesLimit)
 	options.DefaultBoxesLimit = uint64(daemonConfig.defaultBoxesLimit)
+	options.MaxExportLimit = uint64(daemonConfig.maxExportLimit)
+	options.MaxProposerStatsRounds = uint64(daemonConfig.maxProposerStatsRounds)
 
 	if daemonConfig.enableAllParameters {
 		options.DisabledMapConfig = api.MakeDisabledMapConfig()
@@ -376,5 +384,15 @@ func makeOptions(daemonConfig *daemonConfig) (options api.ExtraOptions) {
 		logger.Infof("Enable all parameters flag is set to: %v", daemonConfig.enableAllParameters)
 	}
 
+	if daemonConfig.contractEventsFile != "" {
+		logger.Infof("supplied contract events file located at: %s", daemonConfig.contractEventsFile)
+		contractEvents, err := api.MakeContractEventsFromFile(daemonConfig.contractEventsFile)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "failed to load contract events file: %v", err)
+			panic(exit{1})
+		}
+		options.ContractEvents = contractEvents
+	}
+
 	return
 }
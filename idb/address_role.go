@@ -12,4 +12,7 @@ const (
 	AddressRoleAssetReceiver    AddressRole = 0x10
 	AddressRoleAssetCloseTo     AddressRole = 0x20
 	AddressRoleFreeze           AddressRole = 0x40
+	AddressRoleAppAccount       AddressRole = 0x80
+	AddressRoleInnerReceiver    AddressRole = 0x100
+	AddressRoleHeartbeat        AddressRole = 0x200
 )
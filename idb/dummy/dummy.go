@@ -2,6 +2,7 @@ package dummy
 
 import (
 	"context"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 
@@ -53,6 +54,11 @@ func (db *dummyIndexerDb) GetBlock(ctx context.Context, round uint64, options id
 	return sdk.BlockHeader{}, nil, nil
 }
 
+// RoundsAtTime is part of idb.IndexerDB
+func (db *dummyIndexerDb) RoundsAtTime(ctx context.Context, at time.Time) (idb.RoundsAtTime, uint64, error) {
+	return idb.RoundsAtTime{}, 0, nil
+}
+
 // Blocks is part of idb.IndexerDB
 func (db *dummyIndexerDb) BlockHeaders(ctx context.Context, bf idb.BlockHeaderFilter) (<-chan idb.BlockRow, uint64) {
 	return nil, 0
@@ -63,6 +69,11 @@ func (db *dummyIndexerDb) Transactions(ctx context.Context, tf idb.TransactionFi
 	return nil, 0
 }
 
+// TransactionGroup is part of idb.IndexerDB
+func (db *dummyIndexerDb) TransactionGroup(ctx context.Context, filter idb.TransactionGroupQuery) (<-chan idb.TxnRow, uint64) {
+	return nil, 0
+}
+
 // GetAccounts is part of idb.IndexerDB
 func (db *dummyIndexerDb) GetAccounts(ctx context.Context, opts idb.AccountQueryOptions) (<-chan idb.AccountRow, uint64) {
 	return nil, 0
@@ -78,6 +89,11 @@ func (db *dummyIndexerDb) AssetBalances(ctx context.Context, abq idb.AssetBalanc
 	return nil, 0
 }
 
+// AssetSupply isn't currently implemented
+func (db *dummyIndexerDb) AssetSupply(ctx context.Context, filter idb.AssetSupplyQuery) (<-chan idb.AssetSupplyRow, uint64) {
+	panic("not implemented")
+}
+
 // Applications is part of idb.IndexerDB
 func (db *dummyIndexerDb) Applications(ctx context.Context, filter idb.ApplicationQuery) (<-chan idb.ApplicationRow, uint64) {
 	return nil, 0
@@ -93,11 +109,71 @@ func (db *dummyIndexerDb) ApplicationBoxes(ctx context.Context, filter idb.Appli
 	panic("not implemented")
 }
 
+// AppBoxHistory isn't currently implemented
+func (db *dummyIndexerDb) AppBoxHistory(ctx context.Context, filter idb.AppBoxHistoryQuery) (<-chan idb.AppBoxHistoryRow, uint64) {
+	panic("not implemented")
+}
+
+// AppBoxHistoryStart isn't currently implemented
+func (db *dummyIndexerDb) AppBoxHistoryStart(ctx context.Context) (uint64, error) {
+	panic("not implemented")
+}
+
+// ProposerStats isn't currently implemented
+func (db *dummyIndexerDb) ProposerStats(ctx context.Context, filter idb.ProposerStatsQuery) (<-chan idb.ProposerStatsRow, uint64) {
+	panic("not implemented")
+}
+
+// TxnStats isn't currently implemented
+func (db *dummyIndexerDb) TxnStats(ctx context.Context, filter idb.TxnStatsQuery) (<-chan idb.TxnStatsRow, uint64) {
+	panic("not implemented")
+}
+
+// TxnStatsStart isn't currently implemented
+func (db *dummyIndexerDb) TxnStatsStart(ctx context.Context) (uint64, error) {
+	panic("not implemented")
+}
+
+// FeeStats isn't currently implemented
+func (db *dummyIndexerDb) FeeStats(ctx context.Context, filter idb.FeeStatsQuery) (<-chan idb.FeeStatsRow, uint64) {
+	panic("not implemented")
+}
+
+// FeeCounts isn't currently implemented
+func (db *dummyIndexerDb) FeeCounts(ctx context.Context, filter idb.FeeStatsQuery) ([]idb.FeeCountRow, error) {
+	panic("not implemented")
+}
+
+// FeeStatsStart isn't currently implemented
+func (db *dummyIndexerDb) FeeStatsStart(ctx context.Context) (uint64, error) {
+	panic("not implemented")
+}
+
+// AppGlobalStateHistory isn't currently implemented
+func (db *dummyIndexerDb) AppGlobalStateHistory(ctx context.Context, filter idb.AppGlobalStateHistoryQuery) (<-chan idb.AppGlobalStateHistoryRow, uint64) {
+	panic("not implemented")
+}
+
+// AppGlobalStateHistoryStart isn't currently implemented
+func (db *dummyIndexerDb) AppGlobalStateHistoryStart(ctx context.Context) (uint64, error) {
+	panic("not implemented")
+}
+
 // Health is part of idb.IndexerDB
 func (db *dummyIndexerDb) Health(ctx context.Context) (state idb.Health, err error) {
 	return idb.Health{}, nil
 }
 
+// SubscribeRounds is part of idb.IndexerDB
+func (db *dummyIndexerDb) SubscribeRounds(ctx context.Context) <-chan uint64 {
+	out := make(chan uint64)
+	go func() {
+		<-ctx.Done()
+		close(out)
+	}()
+	return out
+}
+
 // GetNetworkState is part of idb.IndexerDB
 func (db *dummyIndexerDb) GetNetworkState() (state idb.NetworkState, err error) {
 	return idb.NetworkState{GenesisHash: sdk.Genesis{}.Hash()}, nil
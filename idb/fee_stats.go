@@ -0,0 +1,66 @@
+package idb
+
+import (
+	"time"
+)
+
+// FeeStatsQuery is a parameter object used to query the fee statistics of rounds.
+type FeeStatsQuery struct {
+	MinRound uint64
+	MaxRound uint64
+	// Rounds bounds the number of rounds in the range when it is not zero.
+	// Without a MinRound the latest rounds up to MaxRound, or the current
+	// round, are used, otherwise the rounds from MinRound.
+	Rounds uint64
+	// BeforeRound resumes the search before the round when it is not zero.
+	BeforeRound uint64
+	Limit       uint64
+}
+
+// RoundRange returns the first and last round of the range searched at the
+// current round.
+func (q FeeStatsQuery) RoundRange(current uint64) (uint64, uint64) {
+	minRound, maxRound := q.MinRound, q.MaxRound
+	if maxRound == 0 || maxRound > current {
+		maxRound = current
+	}
+	if q.Rounds != 0 {
+		if minRound == 0 {
+			if maxRound >= q.Rounds {
+				minRound = maxRound - q.Rounds + 1
+			}
+		} else {
+			maxRound = min(maxRound, minRound+q.Rounds-1)
+		}
+	}
+	return minRound, maxRound
+}
+
+// FeeStatsRow is the distribution of the fees paid by the top level
+// transactions of a round, newest round first.
+type FeeStatsRow struct {
+	Round     uint64
+	RoundTime time.Time
+	// Protocol is the consensus version of the round.
+	Protocol string
+	// Fees lists the distinct fees paid in ascending order, Counts the number
+	// of transactions paying each of them.
+	Fees   []uint64
+	Counts []uint64
+	// TxnCounter and FeesCollected come from the block header.
+	TxnCounter    uint64
+	FeesCollected uint64
+	// PrevTxnCounter and PrevRoundTime come from the header of the previous
+	// round, they are nil when it isn't available.
+	PrevTxnCounter *uint64
+	PrevRoundTime  *time.Time
+	Error          error
+}
+
+// FeeCountRow is the number of top level transactions paying a fee in the
+// rounds of a consensus version.
+type FeeCountRow struct {
+	Protocol string
+	Fee      uint64
+	Count    uint64
+}
@@ -71,9 +71,6 @@ func countInner(stxn *sdk.SignedTxnWithAD) uint {
 
 // Next returns what should be an opaque string to be used with the next query to resume where a previous limit left off.
 func (tr TxnRow) Next(ascending bool) (string, error) {
-	var b [12]byte
-	binary.LittleEndian.PutUint64(b[:8], tr.Round)
-
 	intra := uint(tr.Intra)
 	if tr.Extra.RootIntra.Present {
 		// initialize for descending order, the root intra.
@@ -96,8 +93,15 @@ func (tr TxnRow) Next(ascending bool) (string, error) {
 		intra += countInner(stxn)
 	}
 
-	binary.LittleEndian.PutUint32(b[8:], uint32(intra))
-	return base64.URLEncoding.EncodeToString(b[:]), nil
+	return EncodeTxnRowNext(tr.Round, uint32(intra)), nil
+}
+
+// EncodeTxnRowNext packs a (round, intra) position in the same format as TxnRow.Next()
+func EncodeTxnRowNext(round uint64, intra uint32) string {
+	var b [12]byte
+	binary.LittleEndian.PutUint64(b[:8], round)
+	binary.LittleEndian.PutUint32(b[8:], intra)
+	return base64.URLEncoding.EncodeToString(b[:])
 }
 
 // DecodeTxnRowNext unpacks opaque string returned from TxnRow.Next()
@@ -202,20 +206,51 @@ type IndexerDb interface {
 	SetNetworkState(genesis sdk.Digest) error
 
 	GetBlock(ctx context.Context, round uint64, options GetBlockOptions) (blockHeader sdk.BlockHeader, transactions []TxnRow, err error)
+	// RoundsAtTime returns the rounds surrounding the time, as well as the
+	// latest round accounted.
+	RoundsAtTime(ctx context.Context, at time.Time) (RoundsAtTime, uint64, error)
 
 	// The next multiple functions return a channel with results as well as the latest round
 	// accounted.
 	BlockHeaders(ctx context.Context, bf BlockHeaderFilter) (<-chan BlockRow, uint64)
 	Transactions(ctx context.Context, tf TransactionFilter) (<-chan TxnRow, uint64)
+	TransactionGroup(ctx context.Context, filter TransactionGroupQuery) (<-chan TxnRow, uint64)
 	GetAccounts(ctx context.Context, opts AccountQueryOptions) (<-chan AccountRow, uint64)
 	Assets(ctx context.Context, filter AssetsQuery) (<-chan AssetRow, uint64)
 	AssetBalances(ctx context.Context, abq AssetBalanceQuery) (<-chan AssetBalanceRow, uint64)
+	AssetSupply(ctx context.Context, filter AssetSupplyQuery) (<-chan AssetSupplyRow, uint64)
 	Applications(ctx context.Context, filter ApplicationQuery) (<-chan ApplicationRow, uint64)
 	AppLocalState(ctx context.Context, filter ApplicationQuery) (<-chan AppLocalStateRow, uint64)
 	ApplicationBoxes(ctx context.Context, filter ApplicationBoxQuery) (<-chan ApplicationBoxRow, uint64)
+	AppGlobalStateHistory(ctx context.Context, filter AppGlobalStateHistoryQuery) (<-chan AppGlobalStateHistoryRow, uint64)
+	// AppGlobalStateHistoryStart returns the first round with recorded global
+	// state changes, zero when the whole history is recorded.
+	AppGlobalStateHistoryStart(ctx context.Context) (uint64, error)
+	AppBoxHistory(ctx context.Context, filter AppBoxHistoryQuery) (<-chan AppBoxHistoryRow, uint64)
+	// AppBoxHistoryStart returns the first round with recorded box changes, or
+	// ErrorNotInitialized when they were never written.
+	AppBoxHistoryStart(ctx context.Context) (uint64, error)
+	ProposerStats(ctx context.Context, filter ProposerStatsQuery) (<-chan ProposerStatsRow, uint64)
+	TxnStats(ctx context.Context, filter TxnStatsQuery) (<-chan TxnStatsRow, uint64)
+	// TxnStatsStart returns the first round with transaction statistics, or
+	// ErrorNotInitialized when they were never written.
+	TxnStatsStart(ctx context.Context) (uint64, error)
+	FeeStats(ctx context.Context, filter FeeStatsQuery) (<-chan FeeStatsRow, uint64)
+	// FeeCounts returns the number of transactions paying each fee over the
+	// whole round range of the filter, ignoring BeforeRound and Limit.
+	FeeCounts(ctx context.Context, filter FeeStatsQuery) ([]FeeCountRow, error)
+	// FeeStatsStart returns the first round with fee statistics, or
+	// ErrorNotInitialized when they were never written.
+	FeeStatsStart(ctx context.Context) (uint64, error)
 
 	Health(ctx context.Context) (status Health, err error)
 
+	// SubscribeRounds returns a channel which receives each round as it is
+	// committed to the database. Rounds may be missed if the connection is
+	// interrupted, so they should only be used as a hint. The channel is closed
+	// when the context is done.
+	SubscribeRounds(ctx context.Context) <-chan uint64
+
 	DeleteTransactions(ctx context.Context, keep uint64) error
 }
 
@@ -228,8 +263,21 @@ type GetBlockOptions struct {
 	MaxTransactionsLimit uint64
 }
 
+// SortOrder is the direction in which search results are sorted.
+type SortOrder int
+
+const (
+	// SortDefault uses the default direction of the search.
+	SortDefault SortOrder = iota
+	// SortAscending returns the oldest results first.
+	SortAscending
+	// SortDescending returns the newest results first.
+	SortDescending
+)
+
 // BlockHeaderFilter is a parameter object with all the block filter options.
 type BlockHeaderFilter struct {
+	Order                        SortOrder // ascending by default
 	Limit                        uint64
 	MaxRound                     *uint64
 	MinRound                     *uint64
@@ -240,6 +288,11 @@ type BlockHeaderFilter struct {
 	AbsentParticipationAccounts  map[sdk.Address]struct{}
 }
 
+// Descending returns true if the block headers are sorted newest-first.
+func (bf BlockHeaderFilter) Descending() bool {
+	return bf.Order == SortDescending
+}
+
 // TransactionFilter is a parameter object with all the transaction filter options.
 type TransactionFilter struct {
 	// SkipOptimization is used for testing to ensure the parameters are not modified.
@@ -247,10 +300,15 @@ type TransactionFilter struct {
 
 	// Address filtering transactions for one Address will
 	// return transactions newest-first proceding into the
-	// past. Paging through such results can be achieved by
-	// setting a MaxRound to get results before.
+	// past, unless Order is SortAscending. Paging through such
+	// results can be achieved by setting a MaxRound to get
+	// results before.
 	Address []byte
 
+	// Order overrides the default direction, which is oldest-first
+	// unless filtering by Address.
+	Order SortOrder
+
 	AddressRole AddressRole // 0=Any, otherwise bitfields as defined in address_role.go
 
 	MinRound   uint64
@@ -276,6 +334,13 @@ type TransactionFilter struct {
 
 	ApplicationID *uint64 // filter transactions relevant to an application
 
+	// Application call filters, only application calls match them.
+	MethodSelector []byte // first application argument
+	OnCompletion   *sdk.OnCompletion
+	ForeignAppID   *uint64
+	ForeignAssetID *uint64
+	BoxReference   []byte // box name
+
 	EffectiveAmountGT *uint64 // Algo: Amount + CloseAmount > x
 	EffectiveAmountLT *uint64 // Algo: Amount + CloseAmount < x
 
@@ -299,6 +364,18 @@ type TransactionFilter struct {
 	// If this flag is set to true, then the query only returns transactions
 	// that have application logs (t.txn -> 'dt' -> 'lg' IS NOT NULL)
 	RequireApplicationLogs bool
+
+	// If this flag is set to true, then the query only returns transactions
+	// that can change the auth address of their sender: rekeys and close-outs.
+	RequireAuthAddrChange bool
+}
+
+// Descending returns true if the transactions are sorted newest-first.
+func (tf TransactionFilter) Descending() bool {
+	if tf.Order == SortDefault {
+		return tf.Address != nil
+	}
+	return tf.Order == SortDescending
 }
 
 // AccountQueryOptions is a parameter object with all of the account filter options.
@@ -339,6 +416,13 @@ type AccountQueryOptions struct {
 	// IncludeDeleted indicates whether to include deleted Assets, Applications, etc within the account.
 	IncludeDeleted bool
 
+	// OrderByBalance returns the largest balances first, without pending
+	// rewards, ties are broken by descending address. Paging then uses
+	// PrevMicroalgos and PrevAddress instead of GreaterThanAddress.
+	OrderByBalance bool
+	PrevMicroalgos uint64
+	PrevAddress    []byte
+
 	Limit uint64
 }
 
@@ -408,6 +492,12 @@ type AssetBalanceQuery struct {
 	// PrevAddress for paging, the last item from the previous
 	// query (items returned in address order)
 	PrevAddress []byte
+
+	// OrderByAmount returns the largest holdings first, ties are broken by
+	// descending address. It requires AssetID, and PrevAmount and PrevAddress
+	// are then both used for paging.
+	OrderByAmount bool
+	PrevAmount    uint64
 }
 
 // AssetBalanceRow is metadata relating to one asset balance in an asset balance query.
@@ -422,6 +512,27 @@ type AssetBalanceRow struct {
 	Deleted      *bool
 }
 
+// AssetSupplyQuery is a parameter object used to query the supply of an asset.
+type AssetSupplyQuery struct {
+	AssetID uint64
+
+	// Exclude lists the addresses whose holdings are summed in ExcludedAmount.
+	Exclude [][]byte
+}
+
+// AssetSupplyRow is the distribution of the supply of an asset among its holders.
+type AssetSupplyRow struct {
+	AssetID       uint64
+	Creator       []byte
+	Params        sdk.AssetParams
+	ReserveAmount uint64
+	CreatorAmount uint64
+	FrozenAmount  uint64
+	// ExcludedAmount is held by the excluded addresses, other than the reserve.
+	ExcludedAmount uint64
+	Error          error
+}
+
 // ApplicationRow is metadata and global state (AppParams) relating to one application in an application query.
 type ApplicationRow struct {
 	Application models.Application
@@ -460,6 +571,80 @@ type ApplicationBoxRow struct {
 	Error error
 }
 
+// AppGlobalStateHistoryQuery is a parameter object used to query the changes to an application global state key.
+type AppGlobalStateHistoryQuery struct {
+	ApplicationID uint64
+	Key           []byte
+	MinRound      uint64
+	MaxRound      uint64
+	Limit         uint64
+	// NextToken resumes after the change at the (round, intra) it encodes.
+	NextToken string
+}
+
+// AppGlobalStateHistoryRow is a change to an application global state key, oldest first.
+type AppGlobalStateHistoryRow struct {
+	Round uint64
+	Intra uint32
+	Txid  string
+	Delta sdk.ValueDelta
+	// Previous is the value before the change, nil if the key did not exist
+	// or its history starts with this change.
+	Previous *sdk.TealValue
+	Error    error
+}
+
+// TransactionGroupQuery is a parameter object used to look up the root transactions of a group.
+type TransactionGroupQuery struct {
+	GroupID []byte
+	// Txid is an optional member transaction, used to find groups which are not
+	// in the group index.
+	Txid string
+}
+
+// AppBoxHistoryQuery is a parameter object used to query the changes to an application box.
+type AppBoxHistoryQuery struct {
+	ApplicationID uint64
+	BoxName       []byte
+	MinRound      uint64
+	MaxRound      uint64
+	Limit         uint64
+}
+
+// AppBoxHistoryRow is the value of a box after the round it changed in, oldest first.
+type AppBoxHistoryRow struct {
+	Round uint64
+	// Value is nil if the box was deleted.
+	Value []byte
+	Error error
+}
+
+// ProposerStatsQuery is a parameter object used to aggregate the blocks proposed by accounts.
+type ProposerStatsQuery struct {
+	// Proposer limits the statistics to one account, all proposers are
+	// aggregated when it is nil.
+	Proposer *sdk.Address
+	MinRound uint64
+	MaxRound uint64
+	// Rounds bounds the number of aggregated rounds when it is not zero. Without
+	// a MinRound the latest rounds up to MaxRound, or the current round, are
+	// aggregated, otherwise the rounds from MinRound.
+	Rounds uint64
+	// Limit the number of proposers, the ones with the most blocks come first.
+	Limit uint64
+}
+
+// ProposerStatsRow aggregates the blocks proposed by an account.
+type ProposerStatsRow struct {
+	Proposer       sdk.Address
+	BlocksProposed uint64
+	TotalPayout    uint64 // microalgos paid to the proposer
+	FeesCollected  uint64 // microalgos of fees in the proposed blocks
+	FirstRound     uint64
+	LastRound      uint64
+	Error          error
+}
+
 // IndexerDbOptions are the options common to all indexer backends.
 type IndexerDbOptions struct {
 	ReadOnly bool
@@ -468,6 +653,18 @@ type IndexerDbOptions struct {
 	// concurrently can never be more than this
 	MaxConn uint32
 
+	// BoxHistory enables writing every box change to the box history table.
+	// Box history is only available for the rounds imported while it is enabled.
+	BoxHistory bool
+
+	// TxnStats enables writing the hourly and daily transaction statistics.
+	// Statistics are only available for the rounds imported while it is enabled.
+	TxnStats bool
+
+	// FeeStats enables writing the fee distribution of each round.
+	// Statistics are only available for the rounds imported while it is enabled.
+	FeeStats bool
+
 	IndexerDatadir string
 	AlgodDataDir   string
 	AlgodToken     string
@@ -8,6 +8,8 @@ import (
 	idb "github.com/algorand/indexer/v3/idb"
 	mock "github.com/stretchr/testify/mock"
 
+	time "time"
+
 	types "github.com/algorand/indexer/v3/types"
 
 	v2types "github.com/algorand/go-algorand-sdk/v2/types"
@@ -36,6 +38,122 @@ func (_m *IndexerDb) AddBlock(block *types.ValidatedBlock) error {
 	return r0
 }
 
+// AppBoxHistory provides a mock function with given fields: ctx, filter
+func (_m *IndexerDb) AppBoxHistory(ctx context.Context, filter idb.AppBoxHistoryQuery) (<-chan idb.AppBoxHistoryRow, uint64) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AppBoxHistory")
+	}
+
+	var r0 <-chan idb.AppBoxHistoryRow
+	var r1 uint64
+	if rf, ok := ret.Get(0).(func(context.Context, idb.AppBoxHistoryQuery) (<-chan idb.AppBoxHistoryRow, uint64)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, idb.AppBoxHistoryQuery) <-chan idb.AppBoxHistoryRow); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan idb.AppBoxHistoryRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, idb.AppBoxHistoryQuery) uint64); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Get(1).(uint64)
+	}
+
+	return r0, r1
+}
+
+// AppBoxHistoryStart provides a mock function with given fields: ctx
+func (_m *IndexerDb) AppBoxHistoryStart(ctx context.Context) (uint64, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AppBoxHistoryStart")
+	}
+
+	var r0 uint64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (uint64, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) uint64); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AppGlobalStateHistory provides a mock function with given fields: ctx, filter
+func (_m *IndexerDb) AppGlobalStateHistory(ctx context.Context, filter idb.AppGlobalStateHistoryQuery) (<-chan idb.AppGlobalStateHistoryRow, uint64) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AppGlobalStateHistory")
+	}
+
+	var r0 <-chan idb.AppGlobalStateHistoryRow
+	var r1 uint64
+	if rf, ok := ret.Get(0).(func(context.Context, idb.AppGlobalStateHistoryQuery) (<-chan idb.AppGlobalStateHistoryRow, uint64)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, idb.AppGlobalStateHistoryQuery) <-chan idb.AppGlobalStateHistoryRow); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan idb.AppGlobalStateHistoryRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, idb.AppGlobalStateHistoryQuery) uint64); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Get(1).(uint64)
+	}
+
+	return r0, r1
+}
+
+// AppGlobalStateHistoryStart provides a mock function with given fields: ctx
+func (_m *IndexerDb) AppGlobalStateHistoryStart(ctx context.Context) (uint64, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AppGlobalStateHistoryStart")
+	}
+
+	var r0 uint64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (uint64, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) uint64); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // AppLocalState provides a mock function with given fields: ctx, filter
 func (_m *IndexerDb) AppLocalState(ctx context.Context, filter idb.ApplicationQuery) (<-chan idb.AppLocalStateRow, uint64) {
 	ret := _m.Called(ctx, filter)
@@ -186,6 +304,36 @@ func (_m *IndexerDb) Assets(ctx context.Context, filter idb.AssetsQuery) (<-chan
 	return r0, r1
 }
 
+// AssetSupply provides a mock function with given fields: ctx, filter
+func (_m *IndexerDb) AssetSupply(ctx context.Context, filter idb.AssetSupplyQuery) (<-chan idb.AssetSupplyRow, uint64) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AssetSupply")
+	}
+
+	var r0 <-chan idb.AssetSupplyRow
+	var r1 uint64
+	if rf, ok := ret.Get(0).(func(context.Context, idb.AssetSupplyQuery) (<-chan idb.AssetSupplyRow, uint64)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, idb.AssetSupplyQuery) <-chan idb.AssetSupplyRow); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan idb.AssetSupplyRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, idb.AssetSupplyQuery) uint64); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Get(1).(uint64)
+	}
+
+	return r0, r1
+}
+
 // BlockHeaders provides a mock function with given fields: ctx, bf
 func (_m *IndexerDb) BlockHeaders(ctx context.Context, bf idb.BlockHeaderFilter) (<-chan idb.BlockRow, uint64) {
 	ret := _m.Called(ctx, bf)
@@ -239,6 +387,94 @@ func (_m *IndexerDb) DeleteTransactions(ctx context.Context, keep uint64) error
 	return r0
 }
 
+// FeeCounts provides a mock function with given fields: ctx, filter
+func (_m *IndexerDb) FeeCounts(ctx context.Context, filter idb.FeeStatsQuery) ([]idb.FeeCountRow, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FeeCounts")
+	}
+
+	var r0 []idb.FeeCountRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, idb.FeeStatsQuery) ([]idb.FeeCountRow, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, idb.FeeStatsQuery) []idb.FeeCountRow); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]idb.FeeCountRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, idb.FeeStatsQuery) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FeeStats provides a mock function with given fields: ctx, filter
+func (_m *IndexerDb) FeeStats(ctx context.Context, filter idb.FeeStatsQuery) (<-chan idb.FeeStatsRow, uint64) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FeeStats")
+	}
+
+	var r0 <-chan idb.FeeStatsRow
+	var r1 uint64
+	if rf, ok := ret.Get(0).(func(context.Context, idb.FeeStatsQuery) (<-chan idb.FeeStatsRow, uint64)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, idb.FeeStatsQuery) <-chan idb.FeeStatsRow); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan idb.FeeStatsRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, idb.FeeStatsQuery) uint64); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Get(1).(uint64)
+	}
+
+	return r0, r1
+}
+
+// FeeStatsStart provides a mock function with given fields: ctx
+func (_m *IndexerDb) FeeStatsStart(ctx context.Context) (uint64, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FeeStatsStart")
+	}
+
+	var r0 uint64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (uint64, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) uint64); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetAccounts provides a mock function with given fields: ctx, opts
 func (_m *IndexerDb) GetAccounts(ctx context.Context, opts idb.AccountQueryOptions) (<-chan idb.AccountRow, uint64) {
 	ret := _m.Called(ctx, opts)
@@ -436,6 +672,71 @@ func (_m *IndexerDb) LoadGenesis(genesis v2types.Genesis) error {
 	return r0
 }
 
+// ProposerStats provides a mock function with given fields: ctx, filter
+func (_m *IndexerDb) ProposerStats(ctx context.Context, filter idb.ProposerStatsQuery) (<-chan idb.ProposerStatsRow, uint64) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProposerStats")
+	}
+
+	var r0 <-chan idb.ProposerStatsRow
+	var r1 uint64
+	if rf, ok := ret.Get(0).(func(context.Context, idb.ProposerStatsQuery) (<-chan idb.ProposerStatsRow, uint64)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, idb.ProposerStatsQuery) <-chan idb.ProposerStatsRow); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan idb.ProposerStatsRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, idb.ProposerStatsQuery) uint64); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Get(1).(uint64)
+	}
+
+	return r0, r1
+}
+
+// RoundsAtTime provides a mock function with given fields: ctx, at
+func (_m *IndexerDb) RoundsAtTime(ctx context.Context, at time.Time) (idb.RoundsAtTime, uint64, error) {
+	ret := _m.Called(ctx, at)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RoundsAtTime")
+	}
+
+	var r0 idb.RoundsAtTime
+	var r1 uint64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) (idb.RoundsAtTime, uint64, error)); ok {
+		return rf(ctx, at)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) idb.RoundsAtTime); ok {
+		r0 = rf(ctx, at)
+	} else {
+		r0 = ret.Get(0).(idb.RoundsAtTime)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) uint64); ok {
+		r1 = rf(ctx, at)
+	} else {
+		r1 = ret.Get(1).(uint64)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, time.Time) error); ok {
+		r2 = rf(ctx, at)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // SetNetworkState provides a mock function with given fields: genesis
 func (_m *IndexerDb) SetNetworkState(genesis v2types.Digest) error {
 	ret := _m.Called(genesis)
@@ -454,6 +755,56 @@ func (_m *IndexerDb) SetNetworkState(genesis v2types.Digest) error {
 	return r0
 }
 
+// SubscribeRounds provides a mock function with given fields: ctx
+func (_m *IndexerDb) SubscribeRounds(ctx context.Context) <-chan uint64 {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SubscribeRounds")
+	}
+
+	var r0 <-chan uint64
+	if rf, ok := ret.Get(0).(func(context.Context) <-chan uint64); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan uint64)
+		}
+	}
+
+	return r0
+}
+
+// TransactionGroup provides a mock function with given fields: ctx, filter
+func (_m *IndexerDb) TransactionGroup(ctx context.Context, filter idb.TransactionGroupQuery) (<-chan idb.TxnRow, uint64) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TransactionGroup")
+	}
+
+	var r0 <-chan idb.TxnRow
+	var r1 uint64
+	if rf, ok := ret.Get(0).(func(context.Context, idb.TransactionGroupQuery) (<-chan idb.TxnRow, uint64)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, idb.TransactionGroupQuery) <-chan idb.TxnRow); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan idb.TxnRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, idb.TransactionGroupQuery) uint64); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Get(1).(uint64)
+	}
+
+	return r0, r1
+}
+
 // Transactions provides a mock function with given fields: ctx, tf
 func (_m *IndexerDb) Transactions(ctx context.Context, tf idb.TransactionFilter) (<-chan idb.TxnRow, uint64) {
 	ret := _m.Called(ctx, tf)
@@ -484,6 +835,64 @@ func (_m *IndexerDb) Transactions(ctx context.Context, tf idb.TransactionFilter)
 	return r0, r1
 }
 
+// TxnStats provides a mock function with given fields: ctx, filter
+func (_m *IndexerDb) TxnStats(ctx context.Context, filter idb.TxnStatsQuery) (<-chan idb.TxnStatsRow, uint64) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TxnStats")
+	}
+
+	var r0 <-chan idb.TxnStatsRow
+	var r1 uint64
+	if rf, ok := ret.Get(0).(func(context.Context, idb.TxnStatsQuery) (<-chan idb.TxnStatsRow, uint64)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, idb.TxnStatsQuery) <-chan idb.TxnStatsRow); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan idb.TxnStatsRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, idb.TxnStatsQuery) uint64); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Get(1).(uint64)
+	}
+
+	return r0, r1
+}
+
+// TxnStatsStart provides a mock function with given fields: ctx
+func (_m *IndexerDb) TxnStatsStart(ctx context.Context) (uint64, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TxnStatsStart")
+	}
+
+	var r0 uint64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (uint64, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) uint64); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewIndexerDb creates a new instance of IndexerDb. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewIndexerDb(t interface {
@@ -735,3 +735,19 @@ func DecodeDeleteStatus(data []byte) (types.DeleteStatus, error) {
 
 	return status, nil
 }
+
+// EncodeHistoryStart encodes history start metastate into json.
+func EncodeHistoryStart(p *types.HistoryStart) []byte {
+	return encodeJSON(p)
+}
+
+// DecodeHistoryStart decodes history start metastate from json.
+func DecodeHistoryStart(data []byte) (types.HistoryStart, error) {
+	var start types.HistoryStart
+	err := DecodeJSON(data, &start)
+	if err != nil {
+		return types.HistoryStart{}, fmt.Errorf("DecodeHistoryStart() err: %w", err)
+	}
+
+	return start, nil
+}
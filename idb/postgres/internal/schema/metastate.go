@@ -7,4 +7,8 @@ const (
 	SpecialAccountsMetastateKey = "accounts"
 	NetworkMetaStateKey         = "network"
 	DeleteStatusKey             = "pruned"
+	AppGlobalDeltaStartKey      = "app_global_delta_start"
+	TxnStatsStartKey            = "txn_stats_start"
+	BoxHistoryStartKey          = "box_history_start"
+	FeeStatsStartKey            = "fee_stats_start"
 )
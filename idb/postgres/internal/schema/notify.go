@@ -0,0 +1,5 @@
+package schema
+
+// RoundNotifyChannel is the LISTEN/NOTIFY channel which receives the round
+// number as its payload whenever a round is committed.
+const RoundNotifyChannel = "indexer_round"
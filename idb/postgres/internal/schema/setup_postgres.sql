@@ -13,6 +13,9 @@ CREATE TABLE IF NOT EXISTS block_header (
 -- efficient since there is such a high correlation between round and time.
 CREATE INDEX IF NOT EXISTS block_header_time ON block_header (realtime);
 
+-- Optional, to make proposer statistics fast:
+-- CREATE INDEX CONCURRENTLY IF NOT EXISTS block_header_proposer ON block_header ((header ->> 'prp'), round) WHERE header ? 'prp';
+
 CREATE TABLE IF NOT EXISTS txn (
   round bigint NOT NULL,
   intra integer NOT NULL,
@@ -27,6 +30,12 @@ CREATE TABLE IF NOT EXISTS txn (
 -- For transaction lookup
 CREATE INDEX IF NOT EXISTS txn_by_tixid ON txn ( txid );
 
+-- For transaction group lookup
+CREATE TABLE IF NOT EXISTS txn_group (
+  grp bytea PRIMARY KEY, -- [32]byte group id
+  round bigint NOT NULL
+);
+
 -- Optional, to make txn queries by asset fast:
 -- CREATE INDEX CONCURRENTLY IF NOT EXISTS txn_asset ON txn (asset, round, intra);
 
@@ -125,3 +134,53 @@ CREATE TABLE IF NOT EXISTS app_box (
   value bytea NOT NULL, -- upon creation 'value' is 0x000...000 with length being the box'es size
   PRIMARY KEY (app, name)
 );
+
+-- For looking up the history of application global state keys
+CREATE TABLE IF NOT EXISTS app_global_delta (
+  app bigint NOT NULL,
+  key bytea NOT NULL,
+  round bigint NOT NULL,
+  intra integer NOT NULL, -- intra round offset of the (possibly inner) transaction which made the change
+  txid bytea NOT NULL, -- base32 of [32]byte hash of the root transaction
+  action smallint NOT NULL, -- 1 set bytes, 2 set uint, 3 delete
+  bytes bytea, -- new value when action is 1
+  uint numeric(20), -- new value when action is 2
+  PRIMARY KEY (app, key, round, intra)
+);
+
+-- For looking up the history of application boxes, only filled when box history is enabled
+CREATE TABLE IF NOT EXISTS app_box_history (
+  app bigint NOT NULL,
+  name bytea NOT NULL,
+  round bigint NOT NULL,
+  value bytea, -- NULL when the box was deleted
+  PRIMARY KEY (app, name, round)
+);
+
+-- Network-wide transaction statistics, maintained as blocks are added
+CREATE TABLE IF NOT EXISTS txn_stats (
+  interval smallint NOT NULL, -- 1 hour, 2 day
+  bucket timestamp without time zone NOT NULL, -- start of the bucket, UTC
+  min_round bigint NOT NULL,
+  max_round bigint NOT NULL,
+  txns bigint[] NOT NULL, -- number of transactions indexed by typeenum, including inner transactions
+  inner_txns bigint NOT NULL,
+  fees bigint NOT NULL,
+  senders bigint NOT NULL, -- distinct senders of top level transactions
+  PRIMARY KEY (interval, bucket)
+);
+
+-- The senders of the latest bucket of each interval, for counting distinct senders
+CREATE TABLE IF NOT EXISTS txn_stats_sender (
+  interval smallint NOT NULL,
+  bucket timestamp without time zone NOT NULL,
+  addr bytea NOT NULL,
+  PRIMARY KEY (interval, bucket, addr)
+);
+
+-- Distribution of the fees paid by the top level transactions of each round
+CREATE TABLE IF NOT EXISTS fee_stats (
+  round bigint PRIMARY KEY,
+  fees bigint[] NOT NULL, -- distinct fees paid, ascending
+  counts bigint[] NOT NULL -- number of transactions paying each fee
+);
@@ -29,6 +29,11 @@ type NetworkState struct {
 	GenesisHash sdk.Digest `codec:"genesis-hash"`
 }
 
+// HistoryStart encodes the first round recorded by a history table.
+type HistoryStart struct {
+	Round uint64 `codec:"round"`
+}
+
 // DeleteStatus encodes pruned metastate.
 type DeleteStatus struct {
 	LastPruned  string `codec:"last_pruned"`
@@ -0,0 +1,41 @@
+package writer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v4"
+
+	"github.com/algorand/avm-abi/apps"
+
+	"github.com/algorand/go-algorand-sdk/v2/types"
+)
+
+// AddAppBoxHistory writes the box changes of the round to the `app_box_history`
+// table. Like writeBoxMods, it assumes that all the kvMods are app boxes.
+func AddAppBoxHistory(round types.Round, kvMods map[string]types.KvValueDelta, tx pgx.Tx) error {
+	if len(kvMods) == 0 {
+		return nil
+	}
+
+	rows := make([][]interface{}, 0, len(kvMods))
+	for key, valueDelta := range kvMods {
+		app, name, err := apps.SplitBoxKey(key)
+		if err != nil {
+			return fmt.Errorf("AddAppBoxHistory() err: %w", err)
+		}
+		// A nil value is stored as NULL and marks a deleted box.
+		rows = append(rows, []interface{}{app, []byte(name), uint64(round), []byte(valueDelta.Data)})
+	}
+
+	_, err := tx.CopyFrom(
+		context.Background(),
+		pgx.Identifier{"app_box_history"},
+		[]string{"app", "name", "round", "value"},
+		pgx.CopyFromRows(rows))
+	if err != nil {
+		return fmt.Errorf("AddAppBoxHistory() copy from err: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,127 @@
+package writer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v4"
+
+	"github.com/algorand/indexer/v3/util"
+
+	"github.com/algorand/go-algorand-sdk/v2/crypto"
+	"github.com/algorand/go-algorand-sdk/v2/types"
+)
+
+// appendGlobalDeltaRows appends a row for each global state key changed by
+// the transaction.
+func appendGlobalDeltaRows(stxnad *types.SignedTxnWithAD, round uint64, intra uint, block *types.Block, txid string, rows [][]interface{}) ([][]interface{}, error) {
+	if len(stxnad.ApplyData.EvalDelta.GlobalDelta) == 0 {
+		return rows, nil
+	}
+
+	app, err := transactionAssetID(stxnad, intra, block)
+	if err != nil {
+		return nil, err
+	}
+	for key, delta := range stxnad.ApplyData.EvalDelta.GlobalDelta {
+		var bytesValue, uintValue interface{}
+		switch delta.Action {
+		case types.SetBytesAction:
+			bytesValue = []byte(delta.Bytes)
+		case types.SetUintAction:
+			uintValue = delta.Uint
+		}
+		rows = append(rows, []interface{}{
+			app, []byte(key), round, intra, txid, int(delta.Action), bytesValue, uintValue})
+	}
+	return rows, nil
+}
+
+// appendInnerGlobalDeltaRows traverses the inner transaction tree and adds
+// global delta rows for each. It performs a preorder traversal to correctly
+// compute the intra round offset, the offset for the next transaction is
+// returned.
+func appendInnerGlobalDeltaRows(stxnad *types.SignedTxnWithAD, round uint64, intra uint, txid string, rows [][]interface{}) (uint, [][]interface{}, error) {
+	next := intra
+	for _, itxn := range stxnad.ApplyData.EvalDelta.InnerTxns {
+		var err error
+		// block shouldn't be used for inner transactions.
+		rows, err = appendGlobalDeltaRows(&itxn, round, next, nil, txid, rows)
+		if err != nil {
+			return 0, nil, err
+		}
+
+		next, rows, err = appendInnerGlobalDeltaRows(&itxn, round, next+1, txid, rows)
+		if err != nil {
+			return 0, nil, err
+		}
+	}
+	return next, rows, nil
+}
+
+// hasGlobalDelta returns true if the transaction or any of its inner
+// transactions changed global state.
+func hasGlobalDelta(stxnad *types.SignedTxnWithAD) bool {
+	if len(stxnad.ApplyData.EvalDelta.GlobalDelta) > 0 {
+		return true
+	}
+	for i := range stxnad.ApplyData.EvalDelta.InnerTxns {
+		if hasGlobalDelta(&stxnad.ApplyData.EvalDelta.InnerTxns[i]) {
+			return true
+		}
+	}
+	return false
+}
+
+// AddAppGlobalDeltas writes the application global state changes of each
+// transaction to the `app_global_delta` table.
+func AddAppGlobalDeltas(block *types.Block, tx pgx.Tx) error {
+	var rows [][]interface{}
+	round := uint64(block.Round)
+	next := uint(0)
+
+	for _, stib := range block.Payset {
+		if !hasGlobalDelta(&stib.SignedTxnWithAD) {
+			next += 1 + countInner(&stib.SignedTxnWithAD)
+			continue
+		}
+
+		// Decode the transaction to restore the genesis information needed for the txid.
+		var stxnad types.SignedTxnWithAD
+		var err error
+		stxnad.SignedTxn, stxnad.ApplyData, err = util.DecodeSignedTxn(block.BlockHeader, stib)
+		if err != nil {
+			return fmt.Errorf("AddAppGlobalDeltas() decode signed txn err: %w", err)
+		}
+		txid := crypto.TransactionIDString(stxnad.Txn)
+
+		rows, err = appendGlobalDeltaRows(&stxnad, round, next, block, txid, rows)
+		if err != nil {
+			return fmt.Errorf("AddAppGlobalDeltas() err: %w", err)
+		}
+		next, rows, err = appendInnerGlobalDeltaRows(&stib.SignedTxnWithAD, round, next+1, txid, rows)
+		if err != nil {
+			return fmt.Errorf("AddAppGlobalDeltas() inner err: %w", err)
+		}
+	}
+
+	_, err := tx.CopyFrom(
+		context.Background(),
+		pgx.Identifier{"app_global_delta"},
+		[]string{"app", "key", "round", "intra", "txid", "action", "bytes", "uint"},
+		pgx.CopyFromRows(rows))
+	if err != nil {
+		return fmt.Errorf("AddAppGlobalDeltas() copy from err: %w", err)
+	}
+
+	return nil
+}
+
+// countInner returns the number of inner transactions in the tree.
+func countInner(stxnad *types.SignedTxnWithAD) uint {
+	num := uint(0)
+	for i := range stxnad.ApplyData.EvalDelta.InnerTxns {
+		num += 1 + countInner(&stxnad.ApplyData.EvalDelta.InnerTxns[i])
+	}
+	return num
+}
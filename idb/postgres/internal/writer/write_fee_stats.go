@@ -0,0 +1,43 @@
+package writer
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/jackc/pgx/v4"
+
+	"github.com/algorand/go-algorand-sdk/v2/types"
+)
+
+// AddFeeStats writes the distribution of the fees paid by the top level
+// transactions of the block to the `fee_stats` table. Inner transactions are
+// not counted, their fees are pooled by the root transaction. Nothing is
+// written for empty blocks.
+func AddFeeStats(block *types.Block, tx pgx.Tx) error {
+	if len(block.Payset) == 0 {
+		return nil
+	}
+
+	counts := make(map[int64]int64)
+	for i := range block.Payset {
+		counts[int64(block.Payset[i].Txn.Fee)]++
+	}
+	fees := make([]int64, 0, len(counts))
+	for fee := range counts {
+		fees = append(fees, fee)
+	}
+	slices.Sort(fees)
+	feeCounts := make([]int64, 0, len(fees))
+	for _, fee := range fees {
+		feeCounts = append(feeCounts, counts[fee])
+	}
+
+	_, err := tx.Exec(
+		context.Background(), `INSERT INTO fee_stats (round, fees, counts) VALUES ($1, $2, $3)`,
+		uint64(block.Round), fees, feeCounts)
+	if err != nil {
+		return fmt.Errorf("AddFeeStats() err: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+package writer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v4"
+
+	"github.com/algorand/go-algorand-sdk/v2/types"
+)
+
+// AddTransactionGroups writes the round of each transaction group to the
+// `txn_group` table.
+func AddTransactionGroups(block *types.Block, tx pgx.Tx) error {
+	var rows [][]interface{}
+	seen := make(map[types.Digest]struct{})
+
+	for _, stxnib := range block.Payset {
+		group := stxnib.Txn.Group
+		if group == (types.Digest{}) {
+			continue
+		}
+		if _, ok := seen[group]; ok {
+			continue
+		}
+		seen[group] = struct{}{}
+		rows = append(rows, []interface{}{group[:], uint64(block.Round)})
+	}
+
+	_, err := tx.CopyFrom(
+		context.Background(),
+		pgx.Identifier{"txn_group"},
+		[]string{"grp", "round"},
+		pgx.CopyFromRows(rows))
+	if err != nil {
+		return fmt.Errorf("AddTransactionGroups() copy from err: %w", err)
+	}
+
+	return nil
+}
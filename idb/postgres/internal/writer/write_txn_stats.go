@@ -0,0 +1,121 @@
+package writer
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+
+	"github.com/algorand/indexer/v3/idb"
+
+	"github.com/algorand/go-algorand-sdk/v2/types"
+)
+
+const deleteTxnStatsSendersQuery = `DELETE FROM txn_stats_sender WHERE interval = $1 AND bucket < $2`
+
+const addTxnStatsSendersQuery = `INSERT INTO txn_stats_sender (interval, bucket, addr)
+	SELECT $1, $2, unnest($3::bytea[]) ON CONFLICT DO NOTHING`
+
+// The transaction counts are added element-wise, indexed by type enum.
+const upsertTxnStatsQuery = `INSERT INTO txn_stats
+	(interval, bucket, min_round, max_round, txns, inner_txns, fees, senders)
+	VALUES ($1, $2, $3, $3, $4, $5, $6, $7)
+	ON CONFLICT (interval, bucket) DO UPDATE SET
+	max_round = EXCLUDED.max_round,
+	txns = ARRAY(
+		SELECT COALESCE(a, 0) + COALESCE(b, 0)
+		FROM unnest(txn_stats.txns, EXCLUDED.txns) WITH ORDINALITY AS t(a, b, i)
+		ORDER BY i),
+	inner_txns = txn_stats.inner_txns + EXCLUDED.inner_txns,
+	fees = txn_stats.fees + EXCLUDED.fees,
+	senders = txn_stats.senders + EXCLUDED.senders`
+
+// txnStats holds the statistics of the transactions in a block.
+type txnStats struct {
+	txns      []int64 // indexed by type enum
+	innerTxns int64
+	fees      uint64
+	senders   [][]byte
+}
+
+func (s *txnStats) add(stxnad *types.SignedTxnWithAD, inner bool) error {
+	typeenum, ok := idb.GetTypeEnum(stxnad.Txn.Type)
+	if !ok {
+		return fmt.Errorf("add() get type enum")
+	}
+	for len(s.txns) <= int(typeenum) {
+		s.txns = append(s.txns, 0)
+	}
+	s.txns[typeenum]++
+	if inner {
+		s.innerTxns++
+	}
+	s.fees += uint64(stxnad.Txn.Fee)
+
+	for i := range stxnad.ApplyData.EvalDelta.InnerTxns {
+		if err := s.add(&stxnad.ApplyData.EvalDelta.InnerTxns[i], true); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func makeTxnStats(block *types.Block) (txnStats, error) {
+	var stats txnStats
+	seen := make(map[types.Address]struct{})
+	for i := range block.Payset {
+		stxnad := &block.Payset[i].SignedTxnWithAD
+		if err := stats.add(stxnad, false); err != nil {
+			return txnStats{}, err
+		}
+		if _, ok := seen[stxnad.Txn.Sender]; !ok {
+			seen[stxnad.Txn.Sender] = struct{}{}
+			stats.senders = append(stats.senders, stxnad.Txn.Sender[:])
+		}
+	}
+	return stats, nil
+}
+
+// AddTxnStats adds the transactions of the block to its buckets in the
+// `txn_stats` table. The proposer payout is not a transaction and is not
+// counted. To count the distinct senders, the senders of the latest bucket
+// of each interval are kept in `txn_stats_sender`, blocks must be added in
+// order.
+func AddTxnStats(block *types.Block, tx pgx.Tx) error {
+	stats, err := makeTxnStats(block)
+	if err != nil {
+		return fmt.Errorf("AddTxnStats() err: %w", err)
+	}
+	realtime := time.Unix(block.TimeStamp, 0).UTC()
+
+	for _, interval := range idb.TxnStatsIntervals {
+		bucket := interval.Truncate(realtime)
+
+		_, err := tx.Exec(context.Background(), deleteTxnStatsSendersQuery, interval, bucket)
+		if err != nil {
+			return fmt.Errorf("AddTxnStats() delete senders err: %w", err)
+		}
+		var newSenders int64
+		if len(stats.senders) > 0 {
+			tag, err := tx.Exec(context.Background(), addTxnStatsSendersQuery, interval, bucket, stats.senders)
+			if err != nil {
+				return fmt.Errorf("AddTxnStats() add senders err: %w", err)
+			}
+			newSenders = tag.RowsAffected()
+		}
+
+		txns := stats.txns
+		if txns == nil {
+			txns = []int64{}
+		}
+		_, err = tx.Exec(
+			context.Background(), upsertTxnStatsQuery,
+			interval, bucket, uint64(block.Round), txns, stats.innerTxns, stats.fees, newSenders)
+		if err != nil {
+			return fmt.Errorf("AddTxnStats() upsert err: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -34,6 +34,7 @@ const (
 	deleteAccountAppStmtName           = "delete_account_app"
 	upsertAppBoxStmtName               = "upsert_app_box"
 	deleteAppBoxStmtName               = "delete_app_box"
+	notifyRoundStmtName                = "notify_round"
 )
 
 var statements = map[string]string{
@@ -111,6 +112,7 @@ var statements = map[string]string{
 		ON CONFLICT (app, name) DO UPDATE SET
 		value = EXCLUDED.value`,
 	deleteAppBoxStmtName: `DELETE FROM app_box WHERE app = $1 and name = $2`,
+	notifyRoundStmtName:  `SELECT pg_notify('` + schema.RoundNotifyChannel + `', $1)`,
 }
 
 // Writer is responsible for writing blocks and accounting state deltas to the database.
@@ -153,6 +155,12 @@ func setSpecialAccounts(addresses types.SpecialAddresses, batch *pgx.Batch) {
 	batch.Queue(setSpecialAccountsStmtName, j)
 }
 
+// notifyRound queues a notification which is delivered to listeners when the
+// transaction commits.
+func notifyRound(round sdk.Round, batch *pgx.Batch) {
+	batch.Queue(notifyRoundStmtName, strconv.FormatUint(uint64(round), 10))
+}
+
 // Describes a change to the `account.keytype` column. If `present` is true,
 // `value` is the new value. Otherwise, NULL will be the new value.
 type sigTypeDelta struct {
@@ -330,6 +338,7 @@ func (w *Writer) AddBlock0(block *sdk.Block) error {
 		RewardsPool: block.RewardsPool,
 	}
 	setSpecialAccounts(specialAddresses, &batch)
+	notifyRound(block.Round, &batch)
 
 	results := w.tx.SendBatch(context.Background(), &batch)
 	// Clean the results off the connection's queue. Without this, weird things happen.
@@ -372,6 +381,7 @@ func (w *Writer) AddBlock(block *sdk.Block, delta sdk.LedgerStateDelta) error {
 			return fmt.Errorf("AddBlock() err on boxes: %w", err)
 		}
 	}
+	notifyRound(block.Round, &batch)
 
 	results := w.tx.SendBatch(context.Background(), &batch)
 	// Clean the results off the connection's queue. Without this, weird things happen.
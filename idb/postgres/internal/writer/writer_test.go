@@ -136,6 +136,40 @@ func TestWriterBlockHeaderTableBasic(t *testing.T) {
 	assert.Equal(t, block.BlockHeader, headerRead)
 }
 
+func TestWriterAddBlockNotifiesRound(t *testing.T) {
+	db, _, shutdownFunc := pgtest.SetupPostgresWithSchema(t)
+	defer shutdownFunc()
+
+	conn, err := db.Acquire(context.Background())
+	require.NoError(t, err)
+	defer conn.Release()
+	_, err = conn.Exec(context.Background(), "LISTEN "+schema.RoundNotifyChannel)
+	require.NoError(t, err)
+
+	var block sdk.Block
+	block.BlockHeader.Round = sdk.Round(2)
+
+	f := func(tx pgx.Tx) error {
+		w, err := writer.MakeWriter(tx)
+		require.NoError(t, err)
+
+		err = w.AddBlock(&block, sdk.LedgerStateDelta{})
+		require.NoError(t, err)
+
+		w.Close()
+		return nil
+	}
+	err = pgutil.TxWithRetry(db, serializable, f, nil)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	notification, err := conn.Conn().WaitForNotification(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, schema.RoundNotifyChannel, notification.Channel)
+	assert.Equal(t, "2", notification.Payload)
+}
+
 func TestWriterSpecialAccounts(t *testing.T) {
 	db, _, shutdownFunc := pgtest.SetupPostgresWithSchema(t)
 	defer shutdownFunc()
@@ -2159,3 +2193,183 @@ func TestWriterAppBoxTableInsertMutateDelete(t *testing.T) {
 
 	validateTotals()
 }
+
+func TestAddAppGlobalDeltas(t *testing.T) {
+	db, _, shutdownFunc := pgtest.SetupPostgresWithSchema(t)
+	defer shutdownFunc()
+
+	// The inner application call of the inner application call is at intra 4.
+	appCall0 := test.MakeAppCallWithInnerTxn(test.AccountA, test.AccountB, test.AccountC, test.AccountD, test.AccountE)
+	appCall0.ApplyData.EvalDelta.InnerTxns[1].ApplyData.EvalDelta.InnerTxns[1].ApplyData.EvalDelta.GlobalDelta =
+		sdk.StateDelta{"key": {Action: sdk.SetBytesAction, Bytes: "value"}}
+	appCall1 := test.MakeSimpleAppCallTxn(777, test.AccountA)
+	appCall1.ApplyData.EvalDelta.GlobalDelta = sdk.StateDelta{
+		"counter": {Action: sdk.SetUintAction, Uint: 3},
+		"deleted": {Action: sdk.DeleteAction},
+	}
+
+	block, err := test.MakeBlockForTxns(test.MakeGenesisBlock().BlockHeader, &appCall0, &appCall1)
+	require.NoError(t, err)
+
+	err = makeTx(db, func(tx pgx.Tx) error {
+		return writer.AddAppGlobalDeltas(&block, tx)
+	})
+	require.NoError(t, err)
+
+	type globalDeltaRow struct {
+		app    uint64
+		key    string
+		round  uint64
+		intra  uint64
+		txid   string
+		action int
+		bytes  []byte
+		uint   *uint64
+	}
+	rows, err := db.Query(context.Background(),
+		"SELECT app, key, round, intra, txid, action, bytes, uint FROM app_global_delta ORDER BY intra, key")
+	require.NoError(t, err)
+	defer rows.Close()
+	var results []globalDeltaRow
+	for rows.Next() {
+		var row globalDeltaRow
+		var key, txid []byte
+		require.NoError(t, rows.Scan(&row.app, &key, &row.round, &row.intra, &txid, &row.action, &row.bytes, &row.uint))
+		row.key = string(key)
+		row.txid = string(txid)
+		results = append(results, row)
+	}
+	require.NoError(t, rows.Err())
+
+	txid0 := crypto2.TransactionIDString(appCall0.Txn)
+	txid1 := crypto2.TransactionIDString(appCall1.Txn)
+	three := uint64(3)
+	expected := []globalDeltaRow{
+		{app: 789, key: "key", round: 1, intra: 4, txid: txid0, action: int(sdk.SetBytesAction), bytes: []byte("value")},
+		{app: 777, key: "counter", round: 1, intra: 5, txid: txid1, action: int(sdk.SetUintAction), uint: &three},
+		{app: 777, key: "deleted", round: 1, intra: 5, txid: txid1, action: int(sdk.DeleteAction)},
+	}
+	assert.Equal(t, expected, results)
+}
+
+func TestAddTxnStats(t *testing.T) {
+	db, _, shutdownFunc := pgtest.SetupPostgresWithSchema(t)
+	defer shutdownFunc()
+
+	start := time.Date(2024, 6, 1, 13, 0, 0, 0, time.UTC)
+	addBlock := func(prevHeader sdk.BlockHeader, realtime time.Time, txns ...*sdk.SignedTxnWithAD) sdk.Block {
+		block, err := test.MakeBlockForTxns(prevHeader, txns...)
+		require.NoError(t, err)
+		block.TimeStamp = realtime.Unix()
+		err = makeTx(db, func(tx pgx.Tx) error {
+			return writer.AddTxnStats(&block, tx)
+		})
+		require.NoError(t, err)
+		return block
+	}
+
+	pay0 := test.MakePaymentTxn(1000, 10, 0, 0, 0, 0, test.AccountA, test.AccountB, sdk.ZeroAddress, sdk.ZeroAddress)
+	pay1 := test.MakePaymentTxn(1000, 10, 0, 0, 0, 0, test.AccountA, test.AccountC, sdk.ZeroAddress, sdk.ZeroAddress)
+	// Inner payment, inner application call and its inner asset transfer.
+	appCall := test.MakeAppCallWithInnerTxn(test.AccountB, test.AccountD, test.AccountE, test.AccountD, test.AccountE)
+	appCall.Txn.Fee = 3000
+	appCall.ApplyData.EvalDelta.InnerTxns[0].Txn.Fee = 500
+	block := addBlock(test.MakeGenesisBlock().BlockHeader, start.Add(10*time.Minute), &pay0, &pay1, &appCall)
+
+	// Same hour, one new sender.
+	pay2 := test.MakePaymentTxn(1000, 10, 0, 0, 0, 0, test.AccountC, test.AccountA, sdk.ZeroAddress, sdk.ZeroAddress)
+	pay3 := test.MakePaymentTxn(1000, 10, 0, 0, 0, 0, test.AccountA, test.AccountB, sdk.ZeroAddress, sdk.ZeroAddress)
+	block = addBlock(block.BlockHeader, start.Add(40*time.Minute), &pay2, &pay3)
+
+	// Next hour, same day.
+	pay4 := test.MakePaymentTxn(1000, 10, 0, 0, 0, 0, test.AccountA, test.AccountB, sdk.ZeroAddress, sdk.ZeroAddress)
+	addBlock(block.BlockHeader, start.Add(65*time.Minute), &pay4)
+
+	type txnStatsRow struct {
+		interval  idb.TxnStatsInterval
+		bucket    time.Time
+		minRound  uint64
+		maxRound  uint64
+		txns      []int64
+		innerTxns uint64
+		fees      uint64
+		senders   uint64
+	}
+	rows, err := db.Query(context.Background(),
+		"SELECT interval, bucket, min_round, max_round, txns, inner_txns, fees, senders FROM txn_stats ORDER BY interval, bucket")
+	require.NoError(t, err)
+	defer rows.Close()
+	var results []txnStatsRow
+	for rows.Next() {
+		var row txnStatsRow
+		require.NoError(t, rows.Scan(
+			&row.interval, &row.bucket, &row.minRound, &row.maxRound, &row.txns, &row.innerTxns, &row.fees, &row.senders))
+		row.bucket = row.bucket.UTC()
+		results = append(results, row)
+	}
+	require.NoError(t, rows.Err())
+
+	// Indexed by type enum: pay, keyreg, acfg, axfer, afrz, appl.
+	day := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	expected := []txnStatsRow{
+		{idb.TxnStatsHour, start, 1, 2, []int64{0, 5, 0, 0, 1, 0, 2}, 3, 7500, 3},
+		{idb.TxnStatsHour, start.Add(time.Hour), 3, 3, []int64{0, 1}, 0, 1000, 1},
+		{idb.TxnStatsDay, day, 1, 3, []int64{0, 6, 0, 0, 1, 0, 2}, 3, 8500, 3},
+	}
+	assert.Equal(t, expected, results)
+
+	// Only the senders of the latest buckets are kept.
+	var count int
+	row := db.QueryRow(context.Background(), "SELECT count(*) FROM txn_stats_sender WHERE interval = 1")
+	require.NoError(t, row.Scan(&count))
+	assert.Equal(t, 1, count)
+	row = db.QueryRow(context.Background(), "SELECT count(*) FROM txn_stats_sender WHERE interval = 2")
+	require.NoError(t, row.Scan(&count))
+	assert.Equal(t, 3, count)
+}
+
+func TestAddFeeStats(t *testing.T) {
+	db, _, shutdownFunc := pgtest.SetupPostgresWithSchema(t)
+	defer shutdownFunc()
+
+	pay0 := test.MakePaymentTxn(2000, 10, 0, 0, 0, 0, test.AccountA, test.AccountB, sdk.ZeroAddress, sdk.ZeroAddress)
+	pay1 := test.MakePaymentTxn(1000, 10, 0, 0, 0, 0, test.AccountA, test.AccountC, sdk.ZeroAddress, sdk.ZeroAddress)
+	pay2 := test.MakePaymentTxn(1000, 10, 0, 0, 0, 0, test.AccountB, test.AccountC, sdk.ZeroAddress, sdk.ZeroAddress)
+	// The inner transactions are not counted.
+	appCall := test.MakeAppCallWithInnerTxn(test.AccountB, test.AccountD, test.AccountE, test.AccountD, test.AccountE)
+	appCall.ApplyData.EvalDelta.InnerTxns[0].Txn.Fee = 500
+
+	block, err := test.MakeBlockForTxns(test.MakeGenesisBlock().BlockHeader, &pay0, &pay1, &pay2, &appCall)
+	require.NoError(t, err)
+
+	err = makeTx(db, func(tx pgx.Tx) error {
+		return writer.AddFeeStats(&block, tx)
+	})
+	require.NoError(t, err)
+
+	var round uint64
+	var fees, counts []int64
+	row := db.QueryRow(context.Background(), "SELECT round, fees, counts FROM fee_stats")
+	require.NoError(t, row.Scan(&round, &fees, &counts))
+	assert.Equal(t, uint64(1), round)
+	assert.Equal(t, []int64{0, 1000, 2000}, fees)
+	assert.Equal(t, []int64{1, 2, 1}, counts)
+}
+
+func TestAddFeeStatsEmptyBlock(t *testing.T) {
+	db, _, shutdownFunc := pgtest.SetupPostgresWithSchema(t)
+	defer shutdownFunc()
+
+	block, err := test.MakeBlockForTxns(test.MakeGenesisBlock().BlockHeader)
+	require.NoError(t, err)
+
+	err = makeTx(db, func(tx pgx.Tx) error {
+		return writer.AddFeeStats(&block, tx)
+	})
+	require.NoError(t, err)
+
+	var count int
+	row := db.QueryRow(context.Background(), "SELECT count(*) FROM fee_stats")
+	require.NoError(t, row.Scan(&count))
+	assert.Equal(t, 0, count)
+}
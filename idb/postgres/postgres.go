@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"os"
 	"reflect"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -71,9 +72,12 @@ func OpenPostgres(connection string, opts idb.IndexerDbOptions, log *log.Logger)
 // Allow tests to inject a DB
 func openPostgres(db *pgxpool.Pool, opts idb.IndexerDbOptions, logger *log.Logger) (*IndexerDb, chan struct{}, error) {
 	idb := &IndexerDb{
-		readonly: opts.ReadOnly,
-		log:      logger,
-		db:       db,
+		readonly:   opts.ReadOnly,
+		boxHistory: opts.BoxHistory,
+		txnStats:   opts.TxnStats,
+		feeStats:   opts.FeeStats,
+		log:        logger,
+		db:         db,
 	}
 
 	if idb.log == nil {
@@ -108,8 +112,11 @@ func openPostgres(db *pgxpool.Pool, opts idb.IndexerDbOptions, logger *log.Logge
 
 // IndexerDb is an idb.IndexerDB implementation
 type IndexerDb struct {
-	readonly bool
-	log      *log.Logger
+	readonly   bool
+	boxHistory bool
+	txnStats   bool
+	feeStats   bool
+	log        *log.Logger
 
 	db             *pgxpool.Pool
 	migration      *migration.Migration
@@ -232,7 +239,15 @@ func (db *IndexerDb) AddBlock(vb *itypes.ValidatedBlock) error {
 				if err != nil {
 					return err
 				}
-				return writer.AddTransactionParticipation(&block, tx)
+				err = writer.AddTransactionParticipation(&block, tx)
+				if err != nil {
+					return err
+				}
+				err = writer.AddTransactionGroups(&block, tx)
+				if err != nil {
+					return err
+				}
+				return writer.AddAppGlobalDeltas(&block, tx)
 			}
 			err0 = db.txWithRetry(serializable, f)
 		}()
@@ -241,6 +256,36 @@ func (db *IndexerDb) AddBlock(vb *itypes.ValidatedBlock) error {
 		if err != nil {
 			return fmt.Errorf("AddBlock() err: %w", err)
 		}
+		if db.boxHistory {
+			err = writer.AddAppBoxHistory(round, vb.Delta.KvMods, tx)
+			if err != nil {
+				return fmt.Errorf("AddBlock() err: %w", err)
+			}
+			err = db.initHistoryStart(tx, schema.BoxHistoryStartKey, round)
+			if err != nil {
+				return fmt.Errorf("AddBlock() err: %w", err)
+			}
+		}
+		if db.txnStats {
+			err = writer.AddTxnStats(&block, tx)
+			if err != nil {
+				return fmt.Errorf("AddBlock() err: %w", err)
+			}
+			err = db.initHistoryStart(tx, schema.TxnStatsStartKey, round)
+			if err != nil {
+				return fmt.Errorf("AddBlock() err: %w", err)
+			}
+		}
+		if db.feeStats {
+			err = writer.AddFeeStats(&block, tx)
+			if err != nil {
+				return fmt.Errorf("AddBlock() err: %w", err)
+			}
+			err = db.initHistoryStart(tx, schema.FeeStatsStartKey, round)
+			if err != nil {
+				return fmt.Errorf("AddBlock() err: %w", err)
+			}
+		}
 
 		// Wait for goroutines to finish and check for errors. If there is an error, we
 		// return our own error so that the main transaction does not commit. Hence,
@@ -498,6 +543,41 @@ func (db *IndexerDb) GetBlock(ctx context.Context, round uint64, options idb.Get
 	return blockHeader, transactions, nil
 }
 
+// RoundsAtTime is part of idb.IndexerDB
+func (db *IndexerDb) RoundsAtTime(ctx context.Context, at time.Time) (idb.RoundsAtTime, uint64, error) {
+	tx, err := db.db.BeginTx(ctx, readonlyRepeatableRead)
+	if err != nil {
+		return idb.RoundsAtTime{}, 0, err
+	}
+	defer tx.Rollback(ctx)
+
+	round, err := db.getMaxRoundAccounted(ctx, tx)
+	if err != nil {
+		return idb.RoundsAtTime{}, 0, err
+	}
+
+	// Both queries use the block_header_time index. Block timestamps never
+	// decrease, the round is only compared to break ties.
+	queries := []string{
+		`SELECT round, realtime FROM block_header WHERE realtime <= $1 ORDER BY realtime DESC, round DESC LIMIT 1`,
+		`SELECT round, realtime FROM block_header WHERE realtime > $1 ORDER BY realtime ASC, round ASC LIMIT 1`,
+	}
+	var results [2]*idb.RoundTime
+	for i, query := range queries {
+		var rt idb.RoundTime
+		err = tx.QueryRow(ctx, query, at.UTC()).Scan(&rt.Round, &rt.RoundTime)
+		if err == pgx.ErrNoRows {
+			continue
+		}
+		if err != nil {
+			return idb.RoundsAtTime{}, 0, fmt.Errorf("RoundsAtTime() err: %w", err)
+		}
+		results[i] = &rt
+	}
+
+	return idb.RoundsAtTime{Before: results[0], After: results[1]}, round, nil
+}
+
 func buildTransactionQuery(tf idb.TransactionFilter) (query string, whereArgs []interface{}, err error) {
 	// TODO? There are some combinations of tf params that will
 	// yield no results and we could catch that before asking the
@@ -523,7 +603,7 @@ func buildTransactionQuery(tf idb.TransactionFilter) (query string, whereArgs []
 		}
 		if tf.AddressRole != 0 {
 			addrBase64 := encoding.Base64(tf.Address)
-			roleparts := make([]string, 0, 8)
+			roleparts := make([]string, 0, 10)
 			if tf.AddressRole&idb.AddressRoleSender != 0 {
 				roleparts = append(roleparts, fmt.Sprintf("t.txn -> 'txn' ->> 'snd' = $%d", partNumber))
 				whereArgs = append(whereArgs, addrBase64)
@@ -559,6 +639,22 @@ func buildTransactionQuery(tf idb.TransactionFilter) (query string, whereArgs []
 				whereArgs = append(whereArgs, addrBase64)
 				partNumber++
 			}
+			if tf.AddressRole&idb.AddressRoleAppAccount != 0 {
+				roleparts = append(roleparts, fmt.Sprintf("t.txn -> 'txn' -> 'apat' ? $%d", partNumber))
+				whereArgs = append(whereArgs, addrBase64)
+				partNumber++
+			}
+			if tf.AddressRole&idb.AddressRoleInnerReceiver != 0 {
+				// Inner transactions are the rows without a txid.
+				roleparts = append(roleparts, fmt.Sprintf("(t.txid IS NULL AND (t.txn -> 'txn' ->> 'rcv' = $%d OR t.txn -> 'txn' ->> 'arcv' = $%d))", partNumber, partNumber))
+				whereArgs = append(whereArgs, addrBase64)
+				partNumber++
+			}
+			if tf.AddressRole&idb.AddressRoleHeartbeat != 0 {
+				roleparts = append(roleparts, fmt.Sprintf("t.txn -> 'txn' -> 'hb' ->> 'a' = $%d", partNumber))
+				whereArgs = append(whereArgs, addrBase64)
+				partNumber++
+			}
 			rolepart := strings.Join(roleparts, " OR ")
 			whereParts = append(whereParts, "("+rolepart+")")
 		}
@@ -669,6 +765,36 @@ func buildTransactionQuery(tf idb.TransactionFilter) (query string, whereArgs []
 		whereArgs = append(whereArgs, tf.NotePrefix)
 		partNumber++
 	}
+	if len(tf.MethodSelector) > 0 || tf.OnCompletion != nil || tf.ForeignAppID != nil || tf.ForeignAssetID != nil || tf.BoxReference != nil {
+		// Restricting the application call filters to application calls
+		// allows using the partial indexes described in docs/PostgresqlIndexes.md.
+		whereParts = append(whereParts, fmt.Sprintf("t.typeenum = %d", idb.TypeEnumApplication))
+	}
+	if len(tf.MethodSelector) > 0 {
+		whereParts = append(whereParts, fmt.Sprintf("decode(t.txn -> 'txn' -> 'apaa' ->> 0, 'base64') = $%d", partNumber))
+		whereArgs = append(whereArgs, tf.MethodSelector)
+		partNumber++
+	}
+	if tf.OnCompletion != nil {
+		whereParts = append(whereParts, fmt.Sprintf("COALESCE((t.txn -> 'txn' ->> 'apan')::int, 0) = $%d", partNumber))
+		whereArgs = append(whereArgs, uint64(*tf.OnCompletion))
+		partNumber++
+	}
+	if tf.ForeignAppID != nil {
+		whereParts = append(whereParts, fmt.Sprintf("t.txn -> 'txn' -> 'apfa' @> jsonb_build_array($%d::bigint)", partNumber))
+		whereArgs = append(whereArgs, *tf.ForeignAppID)
+		partNumber++
+	}
+	if tf.ForeignAssetID != nil {
+		whereParts = append(whereParts, fmt.Sprintf("t.txn -> 'txn' -> 'apas' @> jsonb_build_array($%d::bigint)", partNumber))
+		whereArgs = append(whereArgs, *tf.ForeignAssetID)
+		partNumber++
+	}
+	if tf.BoxReference != nil {
+		whereParts = append(whereParts, fmt.Sprintf("t.txn -> 'txn' -> 'apbx' @> jsonb_build_array(jsonb_build_object('n', $%d::text))", partNumber))
+		whereArgs = append(whereArgs, base64.StdEncoding.EncodeToString(tf.BoxReference))
+		partNumber++
+	}
 	if tf.AlgosGT != nil {
 		whereParts = append(whereParts, fmt.Sprintf("(t.txn -> 'txn' -> 'amt')::bigint > $%d", partNumber))
 		whereArgs = append(whereArgs, *tf.AlgosGT)
@@ -698,6 +824,9 @@ func buildTransactionQuery(tf idb.TransactionFilter) (query string, whereArgs []
 	if tf.RequireApplicationLogs {
 		whereParts = append(whereParts, "t.txn -> 'dt' -> 'lg' IS NOT NULL")
 	}
+	if tf.RequireAuthAddrChange {
+		whereParts = append(whereParts, "((t.txn -> 'txn' -> 'rekey') IS NOT NULL OR (t.txn -> 'txn' -> 'close') IS NOT NULL)")
+	}
 
 	// If these flags are true, return the root transaction
 	if tf.SkipInnerTransactionConversion || tf.SkipInnerTransactions {
@@ -720,12 +849,16 @@ func buildTransactionQuery(tf idb.TransactionFilter) (query string, whereArgs []
 		query += " WHERE " + whereStr
 	}
 
+	direction := ""
+	if tf.Descending() {
+		direction = " DESC"
+	}
 	if joinParticipation {
 		// this should match the index on txn_participation
-		query += " ORDER BY p.addr, p.round DESC, p.intra DESC"
+		query += " ORDER BY p.addr, p.round" + direction + ", p.intra" + direction
 	} else {
 		// this should explicitly match the primary key on txn (round,intra)
-		query += " ORDER BY t.round, t.intra"
+		query += " ORDER BY t.round" + direction + ", t.intra" + direction
 	}
 
 	// Determine the LIMIT clause
@@ -792,6 +925,7 @@ func txnFilterOptimization(tf idb.TransactionFilter) idb.TransactionFilter {
 		AfterTime:  tf.AfterTime,
 		Limit:      tf.Limit,
 		NextToken:  tf.NextToken,
+		Order:      tf.Order,
 		Offset:     tf.Offset,
 		OffsetLT:   tf.OffsetLT,
 		OffsetGT:   tf.OffsetGT,
@@ -839,6 +973,64 @@ func (db *IndexerDb) Transactions(ctx context.Context, tf idb.TransactionFilter)
 	return out, round
 }
 
+// TransactionGroup is part of idb.IndexerDB
+func (db *IndexerDb) TransactionGroup(ctx context.Context, filter idb.TransactionGroupQuery) (<-chan idb.TxnRow, uint64) {
+	out := make(chan idb.TxnRow, 1)
+
+	// The group round comes from the txn_group table, or from the txid hint for
+	// groups imported before the table existed.
+	roundQuery := "(SELECT round FROM txn_group WHERE grp = $1)"
+	whereArgs := []interface{}{filter.GroupID, base64.StdEncoding.EncodeToString(filter.GroupID)}
+	if filter.Txid != "" {
+		roundQuery = "COALESCE(" + roundQuery + ", (SELECT round FROM txn WHERE txid = $3))"
+		whereArgs = append(whereArgs, filter.Txid)
+	}
+	query := "SELECT t.round, t.intra, t.txn, NULL, t.extra, t.asset, h.realtime FROM txn t JOIN block_header h ON t.round = h.round" +
+		" WHERE t.round = " + roundQuery + " AND t.txid IS NOT NULL AND t.txn #>> '{txn,grp}' = $2" +
+		" ORDER BY t.intra"
+
+	tx, err := db.db.BeginTx(ctx, readonlyRepeatableRead)
+	if err != nil {
+		out <- idb.TxnRow{Error: err}
+		close(out)
+		return out, 0
+	}
+
+	round, err := db.getMaxRoundAccounted(ctx, tx)
+	if err != nil {
+		out <- idb.TxnRow{Error: err}
+		close(out)
+		if rerr := tx.Rollback(ctx); rerr != nil {
+			db.log.Printf("rollback error: %s", rerr)
+		}
+		return out, round
+	}
+
+	rows, err := tx.Query(ctx, query, whereArgs...)
+	if err != nil {
+		out <- idb.TxnRow{Error: fmt.Errorf("txn group query %#v err %v", query, err)}
+		close(out)
+		if rerr := tx.Rollback(ctx); rerr != nil {
+			db.log.Printf("rollback error: %s", rerr)
+		}
+		return out, round
+	}
+
+	go func() {
+		db.yieldTxnsThreadSimple(rows, out, nil, nil)
+		// Because we return a channel into a "callWithTimeout" function,
+		// We need to make sure that rollback is called before close()
+		// otherwise we can end up with a situation where "callWithTimeout"
+		// will cancel our context, resulting in connection pool churn
+		if rerr := tx.Rollback(ctx); rerr != nil {
+			db.log.Printf("rollback error: %s", rerr)
+		}
+		close(out)
+	}()
+
+	return out, round
+}
+
 // This function blocks. `tx` must be non-nil.
 func (db *IndexerDb) txnsWithNext(ctx context.Context, tx pgx.Tx, tf idb.TransactionFilter, out chan<- idb.TxnRow) {
 	// TODO: Use txid to deduplicate next resultset at the query level?
@@ -853,7 +1045,7 @@ func (db *IndexerDb) txnsWithNext(ctx context.Context, tx pgx.Tx, tf idb.Transac
 	origRound := tf.Round
 	origOLT := tf.OffsetLT
 	origOGT := tf.OffsetGT
-	if tf.Address != nil {
+	if tf.Descending() {
 		// (round,intra) descending into the past
 		if nextround == 0 && nextintra == 0 {
 			return
@@ -896,7 +1088,7 @@ func (db *IndexerDb) txnsWithNext(ctx context.Context, tx pgx.Tx, tf idb.Transac
 	default:
 	}
 	tf.Round = origRound
-	if tf.Address != nil {
+	if tf.Descending() {
 		// (round,intra) descending into the past
 		tf.OffsetLT = origOLT
 
@@ -1048,11 +1240,12 @@ func buildBlockHeadersQuery(bf idb.BlockHeaderFilter) (query string, err error)
 		if len(bf.Proposers) > 0 {
 			var ps []string
 			for addr := range bf.Proposers {
-				ps = append(ps, `'"`+addr.String()+`"'`)
+				ps = append(ps, `'`+addr.String()+`'`)
 			}
+			// this should match the block_header_proposer index
 			whereTerms = append(
 				whereTerms,
-				fmt.Sprintf("( (bh.header->'prp') IS NOT NULL AND ((bh.header->'prp')::TEXT IN (%s)) )", strings.Join(ps, ",")),
+				fmt.Sprintf("( bh.header ? 'prp' AND (bh.header ->> 'prp') IN (%s) )", strings.Join(ps, ",")),
 			)
 		}
 		if len(bf.ExpiredParticipationAccounts) > 0 {
@@ -1087,9 +1280,13 @@ func buildBlockHeadersQuery(bf idb.BlockHeaderFilter) (query string, err error)
 			SELECT bh.header
 			FROM block_header bh
 			%s
-			ORDER BY bh.round ASC
+			ORDER BY bh.round %s
 			LIMIT %d`
-	query = fmt.Sprintf(tmpl, whereClause, bf.Limit)
+	direction := "ASC"
+	if bf.Descending() {
+		direction = "DESC"
+	}
+	query = fmt.Sprintf(tmpl, whereClause, direction, bf.Limit)
 
 	return query, nil
 }
@@ -2004,7 +2201,7 @@ func (db *IndexerDb) checkAccountResourceLimit(ctx context.Context, tx pgx.Tx, o
 
 func (db *IndexerDb) buildAccountQuery(opts idb.AccountQueryOptions, countOnly bool) (query string, whereArgs []interface{}) {
 	// Construct query for fetching accounts...
-	const maxWhereParts = 9
+	const maxWhereParts = 11
 	whereParts := make([]string, 0, maxWhereParts)
 	whereArgs = make([]interface{}, 0, maxWhereParts)
 	partNumber := 1
@@ -2053,6 +2250,11 @@ func (db *IndexerDb) buildAccountQuery(opts idb.AccountQueryOptions, countOnly b
 		whereArgs = append(whereArgs, opts.GreaterThanAddress)
 		partNumber++
 	}
+	if opts.OrderByBalance && len(opts.PrevAddress) > 0 {
+		whereParts = append(whereParts, fmt.Sprintf("(a.microalgos, a.addr) < ($%d, $%d)", partNumber, partNumber+1))
+		whereArgs = append(whereArgs, opts.PrevMicroalgos, opts.PrevAddress)
+		partNumber += 2
+	}
 	if len(opts.EqualToAddress) > 0 {
 		whereParts = append(whereParts, fmt.Sprintf("a.addr = $%d", partNumber))
 		whereArgs = append(whereArgs, opts.EqualToAddress)
@@ -2092,7 +2294,11 @@ func (db *IndexerDb) buildAccountQuery(opts idb.AccountQueryOptions, countOnly b
 		whereStr := strings.Join(whereParts, " AND ")
 		query += " WHERE " + whereStr
 	}
-	query += " ORDER BY a.addr ASC"
+	if opts.OrderByBalance {
+		query += " ORDER BY a.microalgos DESC, a.addr DESC"
+	} else {
+		query += " ORDER BY a.addr ASC"
+	}
 	if opts.Limit != 0 {
 		query += fmt.Sprintf(" LIMIT %d", opts.Limit)
 	}
@@ -2195,7 +2401,11 @@ func (db *IndexerDb) buildAccountQuery(opts idb.AccountQueryOptions, countOnly b
 	if opts.IncludeAppLocalState {
 		query += ` LEFT JOIN qls ON za.addr = qls.addr`
 	}
-	query += ` ORDER BY za.addr ASC;`
+	if opts.OrderByBalance {
+		query += ` ORDER BY za.microalgos DESC, za.addr DESC;`
+	} else {
+		query += ` ORDER BY za.addr ASC;`
+	}
 	return query, whereArgs
 }
 
@@ -2361,9 +2571,15 @@ func (db *IndexerDb) AssetBalances(ctx context.Context, abq idb.AssetBalanceQuer
 		partNumber++
 	}
 	if len(abq.PrevAddress) != 0 {
-		whereParts = append(whereParts, fmt.Sprintf("aa.addr > $%d", partNumber))
-		whereArgs = append(whereArgs, abq.PrevAddress)
-		partNumber++
+		if abq.OrderByAmount {
+			whereParts = append(whereParts, fmt.Sprintf("(aa.amount, aa.addr) < ($%d, $%d)", partNumber, partNumber+1))
+			whereArgs = append(whereArgs, abq.PrevAmount, abq.PrevAddress)
+			partNumber += 2
+		} else {
+			whereParts = append(whereParts, fmt.Sprintf("aa.addr > $%d", partNumber))
+			whereArgs = append(whereArgs, abq.PrevAddress)
+			partNumber++
+		}
 	}
 	if !abq.IncludeDeleted {
 		whereParts = append(whereParts, "NOT aa.deleted")
@@ -2372,7 +2588,11 @@ func (db *IndexerDb) AssetBalances(ctx context.Context, abq idb.AssetBalanceQuer
 	if len(whereParts) > 0 {
 		query += " WHERE " + strings.Join(whereParts, " AND ")
 	}
-	query += " ORDER BY addr, assetid ASC"
+	if abq.OrderByAmount {
+		query += " ORDER BY amount DESC, addr DESC"
+	} else {
+		query += " ORDER BY addr, assetid ASC"
+	}
 
 	if abq.Limit > 0 {
 		query += fmt.Sprintf(" LIMIT %d", abq.Limit)
@@ -2452,6 +2672,89 @@ func (db *IndexerDb) yieldAssetBalanceThread(rows pgx.Rows, out chan<- idb.Asset
 	}
 }
 
+// AssetSupply is part of idb.IndexerDB
+func (db *IndexerDb) AssetSupply(ctx context.Context, filter idb.AssetSupplyQuery) (<-chan idb.AssetSupplyRow, uint64) {
+	out := make(chan idb.AssetSupplyRow, 1)
+
+	// The reserve address is stored as base64 in the asset params, it is
+	// missing when the asset has no reserve.
+	query := `SELECT a.creator_addr, a.params,
+COALESCE(SUM(aa.amount) FILTER (WHERE aa.addr = decode(a.params ->> 'r', 'base64')), 0),
+COALESCE(SUM(aa.amount) FILTER (WHERE aa.addr = a.creator_addr), 0),
+COALESCE(SUM(aa.amount) FILTER (WHERE aa.frozen), 0),
+COALESCE(SUM(aa.amount) FILTER (
+	WHERE aa.addr = ANY($2::bytea[]) AND aa.addr IS DISTINCT FROM decode(a.params ->> 'r', 'base64')), 0)
+FROM asset a
+LEFT JOIN account_asset aa ON aa.assetid = a.index AND NOT aa.deleted
+WHERE a.index = $1 AND NOT a.deleted
+GROUP BY a.index`
+
+	tx, err := db.db.BeginTx(ctx, readonlyRepeatableRead)
+	if err != nil {
+		out <- idb.AssetSupplyRow{Error: err}
+		close(out)
+		return out, 0
+	}
+
+	round, err := db.getMaxRoundAccounted(ctx, tx)
+	if err != nil {
+		out <- idb.AssetSupplyRow{Error: err}
+		close(out)
+		if rerr := tx.Rollback(ctx); rerr != nil {
+			db.log.Printf("rollback error: %s", rerr)
+		}
+		return out, round
+	}
+
+	rows, err := tx.Query(ctx, query, filter.AssetID, filter.Exclude)
+	if err != nil {
+		out <- idb.AssetSupplyRow{Error: err}
+		close(out)
+		if rerr := tx.Rollback(ctx); rerr != nil {
+			db.log.Printf("rollback error: %s", rerr)
+		}
+		return out, round
+	}
+
+	go func() {
+		db.yieldAssetSupplyThread(filter, rows, out)
+		// Because we return a channel into a "callWithTimeout" function,
+		// We need to make sure that rollback is called before close()
+		// otherwise we can end up with a situation where "callWithTimeout"
+		// will cancel our context, resulting in connection pool churn
+		if rerr := tx.Rollback(ctx); rerr != nil {
+			db.log.Printf("rollback error: %s", rerr)
+		}
+		close(out)
+	}()
+	return out, round
+}
+
+func (db *IndexerDb) yieldAssetSupplyThread(filter idb.AssetSupplyQuery, rows pgx.Rows, out chan<- idb.AssetSupplyRow) {
+	defer rows.Close()
+
+	for rows.Next() {
+		row := idb.AssetSupplyRow{AssetID: filter.AssetID}
+		var paramsJSONStr []byte
+		err := rows.Scan(
+			&row.Creator, &paramsJSONStr, &row.ReserveAmount, &row.CreatorAmount,
+			&row.FrozenAmount, &row.ExcludedAmount)
+		if err != nil {
+			out <- idb.AssetSupplyRow{Error: err}
+			break
+		}
+		row.Params, err = encoding.DecodeAssetParams(paramsJSONStr)
+		if err != nil {
+			out <- idb.AssetSupplyRow{Error: err}
+			break
+		}
+		out <- row
+	}
+	if err := rows.Err(); err != nil {
+		out <- idb.AssetSupplyRow{Error: err}
+	}
+}
+
 // Applications is part of idb.IndexerDB
 func (db *IndexerDb) Applications(ctx context.Context, filter idb.ApplicationQuery) (<-chan idb.ApplicationRow, uint64) {
 	out := make(chan idb.ApplicationRow, 1)
@@ -2705,72 +3008,73 @@ func (db *IndexerDb) yieldApplicationBoxThread(omitValues bool, rows pgx.Rows, o
 	}
 }
 
-// AppLocalState is part of idb.IndexerDB
-func (db *IndexerDb) AppLocalState(ctx context.Context, filter idb.ApplicationQuery) (<-chan idb.AppLocalStateRow, uint64) {
-	out := make(chan idb.AppLocalStateRow, 1)
-
-	query := `SELECT app, addr, localstate, created_at, closed_at, deleted FROM account_app `
+// AppGlobalStateHistory is part of idb.IndexerDB
+func (db *IndexerDb) AppGlobalStateHistory(ctx context.Context, filter idb.AppGlobalStateHistoryQuery) (<-chan idb.AppGlobalStateHistoryRow, uint64) {
+	out := make(chan idb.AppGlobalStateHistoryRow, 1)
 
-	const maxWhereParts = 4
-	whereParts := make([]string, 0, maxWhereParts)
-	whereArgs := make([]interface{}, 0, maxWhereParts)
-	partNumber := 1
-	if filter.ApplicationID != nil {
-		whereParts = append(whereParts, fmt.Sprintf("app = $%d", partNumber))
-		whereArgs = append(whereArgs, *filter.ApplicationID)
-		partNumber++
-	}
-	if filter.Address != nil {
-		whereParts = append(whereParts, fmt.Sprintf("addr = $%d", partNumber))
-		whereArgs = append(whereArgs, filter.Address)
-		partNumber++
-	}
-	if filter.ApplicationIDGreaterThan != nil {
-		whereParts = append(whereParts, fmt.Sprintf("app > $%d", partNumber))
-		whereArgs = append(whereArgs, *filter.ApplicationIDGreaterThan)
-		partNumber++
-	}
-	if !filter.IncludeDeleted {
-		whereParts = append(whereParts, "NOT deleted")
-	}
-	if len(whereParts) > 0 {
-		whereStr := strings.Join(whereParts, " AND ")
-		query += " WHERE " + whereStr
-	}
-	query += " ORDER BY 1"
+	// The first change returned is at or after (round, intra).
+	round := filter.MinRound
+	intra := uint64(0)
+	if filter.NextToken != "" {
+		nextRound, nextIntra, err := idb.DecodeTxnRowNext(filter.NextToken)
+		if err != nil {
+			out <- idb.AppGlobalStateHistoryRow{Error: err}
+			close(out)
+			return out, 0
+		}
+		round = nextRound
+		intra = uint64(nextIntra) + 1
+	}
+
+	// The change before the first one is selected as well, to know the previous value.
+	columns := `round, intra, txid, action, bytes, uint`
+	query := fmt.Sprintf(`SELECT %[1]s FROM (
+(SELECT %[1]s FROM app_global_delta
+WHERE app = $1 AND key = $2 AND (round, intra) < ($3, $4)
+ORDER BY round DESC, intra DESC LIMIT 1)
+UNION ALL
+(SELECT %[1]s FROM app_global_delta
+WHERE app = $1 AND key = $2 AND (round, intra) >= ($3, $4)`, columns)
+	whereArgs := []interface{}{filter.ApplicationID, filter.Key, round, intra}
+	if filter.MaxRound != 0 {
+		query += " AND round <= $5"
+		whereArgs = append(whereArgs, filter.MaxRound)
+	}
+	query += " ORDER BY round, intra"
 	if filter.Limit != 0 {
 		query += fmt.Sprintf(" LIMIT %d", filter.Limit)
 	}
+	query += ")) d ORDER BY round, intra"
 
 	tx, err := db.db.BeginTx(ctx, readonlyRepeatableRead)
 	if err != nil {
-		out <- idb.AppLocalStateRow{Error: err}
+		out <- idb.AppGlobalStateHistoryRow{Error: err}
 		close(out)
 		return out, 0
 	}
 
-	round, err := db.getMaxRoundAccounted(ctx, tx)
+	maxRound, err := db.getMaxRoundAccounted(ctx, tx)
 	if err != nil {
-		out <- idb.AppLocalStateRow{Error: err}
+		out <- idb.AppGlobalStateHistoryRow{Error: err}
 		close(out)
 		if rerr := tx.Rollback(ctx); rerr != nil {
 			db.log.Printf("rollback error: %s", rerr)
 		}
-		return out, round
+		return out, maxRound
 	}
 
 	rows, err := tx.Query(ctx, query, whereArgs...)
 	if err != nil {
-		out <- idb.AppLocalStateRow{Error: err}
+		out <- idb.AppGlobalStateHistoryRow{Error: err}
 		close(out)
 		if rerr := tx.Rollback(ctx); rerr != nil {
 			db.log.Printf("rollback error: %s", rerr)
 		}
-		return out, round
+		return out, maxRound
 	}
 
 	go func() {
-		db.yieldAppLocalStateThread(rows, out)
+		db.yieldAppGlobalStateHistoryThread(rows, round, intra, out)
 		// Because we return a channel into a "callWithTimeout" function,
 		// We need to make sure that rollback is called before close()
 		// otherwise we can end up with a situation where "callWithTimeout"
@@ -2780,58 +3084,665 @@ func (db *IndexerDb) AppLocalState(ctx context.Context, filter idb.ApplicationQu
 		}
 		close(out)
 	}()
-	return out, round
+	return out, maxRound
 }
 
-func (db *IndexerDb) yieldAppLocalStateThread(rows pgx.Rows, out chan idb.AppLocalStateRow) {
+func (db *IndexerDb) yieldAppGlobalStateHistoryThread(rows pgx.Rows, startRound, startIntra uint64, out chan idb.AppGlobalStateHistoryRow) {
 	defer rows.Close()
 
+	var previous *sdk.TealValue
 	for rows.Next() {
-		var index uint64
-		var address []byte
-		var statejson []byte
-		var created *uint64
-		var closed *uint64
-		var deleted *bool
-		err := rows.Scan(&index, &address, &statejson, &created, &closed, &deleted)
+		var row idb.AppGlobalStateHistoryRow
+		var txid []byte
+		var action uint64
+		var bytesValue []byte
+		var uintValue *uint64
+		err := rows.Scan(&row.Round, &row.Intra, &txid, &action, &bytesValue, &uintValue)
 		if err != nil {
-			out <- idb.AppLocalStateRow{Error: err}
+			out <- idb.AppGlobalStateHistoryRow{Error: err}
 			break
 		}
-		var rec idb.AppLocalStateRow
-		rec.AppLocalState.Id = index
-		rec.AppLocalState.OptedInAtRound = created
-		rec.AppLocalState.ClosedOutAtRound = closed
-		rec.AppLocalState.Deleted = deleted
+		row.Txid = string(txid)
+		row.Delta.Action = sdk.DeltaAction(action)
+		row.Delta.Bytes = string(bytesValue)
+		if uintValue != nil {
+			row.Delta.Uint = *uintValue
+		}
+		row.Previous = previous
 
-		ls, err := encoding.DecodeAppLocalState(statejson)
-		if err != nil {
-			rec.Error = fmt.Errorf("app=%d json err: %w", index, err)
-			out <- rec
-			break
+		switch row.Delta.Action {
+		case sdk.SetBytesAction:
+			previous = &sdk.TealValue{Type: sdk.TealBytesType, Bytes: row.Delta.Bytes}
+		case sdk.SetUintAction:
+			previous = &sdk.TealValue{Type: sdk.TealUintType, Uint: row.Delta.Uint}
+		default:
+			previous = nil
 		}
-		rec.AppLocalState.Schema = models.ApplicationStateSchema{
-			NumByteSlice: ls.Schema.NumByteSlice,
-			NumUint:      ls.Schema.NumUint,
+
+		if row.Round < startRound || (row.Round == startRound && uint64(row.Intra) < startIntra) {
+			// the change before the requested range.
+			continue
 		}
-		rec.AppLocalState.KeyValue = tealKeyValueToModel(ls.KeyValue)
-		out <- rec
+		out <- row
 	}
 	if err := rows.Err(); err != nil {
-		out <- idb.AppLocalStateRow{Error: err}
+		out <- idb.AppGlobalStateHistoryRow{Error: err}
 	}
 }
 
-// Health is part of idb.IndexerDB
-func (db *IndexerDb) Health(ctx context.Context) (idb.Health, error) {
-	migrationRequired := false
-	migrating := false
-	blocking := false
-	errString := ""
-	var data = make(map[string]interface{})
-
-	if db.readonly {
-		data["read-only-mode"] = true
+// AppGlobalStateHistoryStart is part of idb.IndexerDB
+func (db *IndexerDb) AppGlobalStateHistoryStart(ctx context.Context) (uint64, error) {
+	startJSON, err := db.getMetastate(ctx, nil, schema.AppGlobalDeltaStartKey)
+	if err == idb.ErrorNotInitialized {
+		// The table was created with the database.
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("AppGlobalStateHistoryStart() err: %w", err)
+	}
+
+	start, err := encoding.DecodeHistoryStart([]byte(startJSON))
+	if err != nil {
+		return 0, fmt.Errorf("AppGlobalStateHistoryStart() err: %w", err)
+	}
+	return start.Round, nil
+}
+
+// initHistoryStart records the round as the first round written to an optional
+// table, unless an earlier round was recorded.
+func (db *IndexerDb) initHistoryStart(tx pgx.Tx, key string, round sdk.Round) error {
+	start := types.HistoryStart{Round: uint64(round)}
+	query := `INSERT INTO metastate (k, v) VALUES ($1, $2) ON CONFLICT (k) DO NOTHING`
+	_, err := tx.Exec(context.Background(), query, key, string(encoding.EncodeHistoryStart(&start)))
+	if err != nil {
+		return fmt.Errorf("initHistoryStart() err: %w", err)
+	}
+	return nil
+}
+
+// getHistoryStart returns the first round recorded by an optional table, or
+// idb.ErrorNotInitialized when it was never written.
+func (db *IndexerDb) getHistoryStart(ctx context.Context, key string) (uint64, error) {
+	startJSON, err := db.getMetastate(ctx, nil, key)
+	if err != nil {
+		return 0, err
+	}
+
+	start, err := encoding.DecodeHistoryStart([]byte(startJSON))
+	if err != nil {
+		return 0, err
+	}
+	return start.Round, nil
+}
+
+// AppBoxHistoryStart is part of idb.IndexerDB
+func (db *IndexerDb) AppBoxHistoryStart(ctx context.Context) (uint64, error) {
+	start, err := db.getHistoryStart(ctx, schema.BoxHistoryStartKey)
+	if err != nil {
+		return 0, fmt.Errorf("AppBoxHistoryStart() err: %w", err)
+	}
+	return start, nil
+}
+
+// FeeStatsStart is part of idb.IndexerDB
+func (db *IndexerDb) FeeStatsStart(ctx context.Context) (uint64, error) {
+	start, err := db.getHistoryStart(ctx, schema.FeeStatsStartKey)
+	if err != nil {
+		return 0, fmt.Errorf("FeeStatsStart() err: %w", err)
+	}
+	return start, nil
+}
+
+// TxnStatsStart is part of idb.IndexerDB
+func (db *IndexerDb) TxnStatsStart(ctx context.Context) (uint64, error) {
+	start, err := db.getHistoryStart(ctx, schema.TxnStatsStartKey)
+	if err != nil {
+		return 0, fmt.Errorf("TxnStatsStart() err: %w", err)
+	}
+	return start, nil
+}
+
+// AppBoxHistory is part of idb.IndexerDB
+func (db *IndexerDb) AppBoxHistory(ctx context.Context, filter idb.AppBoxHistoryQuery) (<-chan idb.AppBoxHistoryRow, uint64) {
+	out := make(chan idb.AppBoxHistoryRow, 1)
+
+	query := `SELECT round, value FROM app_box_history WHERE app = $1 AND name = $2`
+	whereArgs := []interface{}{filter.ApplicationID, filter.BoxName}
+	if filter.MinRound != 0 {
+		whereArgs = append(whereArgs, filter.MinRound)
+		query += fmt.Sprintf(" AND round >= $%d", len(whereArgs))
+	}
+	if filter.MaxRound != 0 {
+		whereArgs = append(whereArgs, filter.MaxRound)
+		query += fmt.Sprintf(" AND round <= $%d", len(whereArgs))
+	}
+	query += " ORDER BY round"
+	if filter.Limit != 0 {
+		query += fmt.Sprintf(" LIMIT %d", filter.Limit)
+	}
+
+	tx, err := db.db.BeginTx(ctx, readonlyRepeatableRead)
+	if err != nil {
+		out <- idb.AppBoxHistoryRow{Error: err}
+		close(out)
+		return out, 0
+	}
+
+	round, err := db.getMaxRoundAccounted(ctx, tx)
+	if err != nil {
+		out <- idb.AppBoxHistoryRow{Error: err}
+		close(out)
+		if rerr := tx.Rollback(ctx); rerr != nil {
+			db.log.Printf("rollback error: %s", rerr)
+		}
+		return out, round
+	}
+
+	rows, err := tx.Query(ctx, query, whereArgs...)
+	if err != nil {
+		out <- idb.AppBoxHistoryRow{Error: err}
+		close(out)
+		if rerr := tx.Rollback(ctx); rerr != nil {
+			db.log.Printf("rollback error: %s", rerr)
+		}
+		return out, round
+	}
+
+	go func() {
+		db.yieldAppBoxHistoryThread(rows, out)
+		// Because we return a channel into a "callWithTimeout" function,
+		// We need to make sure that rollback is called before close()
+		// otherwise we can end up with a situation where "callWithTimeout"
+		// will cancel our context, resulting in connection pool churn
+		if rerr := tx.Rollback(ctx); rerr != nil {
+			db.log.Printf("rollback error: %s", rerr)
+		}
+		close(out)
+	}()
+	return out, round
+}
+
+func (db *IndexerDb) yieldAppBoxHistoryThread(rows pgx.Rows, out chan idb.AppBoxHistoryRow) {
+	defer rows.Close()
+
+	for rows.Next() {
+		var row idb.AppBoxHistoryRow
+		err := rows.Scan(&row.Round, &row.Value)
+		if err != nil {
+			out <- idb.AppBoxHistoryRow{Error: err}
+			break
+		}
+		out <- row
+	}
+	if err := rows.Err(); err != nil {
+		out <- idb.AppBoxHistoryRow{Error: err}
+	}
+}
+
+// ProposerStats is part of idb.IndexerDB
+func (db *IndexerDb) ProposerStats(ctx context.Context, filter idb.ProposerStatsQuery) (<-chan idb.ProposerStatsRow, uint64) {
+	out := make(chan idb.ProposerStatsRow, 1)
+
+	tx, err := db.db.BeginTx(ctx, readonlyRepeatableRead)
+	if err != nil {
+		out <- idb.ProposerStatsRow{Error: err}
+		close(out)
+		return out, 0
+	}
+
+	round, err := db.getMaxRoundAccounted(ctx, tx)
+	if err != nil {
+		out <- idb.ProposerStatsRow{Error: err}
+		close(out)
+		if rerr := tx.Rollback(ctx); rerr != nil {
+			db.log.Printf("rollback error: %s", rerr)
+		}
+		return out, round
+	}
+
+	// The round range is bounded after the current round is known.
+	minRound, maxRound := filter.MinRound, filter.MaxRound
+	if filter.Rounds != 0 {
+		if minRound == 0 {
+			upper := maxRound
+			if upper == 0 {
+				upper = round
+			}
+			if upper >= filter.Rounds {
+				minRound = upper - filter.Rounds + 1
+			}
+		} else if maxRound == 0 {
+			maxRound = minRound + filter.Rounds - 1
+		}
+	}
+
+	// Only the blocks with a proposer are aggregated, it is recorded since
+	// block incentives were enabled.
+	query := `SELECT header ->> 'prp', COUNT(*),
+COALESCE(SUM((header ->> 'pp')::bigint), 0)::bigint,
+COALESCE(SUM((header ->> 'fc')::bigint), 0)::bigint,
+MIN(round), MAX(round)
+FROM block_header WHERE header ? 'prp'`
+	var whereArgs []interface{}
+	if filter.Proposer != nil {
+		whereArgs = append(whereArgs, filter.Proposer.String())
+		query += fmt.Sprintf(" AND header ->> 'prp' = $%d", len(whereArgs))
+	}
+	if minRound != 0 {
+		whereArgs = append(whereArgs, minRound)
+		query += fmt.Sprintf(" AND round >= $%d", len(whereArgs))
+	}
+	if maxRound != 0 {
+		whereArgs = append(whereArgs, maxRound)
+		query += fmt.Sprintf(" AND round <= $%d", len(whereArgs))
+	}
+	query += " GROUP BY 1 ORDER BY 2 DESC, 1"
+	if filter.Limit != 0 {
+		query += fmt.Sprintf(" LIMIT %d", filter.Limit)
+	}
+
+	rows, err := tx.Query(ctx, query, whereArgs...)
+	if err != nil {
+		out <- idb.ProposerStatsRow{Error: err}
+		close(out)
+		if rerr := tx.Rollback(ctx); rerr != nil {
+			db.log.Printf("rollback error: %s", rerr)
+		}
+		return out, round
+	}
+
+	go func() {
+		db.yieldProposerStatsThread(rows, out)
+		// Because we return a channel into a "callWithTimeout" function,
+		// We need to make sure that rollback is called before close()
+		// otherwise we can end up with a situation where "callWithTimeout"
+		// will cancel our context, resulting in connection pool churn
+		if rerr := tx.Rollback(ctx); rerr != nil {
+			db.log.Printf("rollback error: %s", rerr)
+		}
+		close(out)
+	}()
+	return out, round
+}
+
+func (db *IndexerDb) yieldProposerStatsThread(rows pgx.Rows, out chan idb.ProposerStatsRow) {
+	defer rows.Close()
+
+	for rows.Next() {
+		var row idb.ProposerStatsRow
+		var proposer string
+		err := rows.Scan(&proposer, &row.BlocksProposed, &row.TotalPayout, &row.FeesCollected, &row.FirstRound, &row.LastRound)
+		if err == nil {
+			row.Proposer, err = sdk.DecodeAddress(proposer)
+		}
+		if err != nil {
+			out <- idb.ProposerStatsRow{Error: err}
+			break
+		}
+		out <- row
+	}
+	if err := rows.Err(); err != nil {
+		out <- idb.ProposerStatsRow{Error: err}
+	}
+}
+
+// TxnStats is part of idb.IndexerDB
+func (db *IndexerDb) TxnStats(ctx context.Context, filter idb.TxnStatsQuery) (<-chan idb.TxnStatsRow, uint64) {
+	out := make(chan idb.TxnStatsRow, 1)
+
+	query := `SELECT bucket, min_round, max_round, txns, inner_txns, fees, senders
+FROM txn_stats WHERE interval = $1`
+	whereArgs := []interface{}{filter.Interval}
+	if filter.MinRound != 0 {
+		whereArgs = append(whereArgs, filter.MinRound)
+		query += fmt.Sprintf(" AND max_round >= $%d", len(whereArgs))
+	}
+	if filter.MaxRound != 0 {
+		whereArgs = append(whereArgs, filter.MaxRound)
+		query += fmt.Sprintf(" AND min_round <= $%d", len(whereArgs))
+	}
+	if !filter.AfterBucket.IsZero() {
+		whereArgs = append(whereArgs, filter.AfterBucket.UTC())
+		query += fmt.Sprintf(" AND bucket > $%d", len(whereArgs))
+	}
+	query += " ORDER BY bucket"
+	if filter.Limit != 0 {
+		query += fmt.Sprintf(" LIMIT %d", filter.Limit)
+	}
+
+	tx, err := db.db.BeginTx(ctx, readonlyRepeatableRead)
+	if err != nil {
+		out <- idb.TxnStatsRow{Error: err}
+		close(out)
+		return out, 0
+	}
+
+	round, err := db.getMaxRoundAccounted(ctx, tx)
+	if err != nil {
+		out <- idb.TxnStatsRow{Error: err}
+		close(out)
+		if rerr := tx.Rollback(ctx); rerr != nil {
+			db.log.Printf("rollback error: %s", rerr)
+		}
+		return out, round
+	}
+
+	rows, err := tx.Query(ctx, query, whereArgs...)
+	if err != nil {
+		out <- idb.TxnStatsRow{Error: err}
+		close(out)
+		if rerr := tx.Rollback(ctx); rerr != nil {
+			db.log.Printf("rollback error: %s", rerr)
+		}
+		return out, round
+	}
+
+	go func() {
+		db.yieldTxnStatsThread(rows, out)
+		// Because we return a channel into a "callWithTimeout" function,
+		// We need to make sure that rollback is called before close()
+		// otherwise we can end up with a situation where "callWithTimeout"
+		// will cancel our context, resulting in connection pool churn
+		if rerr := tx.Rollback(ctx); rerr != nil {
+			db.log.Printf("rollback error: %s", rerr)
+		}
+		close(out)
+	}()
+	return out, round
+}
+
+func (db *IndexerDb) yieldTxnStatsThread(rows pgx.Rows, out chan idb.TxnStatsRow) {
+	defer rows.Close()
+
+	for rows.Next() {
+		var row idb.TxnStatsRow
+		var txns []int64
+		err := rows.Scan(&row.Bucket, &row.MinRound, &row.MaxRound, &txns, &row.InnerTxns, &row.Fees, &row.Senders)
+		if err != nil {
+			out <- idb.TxnStatsRow{Error: err}
+			break
+		}
+		row.Bucket = row.Bucket.UTC()
+		row.Txns = make(map[idb.TxnTypeEnum]uint64)
+		for typeenum, count := range txns {
+			if count != 0 {
+				row.Txns[idb.TxnTypeEnum(typeenum)] = uint64(count)
+			}
+		}
+		out <- row
+	}
+	if err := rows.Err(); err != nil {
+		out <- idb.TxnStatsRow{Error: err}
+	}
+}
+
+// FeeStats is part of idb.IndexerDB
+func (db *IndexerDb) FeeStats(ctx context.Context, filter idb.FeeStatsQuery) (<-chan idb.FeeStatsRow, uint64) {
+	out := make(chan idb.FeeStatsRow, 1)
+
+	tx, err := db.db.BeginTx(ctx, readonlyRepeatableRead)
+	if err != nil {
+		out <- idb.FeeStatsRow{Error: err}
+		close(out)
+		return out, 0
+	}
+
+	round, err := db.getMaxRoundAccounted(ctx, tx)
+	if err != nil {
+		out <- idb.FeeStatsRow{Error: err}
+		close(out)
+		if rerr := tx.Rollback(ctx); rerr != nil {
+			db.log.Printf("rollback error: %s", rerr)
+		}
+		return out, round
+	}
+
+	// The previous header is joined since rounds without transactions have no
+	// fee_stats row.
+	query := `SELECT fs.round, bh.realtime, bh.header ->> 'proto', COALESCE((bh.header ->> 'tc')::bigint, 0),
+COALESCE((bh.header ->> 'fc')::bigint, 0), fs.fees, fs.counts,
+CASE WHEN pbh.round IS NOT NULL THEN COALESCE((pbh.header ->> 'tc')::bigint, 0) END, pbh.realtime
+FROM fee_stats fs JOIN block_header bh ON bh.round = fs.round
+LEFT JOIN block_header pbh ON pbh.round = fs.round - 1
+WHERE fs.round >= $1 AND fs.round <= $2`
+	minRound, maxRound := filter.RoundRange(round)
+	whereArgs := []interface{}{minRound, maxRound}
+	if filter.BeforeRound != 0 {
+		whereArgs = append(whereArgs, filter.BeforeRound)
+		query += fmt.Sprintf(" AND fs.round < $%d", len(whereArgs))
+	}
+	query += " ORDER BY fs.round DESC"
+	if filter.Limit != 0 {
+		query += fmt.Sprintf(" LIMIT %d", filter.Limit)
+	}
+
+	rows, err := tx.Query(ctx, query, whereArgs...)
+	if err != nil {
+		out <- idb.FeeStatsRow{Error: err}
+		close(out)
+		if rerr := tx.Rollback(ctx); rerr != nil {
+			db.log.Printf("rollback error: %s", rerr)
+		}
+		return out, round
+	}
+
+	go func() {
+		db.yieldFeeStatsThread(rows, out)
+		// Because we return a channel into a "callWithTimeout" function,
+		// We need to make sure that rollback is called before close()
+		// otherwise we can end up with a situation where "callWithTimeout"
+		// will cancel our context, resulting in connection pool churn
+		if rerr := tx.Rollback(ctx); rerr != nil {
+			db.log.Printf("rollback error: %s", rerr)
+		}
+		close(out)
+	}()
+	return out, round
+}
+
+func (db *IndexerDb) yieldFeeStatsThread(rows pgx.Rows, out chan idb.FeeStatsRow) {
+	defer rows.Close()
+
+	for rows.Next() {
+		var row idb.FeeStatsRow
+		var fees, counts []int64
+		err := rows.Scan(&row.Round, &row.RoundTime, &row.Protocol, &row.TxnCounter, &row.FeesCollected, &fees, &counts,
+			&row.PrevTxnCounter, &row.PrevRoundTime)
+		if err != nil {
+			out <- idb.FeeStatsRow{Error: err}
+			break
+		}
+		row.RoundTime = row.RoundTime.UTC()
+		if row.PrevRoundTime != nil {
+			prevRoundTime := row.PrevRoundTime.UTC()
+			row.PrevRoundTime = &prevRoundTime
+		}
+		row.Fees = make([]uint64, len(fees))
+		for i, fee := range fees {
+			row.Fees[i] = uint64(fee)
+		}
+		row.Counts = make([]uint64, len(counts))
+		for i, count := range counts {
+			row.Counts[i] = uint64(count)
+		}
+		out <- row
+	}
+	if err := rows.Err(); err != nil {
+		out <- idb.FeeStatsRow{Error: err}
+	}
+}
+
+// FeeCounts is part of idb.IndexerDB
+func (db *IndexerDb) FeeCounts(ctx context.Context, filter idb.FeeStatsQuery) ([]idb.FeeCountRow, error) {
+	tx, err := db.db.BeginTx(ctx, readonlyRepeatableRead)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	round, err := db.getMaxRoundAccounted(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	// The counts are grouped by consensus version, which sets the minimum fee.
+	query := `SELECT bh.header ->> 'proto', u.fee, SUM(u.count)::bigint
+FROM fee_stats fs JOIN block_header bh ON bh.round = fs.round
+CROSS JOIN LATERAL unnest(fs.fees, fs.counts) AS u(fee, count)
+WHERE fs.round >= $1 AND fs.round <= $2
+GROUP BY 1, 2`
+	minRound, maxRound := filter.RoundRange(round)
+	rows, err := tx.Query(ctx, query, minRound, maxRound)
+	if err != nil {
+		return nil, fmt.Errorf("FeeCounts() err: %w", err)
+	}
+	defer rows.Close()
+
+	var results []idb.FeeCountRow
+	for rows.Next() {
+		var row idb.FeeCountRow
+		var fee, count int64
+		err = rows.Scan(&row.Protocol, &fee, &count)
+		if err != nil {
+			return nil, fmt.Errorf("FeeCounts() err: %w", err)
+		}
+		row.Fee = uint64(fee)
+		row.Count = uint64(count)
+		results = append(results, row)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("FeeCounts() err: %w", err)
+	}
+	return results, nil
+}
+
+// AppLocalState is part of idb.IndexerDB
+func (db *IndexerDb) AppLocalState(ctx context.Context, filter idb.ApplicationQuery) (<-chan idb.AppLocalStateRow, uint64) {
+	out := make(chan idb.AppLocalStateRow, 1)
+
+	query := `SELECT app, addr, localstate, created_at, closed_at, deleted FROM account_app `
+
+	const maxWhereParts = 4
+	whereParts := make([]string, 0, maxWhereParts)
+	whereArgs := make([]interface{}, 0, maxWhereParts)
+	partNumber := 1
+	if filter.ApplicationID != nil {
+		whereParts = append(whereParts, fmt.Sprintf("app = $%d", partNumber))
+		whereArgs = append(whereArgs, *filter.ApplicationID)
+		partNumber++
+	}
+	if filter.Address != nil {
+		whereParts = append(whereParts, fmt.Sprintf("addr = $%d", partNumber))
+		whereArgs = append(whereArgs, filter.Address)
+		partNumber++
+	}
+	if filter.ApplicationIDGreaterThan != nil {
+		whereParts = append(whereParts, fmt.Sprintf("app > $%d", partNumber))
+		whereArgs = append(whereArgs, *filter.ApplicationIDGreaterThan)
+		partNumber++
+	}
+	if !filter.IncludeDeleted {
+		whereParts = append(whereParts, "NOT deleted")
+	}
+	if len(whereParts) > 0 {
+		whereStr := strings.Join(whereParts, " AND ")
+		query += " WHERE " + whereStr
+	}
+	query += " ORDER BY 1"
+	if filter.Limit != 0 {
+		query += fmt.Sprintf(" LIMIT %d", filter.Limit)
+	}
+
+	tx, err := db.db.BeginTx(ctx, readonlyRepeatableRead)
+	if err != nil {
+		out <- idb.AppLocalStateRow{Error: err}
+		close(out)
+		return out, 0
+	}
+
+	round, err := db.getMaxRoundAccounted(ctx, tx)
+	if err != nil {
+		out <- idb.AppLocalStateRow{Error: err}
+		close(out)
+		if rerr := tx.Rollback(ctx); rerr != nil {
+			db.log.Printf("rollback error: %s", rerr)
+		}
+		return out, round
+	}
+
+	rows, err := tx.Query(ctx, query, whereArgs...)
+	if err != nil {
+		out <- idb.AppLocalStateRow{Error: err}
+		close(out)
+		if rerr := tx.Rollback(ctx); rerr != nil {
+			db.log.Printf("rollback error: %s", rerr)
+		}
+		return out, round
+	}
+
+	go func() {
+		db.yieldAppLocalStateThread(rows, out)
+		// Because we return a channel into a "callWithTimeout" function,
+		// We need to make sure that rollback is called before close()
+		// otherwise we can end up with a situation where "callWithTimeout"
+		// will cancel our context, resulting in connection pool churn
+		if rerr := tx.Rollback(ctx); rerr != nil {
+			db.log.Printf("rollback error: %s", rerr)
+		}
+		close(out)
+	}()
+	return out, round
+}
+
+func (db *IndexerDb) yieldAppLocalStateThread(rows pgx.Rows, out chan idb.AppLocalStateRow) {
+	defer rows.Close()
+
+	for rows.Next() {
+		var index uint64
+		var address []byte
+		var statejson []byte
+		var created *uint64
+		var closed *uint64
+		var deleted *bool
+		err := rows.Scan(&index, &address, &statejson, &created, &closed, &deleted)
+		if err != nil {
+			out <- idb.AppLocalStateRow{Error: err}
+			break
+		}
+		var rec idb.AppLocalStateRow
+		rec.AppLocalState.Id = index
+		rec.AppLocalState.OptedInAtRound = created
+		rec.AppLocalState.ClosedOutAtRound = closed
+		rec.AppLocalState.Deleted = deleted
+
+		ls, err := encoding.DecodeAppLocalState(statejson)
+		if err != nil {
+			rec.Error = fmt.Errorf("app=%d json err: %w", index, err)
+			out <- rec
+			break
+		}
+		rec.AppLocalState.Schema = models.ApplicationStateSchema{
+			NumByteSlice: ls.Schema.NumByteSlice,
+			NumUint:      ls.Schema.NumUint,
+		}
+		rec.AppLocalState.KeyValue = tealKeyValueToModel(ls.KeyValue)
+		out <- rec
+	}
+	if err := rows.Err(); err != nil {
+		out <- idb.AppLocalStateRow{Error: err}
+	}
+}
+
+// Health is part of idb.IndexerDB
+func (db *IndexerDb) Health(ctx context.Context) (idb.Health, error) {
+	migrationRequired := false
+	migrating := false
+	blocking := false
+	errString := ""
+	var data = make(map[string]interface{})
+
+	if db.readonly {
+		data["read-only-mode"] = true
 	}
 
 	if db.migration != nil {
@@ -2876,6 +3787,60 @@ func (db *IndexerDb) Health(ctx context.Context) (idb.Health, error) {
 	}, err
 }
 
+// SubscribeRounds is part of idb.IndexerDB
+func (db *IndexerDb) SubscribeRounds(ctx context.Context) <-chan uint64 {
+	out := make(chan uint64, 1)
+	go func() {
+		defer close(out)
+		for {
+			err := db.listenRounds(ctx, out)
+			if ctx.Err() != nil {
+				return
+			}
+			db.log.WithError(err).Warn("SubscribeRounds() listen failed, retrying")
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(time.Second):
+			}
+		}
+	}()
+	return out
+}
+
+// listenRounds opens a connection listening on the round notification channel,
+// and forwards each committed round to out until an error occurs. The
+// connection is not taken from the pool, which would lose it for queries.
+func (db *IndexerDb) listenRounds(ctx context.Context, out chan<- uint64) error {
+	conn, err := pgx.ConnectConfig(ctx, db.db.Config().ConnConfig)
+	if err != nil {
+		return fmt.Errorf("listenRounds() connect err: %w", err)
+	}
+	defer conn.Close(context.Background())
+
+	_, err = conn.Exec(ctx, "LISTEN "+schema.RoundNotifyChannel)
+	if err != nil {
+		return fmt.Errorf("listenRounds() listen err: %w", err)
+	}
+
+	for {
+		notification, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return fmt.Errorf("listenRounds() wait err: %w", err)
+		}
+		round, err := strconv.ParseUint(notification.Payload, 10, 64)
+		if err != nil {
+			db.log.WithError(err).Warnf("listenRounds() bad payload '%s'", notification.Payload)
+			continue
+		}
+		select {
+		case out <- round:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
 // GetSpecialAccounts is part of idb.IndexerDB
 func (db *IndexerDb) GetSpecialAccounts(ctx context.Context) (itypes.SpecialAddresses, error) {
 	cache, err := db.getMetastate(ctx, nil, schema.SpecialAccountsMetastateKey)
@@ -2936,6 +3901,56 @@ func (db *IndexerDb) DeleteTransactions(ctx context.Context, keep uint64) error
 		}
 		db.log.Infof("%d txn_participation records deleted", participationCmd.RowsAffected())
 
+		// delete from txn_group
+		groupQuery := "DELETE FROM txn_group WHERE round < $1"
+		groupCmd, err2 := tx.Exec(ctx, groupQuery, keep)
+		if err2 != nil {
+			return fmt.Errorf("deleteTxns(): txn_group delete err %w", err2)
+		}
+		db.log.Infof("%d txn_group records deleted", groupCmd.RowsAffected())
+
+		// delete from app_global_delta, except the last change of each key
+		// which holds the value before the remaining changes.
+		deltaQuery := `DELETE FROM app_global_delta d WHERE d.round < $1 AND EXISTS (
+			SELECT 1 FROM app_global_delta n
+			WHERE n.app = d.app AND n.key = d.key AND (n.round, n.intra) > (d.round, d.intra) AND n.round < $1)`
+		deltaCmd, err2 := tx.Exec(ctx, deltaQuery, keep)
+		if err2 != nil {
+			return fmt.Errorf("deleteTxns(): app_global_delta delete err %w", err2)
+		}
+		db.log.Infof("%d app_global_delta records deleted", deltaCmd.RowsAffected())
+
+		// delete the statistics buckets which only cover deleted rounds
+		statsQuery := "DELETE FROM txn_stats WHERE max_round < $1"
+		statsCmd, err2 := tx.Exec(ctx, statsQuery, keep)
+		if err2 != nil {
+			return fmt.Errorf("deleteTxns(): txn_stats delete err %w", err2)
+		}
+		db.log.Infof("%d txn_stats records deleted", statsCmd.RowsAffected())
+		statsSenderQuery := `DELETE FROM txn_stats_sender s WHERE NOT EXISTS (
+			SELECT 1 FROM txn_stats t WHERE t.interval = s.interval AND t.bucket = s.bucket)`
+		_, err2 = tx.Exec(ctx, statsSenderQuery)
+		if err2 != nil {
+			return fmt.Errorf("deleteTxns(): txn_stats_sender delete err %w", err2)
+		}
+
+		// delete from fee_stats
+		feeStatsQuery := "DELETE FROM fee_stats WHERE round < $1"
+		feeStatsCmd, err2 := tx.Exec(ctx, feeStatsQuery, keep)
+		if err2 != nil {
+			return fmt.Errorf("deleteTxns(): fee_stats delete err %w", err2)
+		}
+		db.log.Infof("%d fee_stats records deleted", feeStatsCmd.RowsAffected())
+
+		// the statistics start at the oldest remaining round
+		startQuery := `UPDATE metastate SET v = $2 WHERE k = ANY($1) AND (v ->> 'round')::bigint < $3`
+		start := types.HistoryStart{Round: keep}
+		startKeys := []string{schema.TxnStatsStartKey, schema.FeeStatsStartKey}
+		_, err2 = tx.Exec(ctx, startQuery, startKeys, string(encoding.EncodeHistoryStart(&start)), keep)
+		if err2 != nil {
+			return fmt.Errorf("deleteTxns(): metastate update err %w", err2)
+		}
+
 		t := time.Now().UTC()
 		// update metastate
 		status := types.DeleteStatus{
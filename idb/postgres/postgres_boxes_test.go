@@ -14,6 +14,7 @@ import (
 	"github.com/algorand/indexer/v3/idb"
 	"github.com/algorand/indexer/v3/idb/postgres/internal/encoding"
 	"github.com/algorand/indexer/v3/idb/postgres/internal/writer"
+	"github.com/algorand/indexer/v3/types"
 	"github.com/algorand/indexer/v3/util/test"
 
 	"github.com/algorand/go-algorand-sdk/v2/protocol"
@@ -460,3 +461,65 @@ func TestRandomWriteReadBoxes(t *testing.T) {
 
 	fmt.Printf("TestRandomWriteReadBoxes total time: %s\n", time.Since(start))
 }
+
+// Test that box changes are written to the history table when enabled and read back oldest first.
+func TestAppBoxHistory(t *testing.T) {
+	db, shutdownFunc := setupIdb(t, test.MakeGenesis())
+	defer shutdownFunc()
+	db.boxHistory = true
+
+	_, err := db.AppBoxHistoryStart(context.Background())
+	require.ErrorIs(t, err, idb.ErrorNotInitialized)
+
+	appID := uint64(3)
+	key := apps.MakeBoxKey(appID, "box")
+	otherKey := apps.MakeBoxKey(appID, "other")
+
+	deltas := []map[string]sdk.KvValueDelta{
+		{key: {Data: []byte("one")}, otherKey: {Data: []byte("x")}},
+		{otherKey: {Data: []byte("y")}},
+		{key: {Data: []byte("two")}},
+		{key: {Data: nil}},
+	}
+	prev := test.MakeGenesisBlock().BlockHeader
+	for _, kvMods := range deltas {
+		block, err := test.MakeBlockForTxns(prev)
+		require.NoError(t, err)
+		vb := types.ValidatedBlock{
+			Block: block,
+			Delta: sdk.LedgerStateDelta{KvMods: kvMods},
+		}
+		require.NoError(t, db.AddBlock(&vb))
+		prev = block.BlockHeader
+	}
+
+	fetch := func(q idb.AppBoxHistoryQuery) []idb.AppBoxHistoryRow {
+		rowsCh, round := db.AppBoxHistory(context.Background(), q)
+		require.Equal(t, uint64(4), round)
+		var rows []idb.AppBoxHistoryRow
+		for row := range rowsCh {
+			require.NoError(t, row.Error)
+			rows = append(rows, row)
+		}
+		return rows
+	}
+
+	rows := fetch(idb.AppBoxHistoryQuery{ApplicationID: appID, BoxName: []byte("box")})
+	expected := []idb.AppBoxHistoryRow{
+		{Round: 1, Value: []byte("one")},
+		{Round: 3, Value: []byte("two")},
+		{Round: 4},
+	}
+	require.Equal(t, expected, rows)
+
+	rows = fetch(idb.AppBoxHistoryQuery{ApplicationID: appID, BoxName: []byte("box"), MinRound: 2, Limit: 1})
+	require.Equal(t, expected[1:2], rows)
+
+	rows = fetch(idb.AppBoxHistoryQuery{ApplicationID: appID, BoxName: []byte("other"), MaxRound: 1})
+	require.Equal(t, []idb.AppBoxHistoryRow{{Round: 1, Value: []byte("x")}}, rows)
+
+	// the first round imported with box history
+	start, err := db.AppBoxHistoryStart(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), start)
+}
@@ -13,6 +13,7 @@ import (
 
 	"github.com/jackc/pgx/v4"
 	"github.com/jackc/pgx/v4/pgxpool"
+	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -2276,3 +2277,659 @@ func TestDeleteTransactions(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, uint64(5), status.OldestRound)
 }
+
+// Test that a transaction group is found through the group index, or through a
+// member txid when it is not indexed.
+func TestTransactionGroup(t *testing.T) {
+	db, shutdownFunc := setupIdb(t, test.MakeGenesis())
+	defer shutdownFunc()
+
+	group := sdk.Digest{1, 2, 3}
+	txn0 := test.MakePaymentTxn(1000, 10, 0, 0, 0, 0, test.AccountA, test.AccountB, sdk.Address{}, sdk.Address{})
+	txn1 := test.MakePaymentTxn(1000, 20, 0, 0, 0, 0, test.AccountA, test.AccountC, sdk.Address{}, sdk.Address{})
+	txn1.Txn.Group = group
+	txn2 := test.MakeAppCallWithInnerTxn(test.AccountA, test.AccountB, test.AccountC, test.AccountD, test.AccountE)
+	txn2.Txn.Group = group
+
+	block, err := test.MakeBlockForTxns(test.MakeGenesisBlock().BlockHeader, &txn0, &txn1, &txn2)
+	require.NoError(t, err)
+	require.NoError(t, db.AddBlock(&types.ValidatedBlock{Block: block, Delta: sdk.LedgerStateDelta{}}))
+
+	fetch := func(q idb.TransactionGroupQuery) []idb.TxnRow {
+		rowsCh, round := db.TransactionGroup(context.Background(), q)
+		require.Equal(t, uint64(1), round)
+		var rows []idb.TxnRow
+		for row := range rowsCh {
+			require.NoError(t, row.Error)
+			rows = append(rows, row)
+		}
+		return rows
+	}
+	checkGroup := func(rows []idb.TxnRow) {
+		require.Len(t, rows, 2)
+		assert.Equal(t, 1, rows[0].Intra)
+		assert.Equal(t, sdk.MicroAlgos(20), rows[0].Txn.Txn.Amount)
+		// The inner transactions are part of the root transaction.
+		assert.Equal(t, 2, rows[1].Intra)
+		assert.Len(t, rows[1].Txn.ApplyData.EvalDelta.InnerTxns, 2)
+	}
+
+	checkGroup(fetch(idb.TransactionGroupQuery{GroupID: group[:]}))
+	assert.Empty(t, fetch(idb.TransactionGroupQuery{GroupID: []byte("missing group id with 32 bytes..")}))
+
+	// Groups imported before the group index need a txid.
+	_, err = db.db.Exec(context.Background(), "DELETE FROM txn_group")
+	require.NoError(t, err)
+	assert.Empty(t, fetch(idb.TransactionGroupQuery{GroupID: group[:]}))
+	checkGroup(fetch(idb.TransactionGroupQuery{GroupID: group[:], Txid: crypto2.TransactionIDString(txn2.Txn)}))
+}
+
+// Test the filters on the shape of application calls.
+func TestTransactionsApplicationCallFilters(t *testing.T) {
+	db, shutdownFunc := setupIdb(t, test.MakeGenesis())
+	defer shutdownFunc()
+
+	txn0 := test.MakeSimpleAppCallTxn(1, test.AccountA)
+	txn0.Txn.ApplicationArgs = [][]byte{{1, 2, 3, 4}, {5}}
+	txn0.Txn.ForeignApps = []sdk.AppIndex{7}
+	txn1 := test.MakeSimpleAppCallTxn(1, test.AccountB)
+	txn1.Txn.ApplicationArgs = [][]byte{{1, 2, 3, 5}}
+	txn1.Txn.ForeignAssets = []sdk.AssetIndex{8}
+	txn1.Txn.BoxReferences = []sdk.BoxReference{{Name: []byte("box")}}
+	txn2 := test.MakeAppOptInTxn(1, test.AccountC)
+	// Payments have no OnCompletion, they must not match the noop filter.
+	txn3 := test.MakePaymentTxn(1000, 10, 0, 0, 0, 0, test.AccountD, test.AccountA, sdk.Address{}, sdk.Address{})
+
+	block, err := test.MakeBlockForTxns(test.MakeGenesisBlock().BlockHeader, &txn0, &txn1, &txn2, &txn3)
+	require.NoError(t, err)
+	require.NoError(t, db.AddBlock(&types.ValidatedBlock{Block: block, Delta: sdk.LedgerStateDelta{}}))
+
+	noop := sdk.NoOpOC
+	optIn := sdk.OptInOC
+	testcases := []struct {
+		name    string
+		filter  idb.TransactionFilter
+		senders []sdk.Address
+	}{
+		{"method selector", idb.TransactionFilter{MethodSelector: []byte{1, 2, 3, 4}}, []sdk.Address{test.AccountA}},
+		{"noop", idb.TransactionFilter{OnCompletion: &noop}, []sdk.Address{test.AccountA, test.AccountB}},
+		{"optin", idb.TransactionFilter{OnCompletion: &optIn}, []sdk.Address{test.AccountC}},
+		{"foreign app", idb.TransactionFilter{ForeignAppID: uint64Ptr(7)}, []sdk.Address{test.AccountA}},
+		{"foreign asset", idb.TransactionFilter{ForeignAssetID: uint64Ptr(8)}, []sdk.Address{test.AccountB}},
+		{"box reference", idb.TransactionFilter{BoxReference: []byte("box")}, []sdk.Address{test.AccountB}},
+		{"no match", idb.TransactionFilter{BoxReference: []byte("other")}, nil},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			rowsCh, _ := db.Transactions(context.Background(), tc.filter)
+			var senders []sdk.Address
+			for row := range rowsCh {
+				require.NoError(t, row.Error)
+				senders = append(senders, row.Txn.Txn.Sender)
+			}
+			assert.Equal(t, tc.senders, senders)
+		})
+	}
+}
+
+func TestTransactionsAddressRoleReferences(t *testing.T) {
+	db, shutdownFunc := setupIdb(t, test.MakeGenesis())
+	defer shutdownFunc()
+
+	// Inner payment to B and inner asset transfer to C.
+	txn0 := test.MakeAppCallWithInnerTxn(test.AccountA, test.AccountA, test.AccountB, test.AccountA, test.AccountC)
+	txn1 := test.MakeSimpleAppCallTxn(1, test.AccountD)
+	txn1.Txn.Accounts = []sdk.Address{test.AccountB}
+	txn2 := test.MakeHeartbeatTxn(test.AccountA, test.AccountE)
+
+	block, err := test.MakeBlockForTxns(test.MakeGenesisBlock().BlockHeader, &txn0, &txn1, &txn2)
+	require.NoError(t, err)
+	require.NoError(t, db.AddBlock(&types.ValidatedBlock{Block: block, Delta: sdk.LedgerStateDelta{}}))
+
+	// Intra offsets: txn0 is 0 and its inner transactions are 1 to 4, txn1 is 5 and txn2 is 6.
+	testcases := []struct {
+		name    string
+		address sdk.Address
+		role    idb.AddressRole
+		intras  []int
+	}{
+		{"application account", test.AccountB, idb.AddressRoleAppAccount, []int{5}},
+		{"application account sender", test.AccountD, idb.AddressRoleAppAccount, nil},
+		{"inner payment receiver", test.AccountB, idb.AddressRoleInnerReceiver, []int{1}},
+		{"inner asset receiver", test.AccountC, idb.AddressRoleInnerReceiver, []int{3}},
+		{"heartbeat", test.AccountE, idb.AddressRoleHeartbeat, []int{6}},
+		{"heartbeat sender", test.AccountA, idb.AddressRoleHeartbeat, nil},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			filter := idb.TransactionFilter{Address: tc.address[:], AddressRole: tc.role}
+			rowsCh, _ := db.Transactions(context.Background(), filter)
+			var intras []int
+			for row := range rowsCh {
+				require.NoError(t, row.Error)
+				intras = append(intras, row.Intra)
+			}
+			assert.Equal(t, tc.intras, intras)
+		})
+	}
+}
+
+func TestTransactionsRequireAuthAddrChange(t *testing.T) {
+	db, shutdownFunc := setupIdb(t, test.MakeGenesis())
+	defer shutdownFunc()
+
+	rekey := test.MakePaymentTxn(1000, 10, 0, 0, 0, 0, test.AccountA, test.AccountB, sdk.Address{}, test.AccountC)
+	payment := test.MakePaymentTxn(1000, 10, 0, 0, 0, 0, test.AccountA, test.AccountB, sdk.Address{}, sdk.Address{})
+	closeOut := test.MakePaymentTxn(1000, 10, 0, 0, 0, 0, test.AccountA, test.AccountB, test.AccountD, sdk.Address{})
+
+	block, err := test.MakeBlockForTxns(test.MakeGenesisBlock().BlockHeader, &rekey, &payment, &closeOut)
+	require.NoError(t, err)
+	require.NoError(t, db.AddBlock(&types.ValidatedBlock{Block: block, Delta: sdk.LedgerStateDelta{}}))
+
+	filter := idb.TransactionFilter{Address: test.AccountA[:], AddressRole: idb.AddressRoleSender, RequireAuthAddrChange: true}
+	rowsCh, _ := db.Transactions(context.Background(), filter)
+	var intras []int
+	for row := range rowsCh {
+		require.NoError(t, row.Error)
+		intras = append(intras, row.Intra)
+	}
+	// newest first
+	assert.Equal(t, []int{2, 0}, intras)
+}
+
+func TestProposerStats(t *testing.T) {
+	db, shutdownFunc := setupIdb(t, test.MakeGenesis())
+	defer shutdownFunc()
+
+	prev := test.MakeGenesisBlock().BlockHeader
+	for _, proposal := range []struct {
+		proposer sdk.Address
+		payout   uint64
+		fees     uint64
+	}{
+		{test.AccountA, 1000, 500},
+		{test.AccountB, 2000, 0},
+		{test.AccountA, 3000, 100},
+	} {
+		block, err := test.MakeBlockForTxns(prev)
+		require.NoError(t, err)
+		block.Proposer = proposal.proposer
+		block.ProposerPayout = sdk.MicroAlgos(proposal.payout)
+		block.FeesCollected = sdk.MicroAlgos(proposal.fees)
+		require.NoError(t, db.AddBlock(&types.ValidatedBlock{Block: block, Delta: sdk.LedgerStateDelta{}}))
+		prev = block.BlockHeader
+	}
+
+	fetch := func(filter idb.ProposerStatsQuery) []idb.ProposerStatsRow {
+		rowsCh, round := db.ProposerStats(context.Background(), filter)
+		assert.Equal(t, uint64(3), round)
+		var rows []idb.ProposerStatsRow
+		for row := range rowsCh {
+			require.NoError(t, row.Error)
+			rows = append(rows, row)
+		}
+		return rows
+	}
+
+	// most blocks first
+	rows := fetch(idb.ProposerStatsQuery{})
+	require.Len(t, rows, 2)
+	assert.Equal(t, idb.ProposerStatsRow{Proposer: test.AccountA, BlocksProposed: 2, TotalPayout: 4000, FeesCollected: 600, FirstRound: 1, LastRound: 3}, rows[0])
+	assert.Equal(t, idb.ProposerStatsRow{Proposer: test.AccountB, BlocksProposed: 1, TotalPayout: 2000, FirstRound: 2, LastRound: 2}, rows[1])
+
+	rows = fetch(idb.ProposerStatsQuery{Limit: 1})
+	require.Len(t, rows, 1)
+	assert.Equal(t, test.AccountA, rows[0].Proposer)
+
+	proposer := test.AccountA
+	rows = fetch(idb.ProposerStatsQuery{Proposer: &proposer, MinRound: 2})
+	require.Len(t, rows, 1)
+	assert.Equal(t, idb.ProposerStatsRow{Proposer: test.AccountA, BlocksProposed: 1, TotalPayout: 3000, FeesCollected: 100, FirstRound: 3, LastRound: 3}, rows[0])
+
+	proposer = test.AccountC
+	assert.Empty(t, fetch(idb.ProposerStatsQuery{Proposer: &proposer}))
+
+	// the latest rounds
+	rows = fetch(idb.ProposerStatsQuery{Rounds: 2})
+	require.Len(t, rows, 2)
+	assert.Equal(t, idb.ProposerStatsRow{Proposer: test.AccountA, BlocksProposed: 1, TotalPayout: 3000, FeesCollected: 100, FirstRound: 3, LastRound: 3}, rows[0])
+	assert.Equal(t, idb.ProposerStatsRow{Proposer: test.AccountB, BlocksProposed: 1, TotalPayout: 2000, FirstRound: 2, LastRound: 2}, rows[1])
+
+	rows = fetch(idb.ProposerStatsQuery{MaxRound: 2, Rounds: 1})
+	require.Len(t, rows, 1)
+	assert.Equal(t, test.AccountB, rows[0].Proposer)
+
+	// the rounds from MinRound
+	rows = fetch(idb.ProposerStatsQuery{MinRound: 1, Rounds: 1})
+	require.Len(t, rows, 1)
+	assert.Equal(t, idb.ProposerStatsRow{Proposer: test.AccountA, BlocksProposed: 1, TotalPayout: 1000, FeesCollected: 500, FirstRound: 1, LastRound: 1}, rows[0])
+}
+
+func TestTxnStats(t *testing.T) {
+	db, shutdownFunc := setupIdb(t, test.MakeGenesis())
+	defer shutdownFunc()
+	db.txnStats = true
+
+	_, err := db.TxnStatsStart(context.Background())
+	assert.ErrorIs(t, err, idb.ErrorNotInitialized)
+
+	start := time.Date(2024, 6, 1, 23, 0, 0, 0, time.UTC)
+	prev := test.MakeGenesisBlock().BlockHeader
+	for _, realtime := range []time.Time{start, start.Add(30 * time.Minute), start.Add(90 * time.Minute)} {
+		pay := test.MakePaymentTxn(1000, 10, 0, 0, 0, 0, test.AccountA, test.AccountB, sdk.ZeroAddress, sdk.ZeroAddress)
+		block, err := test.MakeBlockForTxns(prev, &pay)
+		require.NoError(t, err)
+		block.TimeStamp = realtime.Unix()
+		require.NoError(t, db.AddBlock(&types.ValidatedBlock{Block: block, Delta: sdk.LedgerStateDelta{}}))
+		prev = block.BlockHeader
+	}
+
+	fetch := func(filter idb.TxnStatsQuery) []idb.TxnStatsRow {
+		rowsCh, round := db.TxnStats(context.Background(), filter)
+		assert.Equal(t, uint64(3), round)
+		var rows []idb.TxnStatsRow
+		for row := range rowsCh {
+			require.NoError(t, row.Error)
+			rows = append(rows, row)
+		}
+		return rows
+	}
+
+	// oldest bucket first, the last block is on the next day
+	rows := fetch(idb.TxnStatsQuery{Interval: idb.TxnStatsDay})
+	require.Len(t, rows, 2)
+	expected := idb.TxnStatsRow{
+		Bucket:   time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC),
+		MinRound: 1,
+		MaxRound: 2,
+		Txns:     map[idb.TxnTypeEnum]uint64{idb.TypeEnumPay: 2},
+		Fees:     2000,
+		Senders:  1,
+	}
+	assert.Equal(t, expected, rows[0])
+	assert.Equal(t, start.Add(time.Hour), rows[1].Bucket)
+
+	rows = fetch(idb.TxnStatsQuery{Interval: idb.TxnStatsHour, MinRound: 2, MaxRound: 2})
+	require.Len(t, rows, 1)
+	assert.Equal(t, start, rows[0].Bucket)
+
+	rows = fetch(idb.TxnStatsQuery{Interval: idb.TxnStatsHour, AfterBucket: start, Limit: 1})
+	require.Len(t, rows, 1)
+	assert.Equal(t, uint64(3), rows[0].MinRound)
+
+	statsStart, err := db.TxnStatsStart(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), statsStart)
+
+	// Pruning deletes the buckets which only cover deleted rounds.
+	require.NoError(t, db.DeleteTransactions(context.Background(), 3))
+	rows = fetch(idb.TxnStatsQuery{Interval: idb.TxnStatsDay})
+	require.Len(t, rows, 1)
+	assert.Equal(t, uint64(3), rows[0].MinRound)
+
+	statsStart, err = db.TxnStatsStart(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), statsStart)
+}
+
+func TestAssetSupply(t *testing.T) {
+	db, shutdownFunc := setupIdb(t, test.MakeGenesis())
+	defer shutdownFunc()
+
+	const assetID = 5
+	var delta sdk.LedgerStateDelta
+	delta.Accts.AssetResources = append(delta.Accts.AssetResources, sdk.AssetResourceRecord{
+		Aidx:   assetID,
+		Addr:   test.AccountA,
+		Params: sdk.AssetParamsDelta{Params: &sdk.AssetParams{Total: 1000, Reserve: test.AccountB}},
+	})
+	for _, holding := range []struct {
+		addr   sdk.Address
+		amount uint64
+		frozen bool
+	}{
+		{test.AccountA, 100, false},
+		{test.AccountB, 600, false},
+		{test.AccountC, 200, true},
+		{test.AccountD, 100, false},
+	} {
+		delta.Accts.AssetResources = append(delta.Accts.AssetResources, sdk.AssetResourceRecord{
+			Aidx:    assetID,
+			Addr:    holding.addr,
+			Holding: sdk.AssetHoldingDelta{Holding: &sdk.AssetHolding{Amount: holding.amount, Frozen: holding.frozen}},
+		})
+	}
+	block, err := test.MakeBlockForTxns(test.MakeGenesisBlock().BlockHeader)
+	require.NoError(t, err)
+	require.NoError(t, db.AddBlock(&types.ValidatedBlock{Block: block, Delta: delta}))
+
+	fetch := func(filter idb.AssetSupplyQuery) []idb.AssetSupplyRow {
+		rowsCh, round := db.AssetSupply(context.Background(), filter)
+		assert.Equal(t, uint64(1), round)
+		var rows []idb.AssetSupplyRow
+		for row := range rowsCh {
+			require.NoError(t, row.Error)
+			rows = append(rows, row)
+		}
+		return rows
+	}
+
+	// The reserve is not counted again when it is excluded.
+	rows := fetch(idb.AssetSupplyQuery{AssetID: assetID, Exclude: [][]byte{test.AccountB[:], test.AccountD[:]}})
+	require.Len(t, rows, 1)
+	assert.Equal(t, test.AccountA[:], rows[0].Creator)
+	assert.Equal(t, uint64(1000), rows[0].Params.Total)
+	assert.Equal(t, uint64(600), rows[0].ReserveAmount)
+	assert.Equal(t, uint64(100), rows[0].CreatorAmount)
+	assert.Equal(t, uint64(200), rows[0].FrozenAmount)
+	assert.Equal(t, uint64(100), rows[0].ExcludedAmount)
+
+	rows = fetch(idb.AssetSupplyQuery{AssetID: assetID})
+	require.Len(t, rows, 1)
+	assert.Equal(t, uint64(0), rows[0].ExcludedAmount)
+
+	assert.Empty(t, fetch(idb.AssetSupplyQuery{AssetID: assetID + 1}))
+}
+
+func TestAssetBalancesOrderByAmount(t *testing.T) {
+	db, shutdownFunc := setupIdb(t, test.MakeGenesis())
+	defer shutdownFunc()
+
+	const assetID = 5
+	var delta sdk.LedgerStateDelta
+	for _, holding := range []struct {
+		addr   sdk.Address
+		amount uint64
+	}{
+		{test.AccountA, 100},
+		{test.AccountB, 300},
+		{test.AccountC, 100},
+		{test.AccountD, 200},
+	} {
+		delta.Accts.AssetResources = append(delta.Accts.AssetResources, sdk.AssetResourceRecord{
+			Aidx:    assetID,
+			Addr:    holding.addr,
+			Holding: sdk.AssetHoldingDelta{Holding: &sdk.AssetHolding{Amount: holding.amount}},
+		})
+	}
+	block, err := test.MakeBlockForTxns(test.MakeGenesisBlock().BlockHeader)
+	require.NoError(t, err)
+	require.NoError(t, db.AddBlock(&types.ValidatedBlock{Block: block, Delta: delta}))
+
+	// Equal amounts are ordered by descending address.
+	tail := []sdk.Address{test.AccountA, test.AccountC}
+	if bytes.Compare(test.AccountA[:], test.AccountC[:]) > 0 {
+		tail = []sdk.Address{test.AccountC, test.AccountA}
+	}
+	all := append([]sdk.Address{test.AccountB, test.AccountD}, tail[1], tail[0])
+
+	var results []sdk.Address
+	query := idb.AssetBalanceQuery{AssetID: uint64Ptr(assetID), OrderByAmount: true, Limit: 3}
+	for page := 0; page < 2; page++ {
+		rowsCh, _ := db.AssetBalances(context.Background(), query)
+		for row := range rowsCh {
+			require.NoError(t, row.Error)
+			var addr sdk.Address
+			copy(addr[:], row.Address)
+			results = append(results, addr)
+			query.PrevAmount = row.Amount
+			query.PrevAddress = row.Address
+		}
+	}
+	assert.Equal(t, all, results)
+}
+
+func TestFeeStats(t *testing.T) {
+	db, shutdownFunc := setupIdb(t, test.MakeGenesis())
+	defer shutdownFunc()
+	db.feeStats = true
+
+	_, err := db.FeeStatsStart(context.Background())
+	assert.ErrorIs(t, err, idb.ErrorNotInitialized)
+
+	prev := test.MakeGenesisBlock().BlockHeader
+	for _, fee := range []uint64{1000, 3000, 2000} {
+		pay := test.MakePaymentTxn(fee, 10, 0, 0, 0, 0, test.AccountA, test.AccountB, sdk.ZeroAddress, sdk.ZeroAddress)
+		block, err := test.MakeBlockForTxns(prev, &pay)
+		require.NoError(t, err)
+		block.FeesCollected = sdk.MicroAlgos(fee)
+		require.NoError(t, db.AddBlock(&types.ValidatedBlock{Block: block, Delta: sdk.LedgerStateDelta{}}))
+		prev = block.BlockHeader
+	}
+
+	fetch := func(filter idb.FeeStatsQuery) []idb.FeeStatsRow {
+		rowsCh, round := db.FeeStats(context.Background(), filter)
+		assert.Equal(t, uint64(3), round)
+		var rows []idb.FeeStatsRow
+		for row := range rowsCh {
+			require.NoError(t, row.Error)
+			rows = append(rows, row)
+		}
+		return rows
+	}
+	rounds := func(rows []idb.FeeStatsRow) []uint64 {
+		var result []uint64
+		for _, row := range rows {
+			result = append(result, row.Round)
+		}
+		return result
+	}
+
+	rows := fetch(idb.FeeStatsQuery{MinRound: 2, MaxRound: 2})
+	require.Len(t, rows, 1)
+	assert.Equal(t, uint64(2), rows[0].Round)
+	assert.Equal(t, prev.CurrentProtocol, rows[0].Protocol)
+	assert.Equal(t, []uint64{3000}, rows[0].Fees)
+	assert.Equal(t, []uint64{1}, rows[0].Counts)
+	assert.Equal(t, uint64(2), rows[0].TxnCounter)
+	assert.Equal(t, uint64(3000), rows[0].FeesCollected)
+	require.NotNil(t, rows[0].PrevTxnCounter)
+	assert.Equal(t, uint64(1), *rows[0].PrevTxnCounter)
+
+	// newest first, the next page resumes before the last round
+	assert.Equal(t, []uint64{3, 2}, rounds(fetch(idb.FeeStatsQuery{Limit: 2})))
+	assert.Equal(t, []uint64{1}, rounds(fetch(idb.FeeStatsQuery{BeforeRound: 2, Limit: 2})))
+
+	// the latest rounds, or the rounds from MinRound
+	assert.Equal(t, []uint64{3, 2}, rounds(fetch(idb.FeeStatsQuery{Rounds: 2})))
+	assert.Equal(t, []uint64{2, 1}, rounds(fetch(idb.FeeStatsQuery{MinRound: 1, Rounds: 2})))
+
+	// the counts cover the whole range
+	counts, err := db.FeeCounts(context.Background(), idb.FeeStatsQuery{Rounds: 2, Limit: 1})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []idb.FeeCountRow{
+		{Protocol: prev.CurrentProtocol, Fee: 3000, Count: 1},
+		{Protocol: prev.CurrentProtocol, Fee: 2000, Count: 1},
+	}, counts)
+
+	statsStart, err := db.FeeStatsStart(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), statsStart)
+
+	require.NoError(t, db.DeleteTransactions(context.Background(), 3))
+	assert.Equal(t, []uint64{3}, rounds(fetch(idb.FeeStatsQuery{})))
+
+	statsStart, err = db.FeeStatsStart(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), statsStart)
+}
+
+func TestTransactionsOrder(t *testing.T) {
+	db, shutdownFunc := setupIdb(t, test.MakeGenesis())
+	defer shutdownFunc()
+
+	prev := test.MakeGenesisBlock().BlockHeader
+	for i := uint64(0); i < 3; i++ {
+		pay1 := test.MakePaymentTxn(1000, 10+2*i, 0, 0, 0, 0, test.AccountA, test.AccountB, sdk.ZeroAddress, sdk.ZeroAddress)
+		pay2 := test.MakePaymentTxn(1000, 11+2*i, 0, 0, 0, 0, test.AccountA, test.AccountB, sdk.ZeroAddress, sdk.ZeroAddress)
+		block, err := test.MakeBlockForTxns(prev, &pay1, &pay2)
+		require.NoError(t, err)
+		require.NoError(t, db.AddBlock(&types.ValidatedBlock{Block: block, Delta: sdk.LedgerStateDelta{}}))
+		prev = block.BlockHeader
+	}
+
+	type position struct {
+		round uint64
+		intra int
+	}
+	// paginate returns the positions of all the transactions, two at a time.
+	paginate := func(filter idb.TransactionFilter) []position {
+		var positions []position
+		filter.Limit = 2
+		for {
+			rowsCh, _ := db.Transactions(context.Background(), filter)
+			var last *idb.TxnRow
+			for row := range rowsCh {
+				require.NoError(t, row.Error)
+				positions = append(positions, position{row.Round, row.Intra})
+				last = &row
+			}
+			if last == nil {
+				return positions
+			}
+			next, err := last.Next(!filter.Descending())
+			require.NoError(t, err)
+			filter.NextToken = next
+		}
+	}
+
+	ascending := []position{{1, 0}, {1, 1}, {2, 0}, {2, 1}, {3, 0}, {3, 1}}
+	descending := []position{{3, 1}, {3, 0}, {2, 1}, {2, 0}, {1, 1}, {1, 0}}
+
+	assert.Equal(t, ascending, paginate(idb.TransactionFilter{}))
+	assert.Equal(t, descending, paginate(idb.TransactionFilter{Order: idb.SortDescending}))
+	assert.Equal(t, descending, paginate(idb.TransactionFilter{Address: test.AccountA[:]}))
+	assert.Equal(t, ascending, paginate(idb.TransactionFilter{Address: test.AccountA[:], Order: idb.SortAscending}))
+
+	// Block headers.
+	rowsCh, _ := db.BlockHeaders(context.Background(), idb.BlockHeaderFilter{Order: idb.SortDescending, Limit: 2})
+	var rounds []uint64
+	for row := range rowsCh {
+		require.NoError(t, row.Error)
+		rounds = append(rounds, uint64(row.BlockHeader.Round))
+	}
+	assert.Equal(t, []uint64{3, 2}, rounds)
+}
+
+func TestRoundsAtTime(t *testing.T) {
+	db, shutdownFunc := setupIdb(t, test.MakeGenesis())
+	defer shutdownFunc()
+
+	// Rounds 2 and 3 have the same timestamp.
+	start := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	realtimes := []time.Time{start, start.Add(10 * time.Second), start.Add(10 * time.Second), start.Add(20 * time.Second)}
+	prev := test.MakeGenesisBlock().BlockHeader
+	for _, realtime := range realtimes {
+		block, err := test.MakeBlockForTxns(prev)
+		require.NoError(t, err)
+		block.TimeStamp = realtime.Unix()
+		require.NoError(t, db.AddBlock(&types.ValidatedBlock{Block: block, Delta: sdk.LedgerStateDelta{}}))
+		prev = block.BlockHeader
+	}
+
+	testcases := []struct {
+		name   string
+		at     time.Time
+		before *idb.RoundTime
+		after  *idb.RoundTime
+	}{
+		{
+			name:   "between rounds",
+			at:     start.Add(5 * time.Second),
+			before: &idb.RoundTime{Round: 1, RoundTime: realtimes[0]},
+			after:  &idb.RoundTime{Round: 2, RoundTime: realtimes[1]},
+		},
+		{
+			name:   "at a round",
+			at:     start,
+			before: &idb.RoundTime{Round: 1, RoundTime: realtimes[0]},
+			after:  &idb.RoundTime{Round: 2, RoundTime: realtimes[1]},
+		},
+		{
+			name:   "at rounds with the same time",
+			at:     start.Add(10*time.Second + 500*time.Millisecond),
+			before: &idb.RoundTime{Round: 3, RoundTime: realtimes[2]},
+			after:  &idb.RoundTime{Round: 4, RoundTime: realtimes[3]},
+		},
+		{
+			name:   "after the latest round",
+			at:     start.Add(time.Hour),
+			before: &idb.RoundTime{Round: 4, RoundTime: realtimes[3]},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			rounds, round, err := db.RoundsAtTime(context.Background(), tc.at)
+			require.NoError(t, err)
+			assert.Equal(t, uint64(4), round)
+			assert.Equal(t, tc.before, rounds.Before)
+			assert.Equal(t, tc.after, rounds.After)
+		})
+	}
+}
+
+func TestDeleteTransactionsAppGlobalDelta(t *testing.T) {
+	db, shutdownFunc := setupIdb(t, test.MakeGenesis())
+	defer shutdownFunc()
+
+	// key "a" changes at rounds 2, 4 and 12, key "b" at round 3.
+	for _, change := range []struct {
+		key   string
+		round uint64
+	}{{"a", 2}, {"a", 4}, {"a", 12}, {"b", 3}} {
+		_, err := db.db.Exec(context.Background(),
+			`INSERT INTO app_global_delta (app, key, round, intra, txid, action, uint) VALUES (1, $1, $2, 0, 'txid', 2, $2)`,
+			[]byte(change.key), change.round)
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, db.DeleteTransactions(context.Background(), 10))
+
+	// The last change before round 10 is kept for the previous value.
+	rows, err := db.db.Query(context.Background(), `SELECT key, round FROM app_global_delta ORDER BY key, round`)
+	require.NoError(t, err)
+	defer rows.Close()
+	var remaining []string
+	for rows.Next() {
+		var key []byte
+		var round uint64
+		require.NoError(t, rows.Scan(&key, &round))
+		remaining = append(remaining, fmt.Sprintf("%s:%d", key, round))
+	}
+	require.NoError(t, rows.Err())
+	assert.Equal(t, []string{"a:4", "a:12", "b:3"}, remaining)
+}
+
+// The round listener must not hold a connection of the pool.
+func TestSubscribeRoundsDedicatedConnection(t *testing.T) {
+	_, connStr, shutdownFunc := pgtest.SetupPostgresWithSchema(t)
+	defer shutdownFunc()
+
+	config, err := pgxpool.ParseConfig(connStr)
+	require.NoError(t, err)
+	config.MaxConns = 1
+	pool, err := pgxpool.ConnectConfig(context.Background(), config)
+	require.NoError(t, err)
+	db := IndexerDb{db: pool, log: log.New()}
+	defer db.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	rounds := db.SubscribeRounds(ctx)
+
+	// Notifications sent before the listener is ready are lost, so repeat
+	// them until one is received. Each one needs the only pooled connection.
+	ticker := time.NewTicker(100 * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		_, err := pool.Exec(ctx, "SELECT pg_notify($1, '5')", schema.RoundNotifyChannel)
+		require.NoError(t, err)
+		select {
+		case round := <-rounds:
+			assert.Equal(t, uint64(5), round)
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -53,6 +53,24 @@ func init() {
 
 		// Migration for app box support
 		{createAppBoxTable, true, "add new table app_box for application boxes"},
+
+		// Migration for application global state history
+		{createAppGlobalDeltaTable, true, "add new table app_global_delta for application global state history"},
+
+		// Migration for application box history
+		{createAppBoxHistoryTable, true, "add new table app_box_history for application box history"},
+
+		// Migration for transaction group lookup
+		{createTxnGroupTable, true, "add new table txn_group for transaction group lookup"},
+
+		// Migration for transaction statistics
+		{createTxnStatsTables, true, "add new tables txn_stats and txn_stats_sender for transaction statistics"},
+
+		// Migration for fee statistics
+		{createFeeStatsTable, true, "add new table fee_stats for fee statistics"},
+
+		// Migration for transaction group lookup
+		{backfillTxnGroups, false, "backfill table txn_group from the existing transactions"},
 	}
 }
 
@@ -264,3 +282,142 @@ func createAppBoxTable(db *IndexerDb, migrationState *types.MigrationState, opts
 			PRIMARY KEY (app, name)
 		)`})
 }
+
+// History is only recorded for the rounds added after this migration, the first
+// of them is stored in the metastate so that the API can report it.
+func createAppGlobalDeltaTable(db *IndexerDb, migrationState *types.MigrationState, opts *idb.IndexerDbOptions) error {
+	db.accountingLock.Lock()
+	defer db.accountingLock.Unlock()
+
+	newMigrationState := *migrationState
+	newMigrationState.NextMigration++
+
+	f := func(tx pgx.Tx) error {
+		_, err := tx.Exec(context.Background(), `CREATE TABLE IF NOT EXISTS app_global_delta (
+			app bigint NOT NULL,
+			key bytea NOT NULL,
+			round bigint NOT NULL,
+			intra integer NOT NULL, -- intra round offset of the (possibly inner) transaction which made the change
+			txid bytea NOT NULL, -- base32 of [32]byte hash of the root transaction
+			action smallint NOT NULL, -- 1 set bytes, 2 set uint, 3 delete
+			bytes bytea, -- new value when action is 1
+			uint numeric(20), -- new value when action is 2
+			PRIMARY KEY (app, key, round, intra)
+		)`)
+		if err != nil {
+			return fmt.Errorf("createAppGlobalDeltaTable() create err: %w", err)
+		}
+
+		round, err := db.getNextRoundToAccount(context.Background(), tx)
+		if err != nil && err != idb.ErrorNotInitialized {
+			return fmt.Errorf("createAppGlobalDeltaTable() err: %w", err)
+		}
+		start := types.HistoryStart{Round: round}
+		err = db.setMetastate(tx, schema.AppGlobalDeltaStartKey, string(encoding.EncodeHistoryStart(&start)))
+		if err != nil {
+			return fmt.Errorf("createAppGlobalDeltaTable() err: %w", err)
+		}
+
+		err = db.setMigrationState(tx, &newMigrationState)
+		if err != nil {
+			return fmt.Errorf("createAppGlobalDeltaTable() err: %w", err)
+		}
+		return nil
+	}
+	err := db.txWithRetry(serializable, f)
+	if err != nil {
+		return fmt.Errorf("createAppGlobalDeltaTable() err: %w", err)
+	}
+
+	*migrationState = newMigrationState
+	return nil
+}
+
+func createAppBoxHistoryTable(db *IndexerDb, migrationState *types.MigrationState, opts *idb.IndexerDbOptions) error {
+	return sqlMigration(
+		db, migrationState, []string{`CREATE TABLE IF NOT EXISTS app_box_history (
+			app bigint NOT NULL,
+			name bytea NOT NULL,
+			round bigint NOT NULL,
+			value bytea, -- NULL when the box was deleted
+			PRIMARY KEY (app, name, round)
+		)`})
+}
+
+func createTxnGroupTable(db *IndexerDb, migrationState *types.MigrationState, opts *idb.IndexerDbOptions) error {
+	return sqlMigration(
+		db, migrationState, []string{`CREATE TABLE IF NOT EXISTS txn_group (
+			grp bytea PRIMARY KEY, -- [32]byte group id
+			round bigint NOT NULL
+		)`})
+}
+
+// Statistics are only collected for the rounds added while IndexerDbOptions.TxnStats is enabled.
+func createTxnStatsTables(db *IndexerDb, migrationState *types.MigrationState, opts *idb.IndexerDbOptions) error {
+	return sqlMigration(
+		db, migrationState, []string{
+			`CREATE TABLE IF NOT EXISTS txn_stats (
+			interval smallint NOT NULL, -- 1 hour, 2 day
+			bucket timestamp without time zone NOT NULL, -- start of the bucket, UTC
+			min_round bigint NOT NULL,
+			max_round bigint NOT NULL,
+			txns bigint[] NOT NULL, -- number of transactions indexed by typeenum, including inner transactions
+			inner_txns bigint NOT NULL,
+			fees bigint NOT NULL,
+			senders bigint NOT NULL, -- distinct senders of top level transactions
+			PRIMARY KEY (interval, bucket)
+		)`,
+			`CREATE TABLE IF NOT EXISTS txn_stats_sender (
+			interval smallint NOT NULL,
+			bucket timestamp without time zone NOT NULL,
+			addr bytea NOT NULL,
+			PRIMARY KEY (interval, bucket, addr)
+		)`})
+}
+
+// Statistics are only collected for the rounds added while IndexerDbOptions.FeeStats is enabled.
+func createFeeStatsTable(db *IndexerDb, migrationState *types.MigrationState, opts *idb.IndexerDbOptions) error {
+	return sqlMigration(
+		db, migrationState, []string{`CREATE TABLE IF NOT EXISTS fee_stats (
+			round bigint PRIMARY KEY,
+			fees bigint[] NOT NULL, -- distinct fees paid, ascending
+			counts bigint[] NOT NULL -- number of transactions paying each fee
+		)`})
+}
+
+// txnGroupBackfillRounds is the number of rounds backfilled by each
+// transaction of backfillTxnGroups.
+const txnGroupBackfillRounds = 10000
+
+// Group IDs are unique, and a group's root transactions are all in one round.
+// The rounds imported so far are backfilled in batches which commit on their
+// own, without blocking the importer. Rows it writes meanwhile are skipped.
+func backfillTxnGroups(db *IndexerDb, migrationState *types.MigrationState, opts *idb.IndexerDbOptions) error {
+	var maxRound uint64
+	row := db.db.QueryRow(context.Background(), "SELECT COALESCE(max(round), 0) FROM txn")
+	if err := row.Scan(&maxRound); err != nil {
+		return fmt.Errorf("backfillTxnGroups() max round err: %w", err)
+	}
+
+	for start := uint64(0); start <= maxRound; start += txnGroupBackfillRounds {
+		_, err := db.db.Exec(context.Background(),
+			`INSERT INTO txn_group (grp, round)
+			SELECT DISTINCT decode(txn #>> '{txn,grp}', 'base64'), round FROM txn
+			WHERE round >= $1 AND round < $2 AND txid IS NOT NULL AND txn #>> '{txn,grp}' IS NOT NULL
+			ON CONFLICT DO NOTHING`,
+			start, start+txnGroupBackfillRounds)
+		if err != nil {
+			return fmt.Errorf("backfillTxnGroups() rounds %d err: %w", start, err)
+		}
+	}
+
+	newMigrationState := *migrationState
+	newMigrationState.NextMigration++
+	err := db.setMigrationState(nil, &newMigrationState)
+	if err != nil {
+		return fmt.Errorf("backfillTxnGroups() err: %w", err)
+	}
+
+	*migrationState = newMigrationState
+	return nil
+}
@@ -7,6 +7,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/algorand/indexer/v3/idb/postgres/internal/encoding"
+	"github.com/algorand/indexer/v3/idb/postgres/internal/schema"
 	pgtest "github.com/algorand/indexer/v3/idb/postgres/internal/testing"
 	"github.com/algorand/indexer/v3/idb/postgres/internal/types"
 
@@ -65,3 +67,212 @@ func TestCreateAppBoxTable(t *testing.T) {
 
 	assert.Equal(t, types.MigrationState{NextMigration: 20}, migrationState)
 }
+
+func TestCreateAppGlobalDeltaTable(t *testing.T) {
+	pdb, _, shutdownFunc := pgtest.SetupPostgresWithSchema(t)
+	defer shutdownFunc()
+
+	db := IndexerDb{db: pdb}
+	defer db.Close()
+
+	_, err := db.db.Exec(context.Background(), "DROP TABLE app_global_delta")
+	require.NoError(t, err)
+
+	migrationState := types.MigrationState{
+		NextMigration: 20,
+	}
+	err = db.setMigrationState(nil, &migrationState)
+	require.NoError(t, err)
+
+	err = db.setMetastate(nil, schema.StateMetastateKey, string(encoding.EncodeImportState(&types.ImportState{NextRoundToAccount: 1234})))
+	require.NoError(t, err)
+
+	err = createAppGlobalDeltaTable(&db, &migrationState, nil)
+	require.NoError(t, err)
+
+	migrationState, err = db.getMigrationState(context.Background(), nil)
+	require.NoError(t, err)
+
+	var count int
+	row := db.db.QueryRow(context.Background(), "SELECT count(*) FROM app_global_delta")
+	require.NoError(t, row.Scan(&count))
+	assert.Equal(t, 0, count)
+
+	assert.Equal(t, types.MigrationState{NextMigration: 21}, migrationState)
+
+	// The history starts with the next round.
+	start, err := db.AppGlobalStateHistoryStart(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1234), start)
+}
+
+func TestCreateAppBoxHistoryTable(t *testing.T) {
+	pdb, _, shutdownFunc := pgtest.SetupPostgresWithSchema(t)
+	defer shutdownFunc()
+
+	db := IndexerDb{db: pdb}
+	defer db.Close()
+
+	_, err := db.db.Exec(context.Background(), "DROP TABLE app_box_history")
+	require.NoError(t, err)
+
+	migrationState := types.MigrationState{
+		NextMigration: 21,
+	}
+	err = db.setMigrationState(nil, &migrationState)
+	require.NoError(t, err)
+
+	err = createAppBoxHistoryTable(&db, &migrationState, nil)
+	require.NoError(t, err)
+
+	migrationState, err = db.getMigrationState(context.Background(), nil)
+	require.NoError(t, err)
+
+	var count int
+	row := db.db.QueryRow(context.Background(), "SELECT count(*) FROM app_box_history")
+	require.NoError(t, row.Scan(&count))
+	assert.Equal(t, 0, count)
+
+	assert.Equal(t, types.MigrationState{NextMigration: 22}, migrationState)
+}
+
+func TestCreateTxnGroupTable(t *testing.T) {
+	pdb, _, shutdownFunc := pgtest.SetupPostgresWithSchema(t)
+	defer shutdownFunc()
+
+	db := IndexerDb{db: pdb}
+	defer db.Close()
+
+	_, err := db.db.Exec(context.Background(), "DROP TABLE txn_group")
+	require.NoError(t, err)
+
+	migrationState := types.MigrationState{
+		NextMigration: 22,
+	}
+	err = db.setMigrationState(nil, &migrationState)
+	require.NoError(t, err)
+
+	err = createTxnGroupTable(&db, &migrationState, nil)
+	require.NoError(t, err)
+
+	migrationState, err = db.getMigrationState(context.Background(), nil)
+	require.NoError(t, err)
+
+	var count int
+	row := db.db.QueryRow(context.Background(), "SELECT count(*) FROM txn_group")
+	require.NoError(t, row.Scan(&count))
+	assert.Equal(t, 0, count)
+
+	assert.Equal(t, types.MigrationState{NextMigration: 23}, migrationState)
+}
+
+func TestCreateTxnStatsTables(t *testing.T) {
+	pdb, _, shutdownFunc := pgtest.SetupPostgresWithSchema(t)
+	defer shutdownFunc()
+
+	db := IndexerDb{db: pdb}
+	defer db.Close()
+
+	_, err := db.db.Exec(context.Background(), "DROP TABLE txn_stats, txn_stats_sender")
+	require.NoError(t, err)
+
+	migrationState := types.MigrationState{
+		NextMigration: 23,
+	}
+	err = db.setMigrationState(nil, &migrationState)
+	require.NoError(t, err)
+
+	err = createTxnStatsTables(&db, &migrationState, nil)
+	require.NoError(t, err)
+
+	migrationState, err = db.getMigrationState(context.Background(), nil)
+	require.NoError(t, err)
+
+	for _, table := range []string{"txn_stats", "txn_stats_sender"} {
+		var count int
+		row := db.db.QueryRow(context.Background(), "SELECT count(*) FROM "+table)
+		require.NoError(t, row.Scan(&count))
+		assert.Equal(t, 0, count)
+	}
+
+	assert.Equal(t, types.MigrationState{NextMigration: 24}, migrationState)
+}
+
+func TestCreateFeeStatsTable(t *testing.T) {
+	pdb, _, shutdownFunc := pgtest.SetupPostgresWithSchema(t)
+	defer shutdownFunc()
+
+	db := IndexerDb{db: pdb}
+	defer db.Close()
+
+	_, err := db.db.Exec(context.Background(), "DROP TABLE fee_stats")
+	require.NoError(t, err)
+
+	migrationState := types.MigrationState{
+		NextMigration: 24,
+	}
+	err = db.setMigrationState(nil, &migrationState)
+	require.NoError(t, err)
+
+	err = createFeeStatsTable(&db, &migrationState, nil)
+	require.NoError(t, err)
+
+	migrationState, err = db.getMigrationState(context.Background(), nil)
+	require.NoError(t, err)
+
+	var count int
+	row := db.db.QueryRow(context.Background(), "SELECT count(*) FROM fee_stats")
+	require.NoError(t, row.Scan(&count))
+	assert.Equal(t, 0, count)
+
+	assert.Equal(t, types.MigrationState{NextMigration: 25}, migrationState)
+}
+
+func TestBackfillTxnGroups(t *testing.T) {
+	pdb, _, shutdownFunc := pgtest.SetupPostgresWithSchema(t)
+	defer shutdownFunc()
+
+	db := IndexerDb{db: pdb}
+	defer db.Close()
+
+	// Two root transactions of one group, an ungrouped transaction, an inner
+	// transaction of another group and a group in the next batch.
+	_, err := db.db.Exec(context.Background(),
+		`INSERT INTO txn (round, intra, typeenum, asset, txid, txn, extra) VALUES
+		(3, 0, 1, 0, 'a', '{"txn": {"grp": "AQID"}}', '{}'),
+		(3, 1, 1, 0, 'b', '{"txn": {"grp": "AQID"}}', '{}'),
+		(3, 2, 1, 0, 'c', '{"txn": {}}', '{}'),
+		(4, 0, 1, 0, NULL, '{"txn": {"grp": "BAUG"}}', '{}'),
+		($1, 0, 1, 0, 'd', '{"txn": {"grp": "BwgJ"}}', '{}')`, txnGroupBackfillRounds+5)
+	require.NoError(t, err)
+
+	migrationState := types.MigrationState{
+		NextMigration: 25,
+	}
+	err = db.setMigrationState(nil, &migrationState)
+	require.NoError(t, err)
+
+	err = backfillTxnGroups(&db, &migrationState, nil)
+	require.NoError(t, err)
+
+	migrationState, err = db.getMigrationState(context.Background(), nil)
+	require.NoError(t, err)
+
+	rows, err := db.db.Query(context.Background(), "SELECT grp, round FROM txn_group ORDER BY round")
+	require.NoError(t, err)
+	defer rows.Close()
+	var groups [][]byte
+	var rounds []uint64
+	for rows.Next() {
+		var grp []byte
+		var round uint64
+		require.NoError(t, rows.Scan(&grp, &round))
+		groups = append(groups, grp)
+		rounds = append(rounds, round)
+	}
+	require.NoError(t, rows.Err())
+	assert.Equal(t, [][]byte{{1, 2, 3}, {7, 8, 9}}, groups)
+	assert.Equal(t, []uint64{3, txnGroupBackfillRounds + 5}, rounds)
+
+	assert.Equal(t, types.MigrationState{NextMigration: 26}, migrationState)
+}
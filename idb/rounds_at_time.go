@@ -0,0 +1,21 @@
+package idb
+
+import (
+	"time"
+)
+
+// RoundTime is a round and the time of its block.
+type RoundTime struct {
+	Round     uint64
+	RoundTime time.Time
+}
+
+// RoundsAtTime are the rounds surrounding a point in time.
+type RoundsAtTime struct {
+	// Before is the last round committed at or before the time, nil if the
+	// time precedes every round.
+	Before *RoundTime
+	// After is the first round committed after the time, nil if it hasn't
+	// been committed yet.
+	After *RoundTime
+}
@@ -0,0 +1,52 @@
+package idb
+
+import (
+	"time"
+)
+
+// TxnStatsInterval is the size of the buckets of the transaction statistics.
+// It is stored in the database for each bucket.
+type TxnStatsInterval int16
+
+// All possible transaction statistics intervals.
+const (
+	TxnStatsHour TxnStatsInterval = iota + 1
+	TxnStatsDay
+)
+
+// TxnStatsIntervals lists the intervals which the statistics are maintained for.
+var TxnStatsIntervals = []TxnStatsInterval{TxnStatsHour, TxnStatsDay}
+
+// Truncate returns the start of the bucket containing t, buckets are aligned
+// on UTC.
+func (i TxnStatsInterval) Truncate(t time.Time) time.Time {
+	t = t.UTC()
+	if i == TxnStatsDay {
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	}
+	return t.Truncate(time.Hour)
+}
+
+// TxnStatsQuery is a parameter object used to query the transaction statistics.
+type TxnStatsQuery struct {
+	Interval TxnStatsInterval
+	// MinRound and MaxRound select the buckets which contain rounds in the range.
+	MinRound uint64
+	MaxRound uint64
+	// AfterBucket resumes after the bucket starting at this time.
+	AfterBucket time.Time
+	Limit       uint64
+}
+
+// TxnStatsRow aggregates the transactions of the rounds in a bucket, oldest first.
+type TxnStatsRow struct {
+	Bucket   time.Time // start of the bucket
+	MinRound uint64
+	MaxRound uint64
+	// Txns is the number of transactions of each type, including inner transactions.
+	Txns      map[TxnTypeEnum]uint64
+	InnerTxns uint64
+	Fees      uint64 // microalgos, including inner transactions
+	Senders   uint64 // distinct senders of top level transactions
+	Error     error
+}